@@ -55,8 +55,15 @@ func buildJobCmd() *cobra.Command {
 			},
 			InitFlags: job.InitViewFlags,
 		},
+		"explain": {
+			Short: "explain why a job is pending",
+			RunFunction: func(cmd *cobra.Command, args []string) {
+				util.CheckError(cmd, job.ExplainJob(cmd.Context()))
+			},
+			InitFlags: job.InitExplainFlags,
+		},
 		"suspend": {
-			Short: "abort a job",
+			Short: "suspend a job",
 			RunFunction: func(cmd *cobra.Command, args []string) {
 				util.CheckError(cmd, job.SuspendJob(cmd.Context()))
 			},
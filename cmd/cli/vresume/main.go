@@ -29,7 +29,7 @@ func main() {
 	rootCmd := cobra.Command{
 		Use:   "vresume",
 		Short: "resume a job",
-		Long:  `resume an aborted job with specified name in default or specified namespace`,
+		Long:  `resume an aborted or suspended job with specified name in default or specified namespace`,
 		Run: func(cmd *cobra.Command, args []string) {
 			util.CheckError(cmd, vresume.ResumeJob(cmd.Context()))
 		},
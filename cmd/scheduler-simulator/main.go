@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The scheduler-simulator replays a snapshot dumped by the scheduler cache, or a synthetic cluster described in YAML,
+// through the actions and plugins of the scheduler config, and reports the placements, evictions and queue shares.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/pflag"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/simulator"
+
+	// Import default actions/plugins.
+	_ "volcano.sh/volcano/pkg/scheduler/actions"
+	_ "volcano.sh/volcano/pkg/scheduler/plugins"
+)
+
+func main() {
+	klog.InitFlags(nil)
+
+	// The flags are not registered in the command line flag set, which is used by the scheduler options defaulted by
+	// the cache of the simulator.
+	fs := pflag.NewFlagSet(os.Args[0], pflag.ExitOnError)
+	fs.AddGoFlagSet(flag.CommandLine)
+	snapshot := fs.String("snapshot", "", "The snapshot file dumped by the scheduler cache")
+	clusterFile := fs.String("cluster", "", "The YAML file of the synthetic cluster, which supplements the snapshot if both are set")
+	schedulerConf := fs.String("scheduler-conf", "", "The absolute path of scheduler configuration file, the default one is used if not set")
+	cycles := fs.Int("cycles", 1, "The number of the scheduling cycles to simulate")
+	output := fs.String("output", "text", "The output format of the report, text or json")
+	fs.Parse(os.Args[1:])
+
+	if err := run(*snapshot, *clusterFile, *schedulerConf, *cycles, *output); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(snapshot, clusterFile, schedulerConf string, cycles int, output string) error {
+	if snapshot == "" && clusterFile == "" {
+		return fmt.Errorf("either --snapshot or --cluster must be set")
+	}
+	if cycles <= 0 {
+		return fmt.Errorf("--cycles must be positive")
+	}
+	if output != "text" && output != "json" {
+		return fmt.Errorf("unsupported output format %q", output)
+	}
+
+	cluster := &simulator.Cluster{}
+	if snapshot != "" {
+		file, err := os.Open(snapshot)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		if cluster, err = simulator.LoadSnapshot(file); err != nil {
+			return err
+		}
+	}
+	if clusterFile != "" {
+		data, err := os.ReadFile(clusterFile)
+		if err != nil {
+			return err
+		}
+		synthetic, err := simulator.LoadCluster(data)
+		if err != nil {
+			return err
+		}
+		cluster.Merge(synthetic)
+	}
+
+	opts := simulator.Options{}
+	if schedulerConf != "" {
+		data, err := os.ReadFile(schedulerConf)
+		if err != nil {
+			return err
+		}
+		opts.SchedulerConf = string(data)
+	}
+
+	sim, err := simulator.New(cluster, opts)
+	if err != nil {
+		return err
+	}
+	defer sim.Close()
+
+	report, err := sim.Run(cycles)
+	if err != nil {
+		return err
+	}
+
+	if output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+	printReport(os.Stdout, report)
+	return nil
+}
+
+func printReport(out io.Writer, report *simulator.Report) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, cycle := range report.Cycles {
+		fmt.Fprintf(w, "Cycle %d:\n", cycle.Cycle)
+		for _, placement := range cycle.Placements {
+			fmt.Fprintf(w, "  Place\t%s\t%s\n", placement.Task, placement.Node)
+		}
+		for _, eviction := range cycle.Evictions {
+			fmt.Fprintf(w, "  Evict\t%s\t%s\t%s\n", eviction.Task, eviction.Node, eviction.Reason)
+		}
+		for _, share := range cycle.QueueShares {
+			fmt.Fprintf(w, "  Queue\t%s\tdeserved: %v\tallocated: %v\n", share.Queue, formatResourceList(share.Deserved), formatResourceList(share.Allocated))
+		}
+	}
+
+	names := make([]string, 0, len(report.PodGroupPhases))
+	for name := range report.PodGroupPhases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintf(w, "PodGroups:\n")
+	for _, name := range names {
+		fmt.Fprintf(w, "  %s\t%s\n", name, report.PodGroupPhases[name])
+	}
+	w.Flush()
+}
+
+func formatResourceList(rl v1.ResourceList) string {
+	if len(rl) == 0 {
+		return "<none>"
+	}
+	names := make([]string, 0, len(rl))
+	for name := range rl {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	items := make([]string, 0, len(names))
+	for _, name := range names {
+		quantity := rl[v1.ResourceName(name)]
+		items = append(items, fmt.Sprintf("%s=%s", name, quantity.String()))
+	}
+	return strings.Join(items, ",")
+}
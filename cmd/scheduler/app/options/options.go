@@ -51,6 +51,9 @@ const (
 	defaultPercentageOfNodesToFind    = 0
 	defaultLockObjectNamespace        = "volcano-system"
 	defaultNodeWorkers                = 20
+
+	defaultScheduleTriggerDebounce = 50 * time.Millisecond
+	defaultMinScheduleInterval     = 200 * time.Millisecond
 )
 
 var (
@@ -70,6 +73,12 @@ type ServerOption struct {
 	SchedulerConf     string
 	SchedulePeriod    time.Duration
 	ResyncPeriod      time.Duration
+	// EnableEventDrivenScheduling runs early scheduling cycles on the changes of the cluster besides the periodic
+	// ones, the early cycle is delayed by ScheduleTriggerDebounce and runs no earlier than MinScheduleInterval since
+	// the last cycle
+	EnableEventDrivenScheduling bool
+	ScheduleTriggerDebounce     time.Duration
+	MinScheduleInterval         time.Duration
 	// leaderElection defines the configuration of leader election.
 	LeaderElection config.LeaderElectionConfiguration
 	// Deprecated: use ResourceNamespace instead.
@@ -78,6 +87,7 @@ type ServerOption struct {
 	PrintVersion        bool
 	EnableMetrics       bool
 	EnablePprof         bool
+	EnableExplain       bool
 	ListenAddress       string
 	EnablePriorityClass bool
 	EnableCSIStorage    bool
@@ -118,6 +128,20 @@ type ServerOption struct {
 
 	//Shard name for this scheduler
 	ShardName string
+
+	// ValidateConfig indicates the scheduler only validates the scheduler config and exits
+	ValidateConfig bool
+	// KubePodName and KubePodNamespace refer to the pod of the scheduler, which the events of the scheduler config
+	// are recorded on
+	KubePodName      string
+	KubePodNamespace string
+
+	// TracingEndpoint, TracingInsecure, TracingFile and TracingSamplingRatio configure the export of the scheduling
+	// cycles as OpenTelemetry traces, the cycles are not traced if neither the endpoint nor the file is set
+	TracingEndpoint      string
+	TracingInsecure      bool
+	TracingFile          string
+	TracingSamplingRatio float64
 }
 
 // DecryptFunc is custom function to parse ca file
@@ -147,6 +171,9 @@ func (s *ServerOption) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.SchedulerConf, "scheduler-conf", "", "The absolute path of scheduler configuration file")
 	fs.DurationVar(&s.SchedulePeriod, "schedule-period", defaultSchedulerPeriod, "The period between each scheduling cycle")
 	fs.DurationVar(&s.ResyncPeriod, "resync-period", defaultResyncPeriod, "The default resync period for k8s native informer factory")
+	fs.BoolVar(&s.EnableEventDrivenScheduling, "event-driven-scheduling", false, "Run early scheduling cycles when jobs are inqueue, nodes are added or resources are freed besides the periodic ones; it is false by default")
+	fs.DurationVar(&s.ScheduleTriggerDebounce, "schedule-trigger-debounce", defaultScheduleTriggerDebounce, "The delay of the early scheduling cycle to coalesce a burst of changes")
+	fs.DurationVar(&s.MinScheduleInterval, "min-schedule-interval", defaultMinScheduleInterval, "The minimum interval between the end of a scheduling cycle and the start of an early one")
 	fs.StringVar(&s.DefaultQueue, "default-queue", defaultQueue, "The default queue name of the job")
 	fs.BoolVar(&s.PrintVersion, "version", false, "Show version and quit")
 	fs.StringVar(&s.ListenAddress, "listen-address", defaultListenAddress, "The address to listen on for HTTP requests.")
@@ -171,6 +198,8 @@ func (s *ServerOption) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&s.EnableHealthz, "enable-healthz", false, "Enable the health check; it is false by default")
 	fs.BoolVar(&s.EnableMetrics, "enable-metrics", false, "Enable the metrics function; it is false by default")
 	fs.BoolVar(&s.EnablePprof, "enable-pprof", false, "Enable the pprof endpoint; it is false by default")
+	fs.BoolVar(&s.EnableExplain, "enable-explain", false, "Enable the endpoint explaining the scheduling decisions of the jobs in the last session; "+
+		"the decisions of all namespaces are served to anyone reaching the listen address, it is false by default")
 	fs.StringSliceVar(&s.NodeSelector, "node-selector", nil, "volcano only work with the labeled node, like: --node-selector=volcano.sh/role:train --node-selector=volcano.sh/role:serving")
 	fs.BoolVar(&s.EnableCacheDumper, "cache-dumper", true, "Enable the cache dumper, it's true by default")
 	fs.StringVar(&s.CacheDumpFileDir, "cache-dump-dir", "/tmp", "The target dir where the json file put at when dump cache info to json file")
@@ -181,6 +210,13 @@ func (s *ServerOption) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&s.DisableDefaultSchedulerConfig, "disable-default-scheduler-config", false, "The flag indicates whether the scheduler should avoid using the default configuration if the provided scheduler configuration is invalid.")
 	fs.StringVar(&s.ShardingMode, "scheduler-sharding-mode", util.NoneShardingMode, "The node sharding mode for scheduling, none(default)|hard|soft mode is supported")
 	fs.StringVar(&s.ShardName, "scheduler-sharding-name", defaultShardName, "The name of shard used for this scheduler")
+	fs.BoolVar(&s.ValidateConfig, "validate-config", false, "Validate the config specified by --scheduler-conf and exit")
+	fs.StringVar(&s.KubePodName, "kube-pod-name", os.Getenv("KUBE_POD_NAME"), "The name of the pod of the scheduler, which the events of the scheduler config are recorded on")
+	fs.StringVar(&s.KubePodNamespace, "kube-pod-namespace", os.Getenv("KUBE_POD_NAMESPACE"), "The namespace of the pod of the scheduler")
+	fs.StringVar(&s.TracingEndpoint, "tracing-endpoint", "", "The address of the OTLP gRPC collector the traces of the scheduling cycles are exported to, e.g. otel-collector:4317")
+	fs.BoolVar(&s.TracingInsecure, "tracing-insecure", false, "Connect to the OTLP collector without TLS; it is false by default")
+	fs.StringVar(&s.TracingFile, "tracing-file", "", "The file the spans of the scheduling cycles are appended to as JSON lines for offline analysis")
+	fs.Float64Var(&s.TracingSamplingRatio, "tracing-sampling-ratio", 1, "The ratio of the scheduling cycles traced when the tracing is enabled, from 0 to 1")
 }
 
 // CheckOptionOrDie check leader election flag when LeaderElection is enabled.
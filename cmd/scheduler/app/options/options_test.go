@@ -88,6 +88,9 @@ func TestAddFlags(t *testing.T) {
 		ShardingMode:                  commonutil.NoneShardingMode,
 		ShardName:                     defaultSchedulerName,
 		ResourceSyncTimeout:           60 * time.Second,
+		TracingSamplingRatio:          1,
+		ScheduleTriggerDebounce:       50 * time.Millisecond,
+		MinScheduleInterval:           200 * time.Millisecond,
 	}
 	expectedFeatureGates := map[featuregate.Feature]bool{
 		features.PodDisruptionBudgetsSupport: false,
@@ -27,8 +27,10 @@ import (
 	"volcano.sh/volcano/cmd/scheduler/app/options"
 	"volcano.sh/volcano/pkg/kube"
 	"volcano.sh/volcano/pkg/scheduler"
+	"volcano.sh/volcano/pkg/scheduler/explain"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/tracing"
 	"volcano.sh/volcano/pkg/signals"
 	commonutil "volcano.sh/volcano/pkg/util"
 
@@ -80,7 +82,11 @@ func Run(opt *options.ServerOption) error {
 	// k8smetrics.Goroutines which is used by Kubernetes scheduler framework plugins
 	metrics.InitKubeSchedulerRelatedMetrics()
 
-	if opt.EnableMetrics || opt.EnablePprof {
+	if opt.EnableExplain {
+		explain.DefaultStore.Enable()
+	}
+
+	if opt.EnableMetrics || opt.EnablePprof || opt.EnableExplain {
 		go startMetricsServer(opt)
 	}
 
@@ -91,6 +97,20 @@ func Run(opt *options.ServerOption) error {
 	}
 
 	ctx := signals.SetupSignalContext()
+	shutdownTracing, err := tracing.Init(ctx, tracing.Options{
+		Endpoint:      opt.TracingEndpoint,
+		Insecure:      opt.TracingInsecure,
+		File:          opt.TracingFile,
+		SamplingRatio: opt.TracingSamplingRatio,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			klog.Errorf("Failed to flush the traces of the scheduling cycles: %v", err)
+		}
+	}()
 	run := func(ctx context.Context) {
 		sched.Run(ctx.Done())
 		<-ctx.Done()
@@ -151,6 +171,30 @@ func Run(opt *options.ServerOption) error {
 	return fmt.Errorf("lost lease")
 }
 
+// ValidateConfig validates the config specified by --scheduler-conf without running the scheduler, the custom plugins
+// are loaded first so that they can be configured.
+func ValidateConfig(opt *options.ServerOption) error {
+	if opt.SchedulerConf == "" {
+		return fmt.Errorf("--scheduler-conf is required to validate the scheduler config")
+	}
+	if opt.PluginsDir != "" {
+		if err := framework.LoadCustomPlugins(opt.PluginsDir); err != nil {
+			return fmt.Errorf("failed to load custom plugins: %v", err)
+		}
+	}
+
+	confData, err := os.ReadFile(opt.SchedulerConf)
+	if err != nil {
+		return fmt.Errorf("failed to read scheduler config %s: %v", opt.SchedulerConf, err)
+	}
+	if err := scheduler.ValidateSchedulerConf(string(confData)); err != nil {
+		return fmt.Errorf("scheduler config %s is invalid: %v", opt.SchedulerConf, err)
+	}
+
+	fmt.Printf("Scheduler config %s is valid, hash %s\n", opt.SchedulerConf, scheduler.SchedulerConfHash(string(confData)))
+	return nil
+}
+
 func startMetricsServer(opt *options.ServerOption) {
 	mux := http.NewServeMux()
 
@@ -166,6 +210,10 @@ func startMetricsServer(opt *options.ServerOption) {
 		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
 	}
 
+	if opt.EnableExplain {
+		mux.Handle(explain.PathPrefix, explain.DefaultStore)
+	}
+
 	server := &http.Server{
 		Addr:              opt.ListenAddress,
 		Handler:           mux,
@@ -175,6 +223,6 @@ func startMetricsServer(opt *options.ServerOption) {
 	}
 
 	if err := server.ListenAndServe(); err != nil {
-		klog.Errorf("start metrics/pprof http server failed: %v", err)
+		klog.Errorf("start metrics/pprof/explain http server failed: %v", err)
 	}
 }
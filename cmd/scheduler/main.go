@@ -67,6 +67,14 @@ func main() {
 		return
 	}
 
+	if s.ValidateConfig {
+		if err := app.ValidateConfig(s); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := s.CheckOptionOrDie(); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
@@ -34,6 +34,7 @@ import (
 	_ "volcano.sh/volcano/pkg/features"
 	"volcano.sh/volcano/pkg/version"
 	_ "volcano.sh/volcano/pkg/webhooks/admission/cronjobs/validate"
+	_ "volcano.sh/volcano/pkg/webhooks/admission/datasourceclaims/validate"
 	_ "volcano.sh/volcano/pkg/webhooks/admission/hypernodes/validate"
 	_ "volcano.sh/volcano/pkg/webhooks/admission/jobflows/validate"
 	_ "volcano.sh/volcano/pkg/webhooks/admission/jobs/mutate"
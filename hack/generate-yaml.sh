@@ -131,6 +131,9 @@ tail -n +2 ${VOLCANO_CRD_DIR}/bases/nodeinfo.volcano.sh_numatopologies.yaml > ${
 tail -n +2 ${VOLCANO_CRD_DIR}/bases/topology.volcano.sh_hypernodes.yaml > ${HELM_VOLCANO_CRD_DIR}/bases/topology.volcano.sh_hypernodes.yaml
 tail -n +2 ${VOLCANO_CRD_DIR}/bases/shard.volcano.sh_nodeshards.yaml > ${HELM_VOLCANO_CRD_DIR}/bases/shard.volcano.sh_nodeshards.yaml
 tail -n +2 ${VOLCANO_CRD_DIR}/bases/config.volcano.sh_colocationconfigurations.yaml > ${HELM_VOLCANO_CRD_DIR}/bases/config.volcano.sh_colocationconfigurations.yaml
+tail -n +2 ${VOLCANO_CRD_DIR}/bases/datadependency.volcano.sh_datasources.yaml > ${HELM_VOLCANO_CRD_DIR}/bases/datadependency.volcano.sh_datasources.yaml
+tail -n +2 ${VOLCANO_CRD_DIR}/bases/datadependency.volcano.sh_datasourceclaims.yaml > ${HELM_VOLCANO_CRD_DIR}/bases/datadependency.volcano.sh_datasourceclaims.yaml
+tail -n +2 ${VOLCANO_CRD_DIR}/bases/training.volcano.sh_hyperjobs.yaml > ${HELM_VOLCANO_CRD_DIR}/bases/training.volcano.sh_hyperjobs.yaml
 
 # sync jobflow bases
 tail -n +2 ${JOBFLOW_CRD_DIR}/bases/flow.volcano.sh_jobflows.yaml > ${HELM_JOBFLOW_CRD_DIR}/bases/flow.volcano.sh_jobflows.yaml
@@ -190,6 +193,9 @@ HELM_CMD="${HELM_BIN_DIR}/helm template ${VK_ROOT}/installer/helm/chart/volcano
       -s templates/topology_v1alpha1_hypernodes.yaml \
       -s templates/shard_v1alpha1_nodeshards.yaml \
       -s templates/config_v1alpha1_colocationconfigurations.yaml \
+      -s templates/datadependency_v1alpha1_datasources.yaml \
+      -s templates/datadependency_v1alpha1_datasourceclaims.yaml \
+      -s templates/training_v1alpha1_hyperjobs.yaml \
       -s templates/webhooks.yaml"
 
 # Add VAP and MAP templates if enabled
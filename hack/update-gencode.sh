@@ -37,3 +37,11 @@ bash hack/generate-internal-groups.sh "deepcopy,conversion" \
   --output-base "$(dirname "${BASH_SOURCE[0]}")/../../.." \
   --go-header-file ${SCRIPT_ROOT}/hack/boilerplate/boilerplate.go.txt
 
+# generate the protocol of the out-of-process scheduler plugins, which requires protoc, protoc-gen-go and protoc-gen-go-grpc
+if command -v protoc >/dev/null 2>&1; then
+  protoc --go_out=. --go_opt=paths=source_relative \
+    --go-grpc_out=. --go-grpc_opt=paths=source_relative \
+    pkg/scheduler/plugins/remote/proto/v1/plugin.proto
+else
+  echo "protoc not found, skip generating pkg/scheduler/plugins/remote/proto/v1"
+fi
@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"volcano.sh/apis/pkg/client/clientset/versioned"
+	"volcano.sh/volcano/pkg/cli/util"
+	"volcano.sh/volcano/pkg/scheduler/explain"
+)
+
+type explainFlags struct {
+	util.CommonFlags
+
+	Namespace string
+	JobName   string
+
+	// SchedulerAddress is the address of the scheduler http server, the scheduler service is accessed
+	// through the apiserver proxy if it is not set.
+	SchedulerAddress   string
+	SchedulerNamespace string
+	SchedulerService   string
+	SchedulerPort      string
+}
+
+var explainJobFlags = &explainFlags{}
+
+// InitExplainFlags init the explain command flags.
+func InitExplainFlags(cmd *cobra.Command) {
+	util.InitFlags(cmd, &explainJobFlags.CommonFlags)
+
+	cmd.Flags().StringVarP(&explainJobFlags.Namespace, "namespace", "n", "default", "the namespace of job")
+	cmd.Flags().StringVarP(&explainJobFlags.JobName, "name", "N", "", "the name of job")
+	cmd.Flags().StringVar(&explainJobFlags.SchedulerAddress, "scheduler-address", "", "(optional) the address of the scheduler http server, e.g. http://127.0.0.1:8080")
+	cmd.Flags().StringVar(&explainJobFlags.SchedulerNamespace, "scheduler-namespace", "volcano-system", "the namespace of the scheduler service")
+	cmd.Flags().StringVar(&explainJobFlags.SchedulerService, "scheduler-service", "volcano-scheduler-service", "the name of the scheduler service")
+	cmd.Flags().StringVar(&explainJobFlags.SchedulerPort, "scheduler-port", "8080", "the port of the scheduler service")
+}
+
+// ExplainJob shows why the job is pending according to the decisions of the scheduler in the last session.
+func ExplainJob(ctx context.Context) error {
+	config, err := util.BuildConfig(explainJobFlags.Master, explainJobFlags.Kubeconfig)
+	if err != nil {
+		return err
+	}
+	if explainJobFlags.JobName == "" {
+		err := fmt.Errorf("job name (specified by --name or -N) is mandatory to explain a particular job")
+		return err
+	}
+
+	jobClient := versioned.NewForConfigOrDie(config)
+	job, err := jobClient.BatchV1alpha1().Jobs(explainJobFlags.Namespace).Get(ctx, explainJobFlags.JobName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// The PodGroup of a job is named after its UID, while the one created by the former versions is named after the job.
+	var decision *explain.JobDecision
+	for _, podGroupName := range []string{fmt.Sprintf("%s-%s", job.Name, job.UID), job.Name} {
+		decision, err = getJobDecision(ctx, config, job.Namespace, podGroupName)
+		if err != nil {
+			return err
+		}
+		if decision != nil {
+			break
+		}
+	}
+	if decision == nil {
+		fmt.Printf("No scheduling decision found for job %s/%s in the last session\n", job.Namespace, job.Name)
+		return nil
+	}
+
+	PrintJobDecision(decision, os.Stdout)
+	return nil
+}
+
+// getJobDecision gets the decision of the job from the scheduler, nil means the job is not found in the last session.
+func getJobDecision(ctx context.Context, config *rest.Config, namespace, podGroupName string) (*explain.JobDecision, error) {
+	path := explain.PathPrefix + namespace + "/" + podGroupName
+
+	var data []byte
+	if explainJobFlags.SchedulerAddress != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(explainJobFlags.SchedulerAddress, "/")+path, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		data, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, notFound(string(data))
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to get the decision of job %s/%s from scheduler: %s", namespace, podGroupName, strings.TrimSpace(string(data)))
+		}
+	} else {
+		kubeClient, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		data, err = kubeClient.CoreV1().Services(explainJobFlags.SchedulerNamespace).
+			ProxyGet("http", explainJobFlags.SchedulerService, explainJobFlags.SchedulerPort, path, nil).DoRaw(ctx)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, notFound(serverMessage(err))
+			}
+			return nil, fmt.Errorf("failed to get the decision of job %s/%s from scheduler: %v", namespace, podGroupName, err)
+		}
+	}
+
+	decision := &explain.JobDecision{}
+	if err := json.Unmarshal(data, decision); err != nil {
+		return nil, err
+	}
+	return decision, nil
+}
+
+// notFound tells the job without decision in the last session, which is not an error, apart from the endpoint not
+// being served by the scheduler.
+func notFound(message string) error {
+	if strings.HasPrefix(strings.TrimSpace(message), explain.NoDecisionMessage) {
+		return nil
+	}
+	return fmt.Errorf("the explain endpoint is not served by the scheduler, it is enabled by the --enable-explain " +
+		"flag of the scheduler, or the custom.scheduler_explain_enable value of the helm chart")
+}
+
+// serverMessage returns the body of the unexpected response carried by the error of the apiserver proxy.
+func serverMessage(err error) string {
+	status, ok := err.(apierrors.APIStatus)
+	if !ok || status.Status().Details == nil {
+		return ""
+	}
+	for _, cause := range status.Status().Details.Causes {
+		if cause.Type == metav1.CauseTypeUnexpectedServerResponse {
+			return cause.Message
+		}
+	}
+	return ""
+}
+
+// PrintJobDecision prints the decision of the job into writer.
+func PrintJobDecision(decision *explain.JobDecision, writer io.Writer) {
+	WriteLine(writer, Level0, "PodGroup:     \t%s/%s\n", decision.Namespace, decision.Name)
+	WriteLine(writer, Level0, "Queue:        \t%s\n", decision.Queue)
+	WriteLine(writer, Level0, "Phase:        \t%s\n", decision.Phase)
+	WriteLine(writer, Level0, "Pending Tasks:\t%d\n", decision.PendingTasks)
+	WriteLine(writer, Level0, "Session:      \t%s (%s ago)\n", decision.SessionID, time.Since(decision.Timestamp).Round(time.Second))
+	if decision.Message != "" {
+		WriteLine(writer, Level0, "Message:      \t%s\n", decision.Message)
+	}
+
+	if len(decision.Rejections) > 0 {
+		WriteLine(writer, Level0, "Rejections:\n")
+		WriteLine(writer, Level1, "%-20s\t%-20s\t%-30s\t%s\n", "Stage", "Plugin", "Reason", "Message")
+		for _, r := range decision.Rejections {
+			WriteLine(writer, Level1, "%-20s\t%-20s\t%-30s\t%s\n", r.Stage, r.Plugin, r.Reason, r.Message)
+		}
+	}
+
+	if len(decision.Predicates) > 0 {
+		WriteLine(writer, Level0, "Predicates:\n")
+		WriteLine(writer, Level1, "%-20s\t%-8s\t%-8s\t%s\n", "Plugin", "Nodes", "Tasks", "Reason")
+		for _, p := range decision.Predicates {
+			WriteLine(writer, Level1, "%-20s\t%-8d\t%-8d\t%s\n", p.Plugin, p.Nodes, p.Tasks, p.Reason)
+		}
+	}
+
+	if decision.QueueShare != nil {
+		WriteLine(writer, Level0, "Queue Share:\n")
+		WriteLine(writer, Level1, "Deserved: \t%s\n", formatResourceList(decision.QueueShare.Deserved))
+		WriteLine(writer, Level1, "Allocated:\t%s\n", formatResourceList(decision.QueueShare.Allocated))
+	}
+}
+
+// formatResourceList formats the resource list as name=quantity sorted by name.
+func formatResourceList(resources coreV1.ResourceList) string {
+	if len(resources) == 0 {
+		return "<none>"
+	}
+	items := make([]string, 0, len(resources))
+	for name, quantity := range resources {
+		items = append(items, fmt.Sprintf("%s=%s", name, quantity.String()))
+	}
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
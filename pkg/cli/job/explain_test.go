@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/volcano/pkg/scheduler/explain"
+)
+
+func TestExplainJob(t *testing.T) {
+	job := v1alpha1.Job{ObjectMeta: metav1.ObjectMeta{Name: "testJob", Namespace: "test", UID: "uid"}}
+	decision := explain.JobDecision{
+		Namespace:  "test",
+		Name:       "testJob-uid",
+		Queue:      "default",
+		Rejections: []explain.Rejection{{Stage: explain.JobEnqueueableStage, Plugin: "proportion"}},
+	}
+
+	var requested []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, explain.PathPrefix) {
+			requested = append(requested, r.URL.Path)
+			if !strings.HasSuffix(r.URL.Path, "/testJob-uid") {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			val, _ := json.Marshal(decision)
+			w.Write(val)
+			return
+		}
+
+		val, _ := json.Marshal(job)
+		w.Write(val)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	explainJobFlags.Master = server.URL
+	explainJobFlags.Namespace = "test"
+	explainJobFlags.JobName = "testJob"
+	explainJobFlags.SchedulerNamespace = "volcano-system"
+	explainJobFlags.SchedulerService = "volcano-scheduler-service"
+	explainJobFlags.SchedulerPort = "8080"
+
+	testCases := []struct {
+		name             string
+		schedulerAddress string
+		expectedPath     string
+	}{
+		{
+			name:         "through apiserver proxy",
+			expectedPath: "/api/v1/namespaces/volcano-system/services/http:volcano-scheduler-service:8080/proxy/explain/jobs/test/testJob-uid",
+		},
+		{
+			name:             "through scheduler address",
+			schedulerAddress: server.URL,
+			expectedPath:     "/explain/jobs/test/testJob-uid",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requested = nil
+			explainJobFlags.SchedulerAddress = tc.schedulerAddress
+			if err := ExplainJob(context.TODO()); err != nil {
+				t.Fatalf("explain job failed: %v", err)
+			}
+			if len(requested) != 1 || requested[0] != tc.expectedPath {
+				t.Errorf("expected request %s, got %v", tc.expectedPath, requested)
+			}
+		})
+	}
+}
+
+func TestExplainJobNotFound(t *testing.T) {
+	job := v1alpha1.Job{ObjectMeta: metav1.ObjectMeta{Name: "testJob", Namespace: "test", UID: "uid"}}
+
+	var served bool
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, explain.PathPrefix) {
+			if !served {
+				http.NotFound(w, r)
+				return
+			}
+			http.Error(w, explain.NoDecisionMessage+"test/testJob-uid in the last session", http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		val, _ := json.Marshal(job)
+		w.Write(val)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	explainJobFlags.Master = server.URL
+	explainJobFlags.Namespace = "test"
+	explainJobFlags.JobName = "testJob"
+
+	testCases := []struct {
+		name             string
+		schedulerAddress string
+		served           bool
+		expectErr        bool
+	}{
+		{
+			name:   "no decision through apiserver proxy",
+			served: true,
+		},
+		{
+			name:             "no decision through scheduler address",
+			schedulerAddress: server.URL,
+			served:           true,
+		},
+		{
+			name:      "endpoint not served through apiserver proxy",
+			expectErr: true,
+		},
+		{
+			name:             "endpoint not served through scheduler address",
+			schedulerAddress: server.URL,
+			expectErr:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			served = tc.served
+			explainJobFlags.SchedulerAddress = tc.schedulerAddress
+			err := ExplainJob(context.TODO())
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("expected error %v, got %v", tc.expectErr, err)
+			}
+			if err != nil && !strings.Contains(err.Error(), "--enable-explain") {
+				t.Errorf("expected the error to tell how to enable the endpoint, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPrintJobDecision(t *testing.T) {
+	decision := &explain.JobDecision{
+		Namespace:    "test",
+		Name:         "testJob-uid",
+		Queue:        "default",
+		Phase:        "Pending",
+		PendingTasks: 2,
+		Timestamp:    time.Now(),
+		Rejections:   []explain.Rejection{{Stage: explain.JobEnqueueableStage, Plugin: "proportion", Message: "job is not enqueueable"}},
+		Predicates:   []explain.PredicateFailure{{Plugin: "predicates", Reason: "Insufficient cpu", Nodes: 3, Tasks: 2}},
+		QueueShare: &explain.QueueShare{
+			Queue:    "default",
+			Deserved: v1.ResourceList{v1.ResourceMemory: resource.MustParse("4Gi"), v1.ResourceCPU: resource.MustParse("4")},
+		},
+	}
+
+	var buf bytes.Buffer
+	PrintJobDecision(decision, &buf)
+	for _, expected := range []string{"test/testJob-uid", "JobEnqueueable", "Insufficient cpu", "cpu=4,memory=4Gi", "<none>"} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("expected %q in output:\n%s", expected, buf.String())
+		}
+	}
+}
+
+func TestInitExplainFlags(t *testing.T) {
+	var cmd cobra.Command
+	InitExplainFlags(&cmd)
+
+	for _, name := range []string{"namespace", "name", "scheduler-address", "scheduler-namespace", "scheduler-service", "scheduler-port"} {
+		if cmd.Flag(name) == nil {
+			t.Errorf("Could not find the flag %s", name)
+		}
+	}
+}
@@ -57,5 +57,5 @@ func SuspendJob(ctx context.Context) error {
 
 	return util.CreateJobCommand(ctx, config,
 		suspendJobFlags.Namespace, suspendJobFlags.JobName,
-		v1alpha1.AbortJobAction)
+		v1alpha1.SuspendJobAction)
 }
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datadependency
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+	vcscheme "volcano.sh/apis/pkg/client/clientset/versioned/scheme"
+	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
+	batchinformer "volcano.sh/apis/pkg/client/informers/externalversions/batch/v1alpha1"
+	datainformer "volcano.sh/apis/pkg/client/informers/externalversions/datadependency/v1alpha1"
+	schedulinginformer "volcano.sh/apis/pkg/client/informers/externalversions/scheduling/v1beta1"
+	batchlister "volcano.sh/apis/pkg/client/listers/batch/v1alpha1"
+	datalister "volcano.sh/apis/pkg/client/listers/datadependency/v1alpha1"
+	schedulinglister "volcano.sh/apis/pkg/client/listers/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/controllers/framework"
+)
+
+func init() {
+	framework.RegisterController(&datadependencycontroller{})
+}
+
+// datadependencycontroller binds DataSourceClaims to DataSources, and surfaces the locality
+// of the bound DataSource on the workload of the claim for the scheduler.
+type datadependencycontroller struct {
+	kubeClient kubernetes.Interface
+	vcClient   vcclientset.Interface
+
+	dataSourceInformer      datainformer.DataSourceInformer
+	dataSourceClaimInformer datainformer.DataSourceClaimInformer
+	jobInformer             batchinformer.JobInformer
+	podGroupInformer        schedulinginformer.PodGroupInformer
+
+	vcInformerFactory vcinformer.SharedInformerFactory
+
+	dataSourceLister datalister.DataSourceLister
+	dataSourceSynced cache.InformerSynced
+
+	dataSourceClaimLister datalister.DataSourceClaimLister
+	dataSourceClaimSynced cache.InformerSynced
+
+	jobLister batchlister.JobLister
+	jobSynced cache.InformerSynced
+
+	podGroupLister schedulinglister.PodGroupLister
+	podGroupSynced cache.InformerSynced
+
+	recorder record.EventRecorder
+
+	// claimQueue holds the namespace/name keys of DataSourceClaims,
+	// dataSourceQueue holds the names of the cluster scoped DataSources,
+	// workloadQueue holds the kind/namespace/name keys of the workloads whose bound claim is gone.
+	claimQueue      workqueue.TypedRateLimitingInterface[string]
+	dataSourceQueue workqueue.TypedRateLimitingInterface[string]
+	workloadQueue   workqueue.TypedRateLimitingInterface[string]
+
+	maxRequeueNum int
+}
+
+func (dc *datadependencycontroller) Name() string {
+	return "datadependency-controller"
+}
+
+func (dc *datadependencycontroller) Initialize(opt *framework.ControllerOption) error {
+	dc.kubeClient = opt.KubeClient
+	dc.vcClient = opt.VolcanoClient
+
+	factory := opt.VCSharedInformerFactory
+	dc.vcInformerFactory = factory
+
+	dc.dataSourceInformer = factory.Datadependency().V1alpha1().DataSources()
+	dc.dataSourceLister = dc.dataSourceInformer.Lister()
+	dc.dataSourceSynced = dc.dataSourceInformer.Informer().HasSynced
+	dc.dataSourceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    dc.addDataSource,
+		UpdateFunc: dc.updateDataSource,
+		DeleteFunc: dc.deleteDataSource,
+	})
+
+	dc.dataSourceClaimInformer = factory.Datadependency().V1alpha1().DataSourceClaims()
+	dc.dataSourceClaimLister = dc.dataSourceClaimInformer.Lister()
+	dc.dataSourceClaimSynced = dc.dataSourceClaimInformer.Informer().HasSynced
+	dc.dataSourceClaimInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    dc.addDataSourceClaim,
+		UpdateFunc: dc.updateDataSourceClaim,
+		DeleteFunc: dc.deleteDataSourceClaim,
+	})
+
+	dc.jobInformer = factory.Batch().V1alpha1().Jobs()
+	dc.jobLister = dc.jobInformer.Lister()
+	dc.jobSynced = dc.jobInformer.Informer().HasSynced
+	dc.jobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    dc.addWorkload,
+		DeleteFunc: dc.deleteWorkload,
+	})
+
+	dc.podGroupInformer = factory.Scheduling().V1beta1().PodGroups()
+	dc.podGroupLister = dc.podGroupInformer.Lister()
+	dc.podGroupSynced = dc.podGroupInformer.Informer().HasSynced
+	dc.podGroupInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    dc.addWorkload,
+		DeleteFunc: dc.deleteWorkload,
+	})
+
+	dc.maxRequeueNum = opt.MaxRequeueNum
+	if dc.maxRequeueNum < 0 {
+		dc.maxRequeueNum = -1
+	}
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(klog.Infof)
+	eventBroadcaster.StartRecordingToSink(&corev1.EventSinkImpl{Interface: dc.kubeClient.CoreV1().Events("")})
+	dc.recorder = eventBroadcaster.NewRecorder(vcscheme.Scheme, v1.EventSource{Component: "vc-controller-manager"})
+
+	dc.claimQueue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+	dc.dataSourceQueue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+	dc.workloadQueue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+
+	return nil
+}
+
+func (dc *datadependencycontroller) Run(stopCh <-chan struct{}) {
+	defer dc.claimQueue.ShutDown()
+	defer dc.dataSourceQueue.ShutDown()
+	defer dc.workloadQueue.ShutDown()
+
+	dc.vcInformerFactory.Start(stopCh)
+	for informerType, ok := range dc.vcInformerFactory.WaitForCacheSync(stopCh) {
+		if !ok {
+			klog.Errorf("caches failed to sync: %v", informerType)
+			return
+		}
+	}
+
+	go wait.Until(func() {
+		for dc.processNextItem(dc.claimQueue, dc.syncDataSourceClaim) {
+		}
+	}, time.Second, stopCh)
+	go wait.Until(func() {
+		for dc.processNextItem(dc.dataSourceQueue, dc.syncDataSource) {
+		}
+	}, time.Second, stopCh)
+	go wait.Until(func() {
+		for dc.processNextItem(dc.workloadQueue, dc.syncWorkload) {
+		}
+	}, time.Second, stopCh)
+
+	klog.Infof("DataDependencyController is running ...... ")
+
+	<-stopCh
+}
+
+func (dc *datadependencycontroller) processNextItem(queue workqueue.TypedRateLimitingInterface[string], syncHandler func(key string) error) bool {
+	key, shutdown := queue.Get()
+	if shutdown {
+		return false
+	}
+	defer queue.Done(key)
+
+	err := syncHandler(key)
+	if err == nil {
+		queue.Forget(key)
+		return true
+	}
+
+	if dc.maxRequeueNum == -1 || queue.NumRequeues(key) < dc.maxRequeueNum {
+		klog.V(4).Infof("Error syncing %s for %v.", key, err)
+		queue.AddRateLimited(key)
+		return true
+	}
+
+	klog.Errorf("Dropping %s out of the queue for %v.", key, err)
+	queue.Forget(key)
+	return true
+}
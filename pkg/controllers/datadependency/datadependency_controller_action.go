@@ -0,0 +1,341 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datadependency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	datav1alpha1 "volcano.sh/apis/pkg/apis/datadependency/v1alpha1"
+)
+
+// syncDataSourceClaim binds the claim to a matched data source once its workload is observed, deletes the claim
+// once the observed workload is deleted, and sets the locality of the bound data source on the workload.
+func (dc *datadependencycontroller) syncDataSourceClaim(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	claim, err := dc.dataSourceClaimLister.DataSourceClaims(namespace).Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).Infof("DataSourceClaim %s has been deleted.", key)
+			return nil
+		}
+		return err
+	}
+	if claim.DeletionTimestamp != nil {
+		return nil
+	}
+	claim = claim.DeepCopy()
+
+	exists, err := dc.workloadExists(claim)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// The claim may be created ahead of its workload, it waits for the workload to show up.
+		if !isWorkloadObserved(claim) {
+			klog.V(4).Infof("Workload %s/%s of DataSourceClaim %s is not found yet, wait for it.",
+				claim.Spec.Workload.Kind, claim.Spec.Workload.Name, key)
+			return nil
+		}
+		// The bound data source is reclaimed according to its ReclaimPolicy once the claim is gone.
+		klog.V(3).Infof("Workload %s/%s of DataSourceClaim %s has been deleted, delete the claim.",
+			claim.Spec.Workload.Kind, claim.Spec.Workload.Name, key)
+		err := dc.vcClient.DatadependencyV1alpha1().DataSourceClaims(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	dataSource, err := dc.findDataSource(claim)
+	if err != nil {
+		return err
+	}
+
+	status := claim.Status.DeepCopy()
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               datav1alpha1.DSCConditionWorkloadObserved,
+		Status:             metav1.ConditionTrue,
+		Reason:             "WorkloadFound",
+		Message:            fmt.Sprintf("%s %s is found", claim.Spec.Workload.Kind, claim.Spec.Workload.Name),
+		ObservedGeneration: claim.Generation,
+	})
+	if dataSource == nil {
+		status.Phase = datav1alpha1.DSCPhasePending
+		status.BoundDataSource = ""
+	} else {
+		status.Phase = datav1alpha1.DSCPhaseBound
+		status.BoundDataSource = dataSource.Name
+	}
+
+	if !equality.Semantic.DeepEqual(claim.Status, *status) {
+		if dataSource != nil && claim.Status.BoundDataSource != dataSource.Name {
+			dc.recorder.Eventf(claim, v1.EventTypeNormal, "Bound", "Bound to DataSource %s", dataSource.Name)
+		}
+		claim.Status = *status
+		if _, err := dc.vcClient.DatadependencyV1alpha1().DataSourceClaims(namespace).UpdateStatus(context.TODO(), claim, metav1.UpdateOptions{}); err != nil {
+			klog.Errorf("Failed to update status of DataSourceClaim %s: %v", key, err)
+			return err
+		}
+	}
+
+	if dataSource == nil {
+		return nil
+	}
+	dc.dataSourceQueue.Add(dataSource.Name)
+
+	return dc.setWorkloadLocality(claim, getLocalityClusterNames(dataSource))
+}
+
+// findDataSource returns the data source bound to the claim, or the first matched data source by name if the claim is not bound yet.
+func (dc *datadependencycontroller) findDataSource(claim *datav1alpha1.DataSourceClaim) (*datav1alpha1.DataSource, error) {
+	if claim.Status.BoundDataSource != "" {
+		dataSource, err := dc.dataSourceLister.Get(claim.Status.BoundDataSource)
+		if err == nil && dataSource.DeletionTimestamp == nil {
+			return dataSource, nil
+		}
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		klog.V(3).Infof("DataSource %s bound by DataSourceClaim %s/%s is gone, rebind the claim.",
+			claim.Status.BoundDataSource, claim.Namespace, claim.Name)
+	}
+
+	dataSources, err := dc.dataSourceLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(dataSources, func(i, j int) bool {
+		return dataSources[i].Name < dataSources[j].Name
+	})
+	for _, dataSource := range dataSources {
+		if isDataSourceMatched(claim, dataSource) {
+			return dataSource, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// workloadExists checks whether the workload of the claim exists, only vcjob and podgroup are checked,
+// other workloads are always considered existing.
+func (dc *datadependencycontroller) workloadExists(claim *datav1alpha1.DataSourceClaim) (bool, error) {
+	kind, supported := getClaimWorkloadKind(claim)
+	if !supported {
+		return true, nil
+	}
+
+	var err error
+	namespace := claim.Namespace
+	switch kind {
+	case jobGroupKind:
+		_, err = dc.jobLister.Jobs(namespace).Get(claim.Spec.Workload.Name)
+	case podGroupGroupKind:
+		_, err = dc.podGroupLister.PodGroups(namespace).Get(claim.Spec.Workload.Name)
+	}
+
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// setWorkloadLocality sets the locality cluster names on the workload, and on the podgroups of a vcjob workload.
+// The annotation on the podgroup is consumed by the datalocality plugin of the scheduler to place the pods on the
+// nodes of the clusters where the data lives.
+func (dc *datadependencycontroller) setWorkloadLocality(claim *datav1alpha1.DataSourceClaim, clusterNames string) error {
+	kind, supported := getClaimWorkloadKind(claim)
+	if !supported {
+		return nil
+	}
+	return dc.patchWorkloadLocality(kind, claim.Namespace, claim.Spec.Workload.Name, &clusterNames)
+}
+
+// syncWorkload removes the locality from the workload once no bound claim refers to it, e.g. its claim is
+// unbound or deleted, so that the pods of the workload are no longer pinned to the clusters of stale data.
+func (dc *datadependencycontroller) syncWorkload(key string) error {
+	kind, namespace, name, err := splitWorkloadKey(key)
+	if err != nil {
+		return err
+	}
+
+	claims, err := dc.dataSourceClaimLister.DataSourceClaims(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, claim := range claims {
+		claimKind, supported := getClaimWorkloadKind(claim)
+		if supported && claimKind == kind && claim.Spec.Workload.Name == name &&
+			claim.DeletionTimestamp == nil && claim.Status.Phase == datav1alpha1.DSCPhaseBound {
+			klog.V(4).Infof("Workload %s is still referred by the bound DataSourceClaim %s/%s.", key, namespace, claim.Name)
+			return nil
+		}
+	}
+
+	err = dc.patchWorkloadLocality(kind, namespace, name, nil)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// patchWorkloadLocality sets the locality cluster names on the vcjob or podgroup workload, and on the podgroups
+// of a vcjob workload. The locality is removed if clusterNames is nil.
+func (dc *datadependencycontroller) patchWorkloadLocality(kind schema.GroupKind, namespace, name string, clusterNames *string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]*string{
+				datav1alpha1.DataLocalityClusterNamesAnnotationKey: clusterNames,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case jobGroupKind:
+		job, err := dc.jobLister.Jobs(namespace).Get(name)
+		if err != nil {
+			return err
+		}
+		if !isLocalityUpToDate(job.Annotations, clusterNames) {
+			if _, err := dc.vcClient.BatchV1alpha1().Jobs(namespace).Patch(context.TODO(), job.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+				return fmt.Errorf("failed to update data locality on Job %s/%s: %v", namespace, job.Name, err)
+			}
+		}
+
+		podGroups, err := dc.podGroupLister.PodGroups(namespace).List(labels.Everything())
+		if err != nil {
+			return err
+		}
+		for _, podGroup := range podGroups {
+			if !metav1.IsControlledBy(podGroup, job) || isLocalityUpToDate(podGroup.Annotations, clusterNames) {
+				continue
+			}
+			if _, err := dc.vcClient.SchedulingV1beta1().PodGroups(namespace).Patch(context.TODO(), podGroup.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+				return fmt.Errorf("failed to update data locality on PodGroup %s/%s: %v", namespace, podGroup.Name, err)
+			}
+		}
+	case podGroupGroupKind:
+		podGroup, err := dc.podGroupLister.PodGroups(namespace).Get(name)
+		if err != nil {
+			return err
+		}
+		if !isLocalityUpToDate(podGroup.Annotations, clusterNames) {
+			if _, err := dc.vcClient.SchedulingV1beta1().PodGroups(namespace).Patch(context.TODO(), podGroup.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+				return fmt.Errorf("failed to update data locality on PodGroup %s/%s: %v", namespace, podGroup.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// syncDataSource refreshes the claim references of the data source, and deletes the data source
+// with Delete reclaim policy once its last bound claim is gone.
+func (dc *datadependencycontroller) syncDataSource(name string) error {
+	dataSource, err := dc.dataSourceLister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).Infof("DataSource %s has been deleted.", name)
+			return nil
+		}
+		return err
+	}
+	if dataSource.DeletionTimestamp != nil {
+		return nil
+	}
+
+	claims, err := dc.dataSourceClaimLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var boundClaims []*datav1alpha1.DataSourceClaim
+	for _, claim := range claims {
+		if claim.DeletionTimestamp == nil && claim.Status.BoundDataSource == name {
+			boundClaims = append(boundClaims, claim)
+		}
+	}
+
+	if len(boundClaims) == 0 && len(dataSource.Status.ClaimRefs) != 0 && dataSource.Spec.ReclaimPolicy == datav1alpha1.ReclaimPolicyDelete {
+		// The lister may lag behind a claim just bound to the data source, check the claims by the api server
+		// before the data source is deleted.
+		bound, err := dc.isDataSourceBound(name)
+		if err != nil {
+			return err
+		}
+		if bound {
+			klog.V(4).Infof("DataSource %s is still bound by the api server, wait for the claim cache.", name)
+			return nil
+		}
+		klog.V(3).Infof("The last claim of DataSource %s is gone, delete it by reclaim policy.", name)
+		err = dc.vcClient.DatadependencyV1alpha1().DataSources().Delete(context.TODO(), name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &dataSource.UID, ResourceVersion: &dataSource.ResourceVersion},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	claimRefs := buildClaimRefs(boundClaims)
+	if equality.Semantic.DeepEqual(dataSource.Status.ClaimRefs, claimRefs) && dataSource.Status.BoundClaims == int32(len(claimRefs)) {
+		return nil
+	}
+
+	dataSource = dataSource.DeepCopy()
+	dataSource.Status.ClaimRefs = claimRefs
+	dataSource.Status.BoundClaims = int32(len(claimRefs))
+	if _, err := dc.vcClient.DatadependencyV1alpha1().DataSources().UpdateStatus(context.TODO(), dataSource, metav1.UpdateOptions{}); err != nil {
+		klog.Errorf("Failed to update status of DataSource %s: %v", name, err)
+		return err
+	}
+
+	return nil
+}
+
+// isDataSourceBound checks whether any claim is bound to the data source by the api server.
+func (dc *datadependencycontroller) isDataSourceBound(name string) (bool, error) {
+	claims, err := dc.vcClient.DatadependencyV1alpha1().DataSourceClaims(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+	for _, claim := range claims.Items {
+		if claim.DeletionTimestamp == nil && claim.Status.BoundDataSource == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
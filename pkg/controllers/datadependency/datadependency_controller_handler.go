@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datadependency
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	datav1alpha1 "volcano.sh/apis/pkg/apis/datadependency/v1alpha1"
+)
+
+func (dc *datadependencycontroller) enqueueDataSourceClaim(claim *datav1alpha1.DataSourceClaim) {
+	dc.claimQueue.Add(claim.Namespace + "/" + claim.Name)
+}
+
+func (dc *datadependencycontroller) addDataSource(obj interface{}) {
+	dataSource, ok := obj.(*datav1alpha1.DataSource)
+	if !ok {
+		klog.Errorf("Failed to convert %v to DataSource", obj)
+		return
+	}
+
+	dc.dataSourceQueue.Add(dataSource.Name)
+	dc.enqueueClaimsFor(dataSource)
+}
+
+func (dc *datadependencycontroller) updateDataSource(oldObj, newObj interface{}) {
+	oldDataSource, ok := oldObj.(*datav1alpha1.DataSource)
+	if !ok {
+		klog.Errorf("Failed to convert %v to DataSource", oldObj)
+		return
+	}
+	newDataSource, ok := newObj.(*datav1alpha1.DataSource)
+	if !ok {
+		klog.Errorf("Failed to convert %v to DataSource", newObj)
+		return
+	}
+
+	if newDataSource.ResourceVersion == oldDataSource.ResourceVersion {
+		return
+	}
+
+	dc.dataSourceQueue.Add(newDataSource.Name)
+	// The status is maintained by the controller itself, only spec changes affect the claims.
+	if !reflect.DeepEqual(oldDataSource.Spec, newDataSource.Spec) {
+		dc.enqueueClaimsFor(newDataSource)
+	}
+}
+
+func (dc *datadependencycontroller) deleteDataSource(obj interface{}) {
+	dataSource, ok := obj.(*datav1alpha1.DataSource)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Couldn't get object from tombstone %#v", obj)
+			return
+		}
+		dataSource, ok = tombstone.Obj.(*datav1alpha1.DataSource)
+		if !ok {
+			klog.Errorf("Tombstone contained object that is not a DataSource %#v", obj)
+			return
+		}
+	}
+
+	dc.enqueueClaimsFor(dataSource)
+}
+
+// enqueueClaimsFor enqueues the claims bound to the data source and the pending claims it may be bound to.
+func (dc *datadependencycontroller) enqueueClaimsFor(dataSource *datav1alpha1.DataSource) {
+	claims, err := dc.dataSourceClaimLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list DataSourceClaims: %v", err)
+		return
+	}
+
+	for _, claim := range claims {
+		if claim.Status.BoundDataSource == dataSource.Name ||
+			(claim.Status.BoundDataSource == "" && isDataSourceMatched(claim, dataSource)) {
+			dc.enqueueDataSourceClaim(claim)
+		}
+	}
+}
+
+func (dc *datadependencycontroller) addDataSourceClaim(obj interface{}) {
+	claim, ok := obj.(*datav1alpha1.DataSourceClaim)
+	if !ok {
+		klog.Errorf("Failed to convert %v to DataSourceClaim", obj)
+		return
+	}
+
+	dc.enqueueDataSourceClaim(claim)
+}
+
+func (dc *datadependencycontroller) updateDataSourceClaim(oldObj, newObj interface{}) {
+	oldClaim, ok := oldObj.(*datav1alpha1.DataSourceClaim)
+	if !ok {
+		klog.Errorf("Failed to convert %v to DataSourceClaim", oldObj)
+		return
+	}
+	newClaim, ok := newObj.(*datav1alpha1.DataSourceClaim)
+	if !ok {
+		klog.Errorf("Failed to convert %v to DataSourceClaim", newObj)
+		return
+	}
+
+	if newClaim.ResourceVersion == oldClaim.ResourceVersion {
+		return
+	}
+
+	dc.enqueueDataSourceClaim(newClaim)
+	// Refresh the claim references of both the previous and the current bound data sources.
+	if oldClaim.Status.BoundDataSource != "" {
+		dc.dataSourceQueue.Add(oldClaim.Status.BoundDataSource)
+	}
+	if newClaim.Status.BoundDataSource != "" {
+		dc.dataSourceQueue.Add(newClaim.Status.BoundDataSource)
+	}
+	// The locality is removed from the previous workload once the claim is unbound or refers to another workload.
+	if oldClaim.Status.Phase == datav1alpha1.DSCPhaseBound {
+		oldKey, oldSupported := workloadKey(oldClaim)
+		newKey, _ := workloadKey(newClaim)
+		if oldSupported && (newClaim.Status.Phase != datav1alpha1.DSCPhaseBound || newKey != oldKey) {
+			dc.workloadQueue.Add(oldKey)
+		}
+	}
+}
+
+func (dc *datadependencycontroller) deleteDataSourceClaim(obj interface{}) {
+	claim, ok := obj.(*datav1alpha1.DataSourceClaim)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Couldn't get object from tombstone %#v", obj)
+			return
+		}
+		claim, ok = tombstone.Obj.(*datav1alpha1.DataSourceClaim)
+		if !ok {
+			klog.Errorf("Tombstone contained object that is not a DataSourceClaim %#v", obj)
+			return
+		}
+	}
+
+	if claim.Status.BoundDataSource != "" {
+		dc.dataSourceQueue.Add(claim.Status.BoundDataSource)
+	}
+	if key, supported := workloadKey(claim); supported {
+		dc.workloadQueue.Add(key)
+	}
+}
+
+func (dc *datadependencycontroller) addWorkload(obj interface{}) {
+	dc.enqueueClaimsOfWorkload(obj)
+}
+
+func (dc *datadependencycontroller) deleteWorkload(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	dc.enqueueClaimsOfWorkload(obj)
+}
+
+// enqueueClaimsOfWorkload enqueues the claims referring to the vcjob or podgroup. The claims of the vcjob
+// controlling the podgroup are enqueued as well, so that the locality is set on the podgroup created after
+// the claim is bound.
+func (dc *datadependencycontroller) enqueueClaimsOfWorkload(obj interface{}) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		klog.Errorf("Failed to get accessor of %v: %v", obj, err)
+		return
+	}
+	kind, supported := getWorkloadKind(obj)
+	if !supported {
+		return
+	}
+	owner := metav1.GetControllerOf(accessor)
+	if owner != nil && schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind).GroupKind() != jobGroupKind {
+		owner = nil
+	}
+
+	claims, err := dc.dataSourceClaimLister.DataSourceClaims(accessor.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list DataSourceClaims: %v", err)
+		return
+	}
+
+	for _, claim := range claims {
+		claimKind, supported := getClaimWorkloadKind(claim)
+		if !supported {
+			continue
+		}
+		if (claimKind == kind && claim.Spec.Workload.Name == accessor.GetName()) ||
+			(kind == podGroupGroupKind && owner != nil && claimKind == jobGroupKind && claim.Spec.Workload.Name == owner.Name) {
+			dc.enqueueDataSourceClaim(claim)
+		}
+	}
+}
@@ -0,0 +1,448 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datadependency
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	kubeclient "k8s.io/client-go/kubernetes/fake"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	datav1alpha1 "volcano.sh/apis/pkg/apis/datadependency/v1alpha1"
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	volcanoclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	informerfactory "volcano.sh/apis/pkg/client/informers/externalversions"
+	"volcano.sh/volcano/pkg/controllers/framework"
+)
+
+func newFakeController() *datadependencycontroller {
+	volcanoClientSet := volcanoclient.NewSimpleClientset()
+	kubeClientSet := kubeclient.NewSimpleClientset()
+
+	sharedInformers := informers.NewSharedInformerFactory(kubeClientSet, 0)
+	vcSharedInformers := informerfactory.NewSharedInformerFactory(volcanoClientSet, 0)
+
+	controller := &datadependencycontroller{}
+	opt := &framework.ControllerOption{
+		VolcanoClient:           volcanoClientSet,
+		KubeClient:              kubeClientSet,
+		SharedInformerFactory:   sharedInformers,
+		VCSharedInformerFactory: vcSharedInformers,
+	}
+
+	controller.Initialize(opt)
+
+	return controller
+}
+
+func newDataSource(name string, attributes map[string]string, policy datav1alpha1.DataSourceReclaimPolicy, clusters ...string) *datav1alpha1.DataSource {
+	return &datav1alpha1.DataSource{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: datav1alpha1.DataSourceSpec{
+			System:        "hive",
+			Type:          "table",
+			Name:          "sales",
+			Attributes:    attributes,
+			Locality:      &datav1alpha1.DataSourceLocality{ClusterNames: clusters},
+			ReclaimPolicy: policy,
+		},
+	}
+}
+
+func newDataSourceClaim(name string, attributes map[string]string) *datav1alpha1.DataSourceClaim {
+	return &datav1alpha1.DataSourceClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: "claim-uid"},
+		Spec: datav1alpha1.DataSourceClaimSpec{
+			System:         "hive",
+			DataSourceType: "table",
+			DataSourceName: "sales",
+			Attributes:     attributes,
+			Workload: datav1alpha1.WorkloadRef{
+				APIVersion: batch.SchemeGroupVersion.String(),
+				Kind:       "Job",
+				Name:       "job",
+			},
+		},
+	}
+}
+
+func (dc *datadependencycontroller) addObjects(t *testing.T, objs ...interface{}) {
+	ctx := context.TODO()
+	for _, obj := range objs {
+		var err error
+		switch o := obj.(type) {
+		case *datav1alpha1.DataSource:
+			dc.dataSourceInformer.Informer().GetIndexer().Add(o)
+			_, err = dc.vcClient.DatadependencyV1alpha1().DataSources().Create(ctx, o, metav1.CreateOptions{})
+		case *datav1alpha1.DataSourceClaim:
+			dc.dataSourceClaimInformer.Informer().GetIndexer().Add(o)
+			_, err = dc.vcClient.DatadependencyV1alpha1().DataSourceClaims(o.Namespace).Create(ctx, o, metav1.CreateOptions{})
+		case *batch.Job:
+			dc.jobInformer.Informer().GetIndexer().Add(o)
+			_, err = dc.vcClient.BatchV1alpha1().Jobs(o.Namespace).Create(ctx, o, metav1.CreateOptions{})
+		case *scheduling.PodGroup:
+			dc.podGroupInformer.Informer().GetIndexer().Add(o)
+			_, err = dc.vcClient.SchedulingV1beta1().PodGroups(o.Namespace).Create(ctx, o, metav1.CreateOptions{})
+		}
+		if err != nil {
+			t.Fatalf("failed to create %v: %v", obj, err)
+		}
+	}
+}
+
+func TestSyncDataSourceClaim(t *testing.T) {
+	testCases := []struct {
+		name            string
+		dataSources     []*datav1alpha1.DataSource
+		claimAttributes map[string]string
+		expectedPhase   datav1alpha1.DSCPhase
+		expectedBound   string
+		expectedCluster string
+	}{
+		{
+			name:            "bind to the first matched data source",
+			dataSources:     []*datav1alpha1.DataSource{newDataSource("ds-b", nil, "", "c3"), newDataSource("ds-a", nil, "", "c2", "c1")},
+			expectedPhase:   datav1alpha1.DSCPhaseBound,
+			expectedBound:   "ds-a",
+			expectedCluster: "c1,c2",
+		},
+		{
+			name: "bind to the data source with matched attributes",
+			dataSources: []*datav1alpha1.DataSource{
+				newDataSource("ds-a", map[string]string{"region": "us"}, "", "c1"),
+				newDataSource("ds-b", map[string]string{"region": "eu", "format": "orc"}, "", "c2"),
+			},
+			claimAttributes: map[string]string{"region": "eu"},
+			expectedPhase:   datav1alpha1.DSCPhaseBound,
+			expectedBound:   "ds-b",
+			expectedCluster: "c2",
+		},
+		{
+			name:            "keep pending without matched data source",
+			dataSources:     []*datav1alpha1.DataSource{newDataSource("ds-a", map[string]string{"region": "us"}, "", "c1")},
+			claimAttributes: map[string]string{"region": "eu"},
+			expectedPhase:   datav1alpha1.DSCPhasePending,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dc := newFakeController()
+			job := &batch.Job{ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "default", UID: "job-uid"}}
+			podGroup := &scheduling.PodGroup{ObjectMeta: metav1.ObjectMeta{
+				Name:            "job-pg",
+				Namespace:       "default",
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(job, batch.SchemeGroupVersion.WithKind("Job"))},
+			}}
+			claim := newDataSourceClaim("claim", tc.claimAttributes)
+			dc.addObjects(t, job, podGroup, claim)
+			for _, ds := range tc.dataSources {
+				dc.addObjects(t, ds)
+			}
+
+			if err := dc.syncDataSourceClaim("default/claim"); err != nil {
+				t.Fatalf("sync claim failed: %v", err)
+			}
+
+			ctx := context.TODO()
+			claim, err := dc.vcClient.DatadependencyV1alpha1().DataSourceClaims("default").Get(ctx, "claim", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get claim: %v", err)
+			}
+			if claim.Status.Phase != tc.expectedPhase || claim.Status.BoundDataSource != tc.expectedBound {
+				t.Errorf("expected phase %s bound to %q, got %s bound to %q",
+					tc.expectedPhase, tc.expectedBound, claim.Status.Phase, claim.Status.BoundDataSource)
+			}
+
+			job, err = dc.vcClient.BatchV1alpha1().Jobs("default").Get(ctx, "job", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get job: %v", err)
+			}
+			if got := job.Annotations[datav1alpha1.DataLocalityClusterNamesAnnotationKey]; got != tc.expectedCluster {
+				t.Errorf("expected job locality %q, got %q", tc.expectedCluster, got)
+			}
+			podGroup, err = dc.vcClient.SchedulingV1beta1().PodGroups("default").Get(ctx, "job-pg", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get podgroup: %v", err)
+			}
+			if got := podGroup.Annotations[datav1alpha1.DataLocalityClusterNamesAnnotationKey]; got != tc.expectedCluster {
+				t.Errorf("expected podgroup locality %q, got %q", tc.expectedCluster, got)
+			}
+		})
+	}
+}
+
+func TestReclaimDataSource(t *testing.T) {
+	testCases := []struct {
+		name          string
+		policy        datav1alpha1.DataSourceReclaimPolicy
+		expectDeleted bool
+	}{
+		{
+			name:          "delete data source with Delete reclaim policy",
+			policy:        datav1alpha1.ReclaimPolicyDelete,
+			expectDeleted: true,
+		},
+		{
+			name:   "retain data source with Retain reclaim policy",
+			policy: datav1alpha1.ReclaimPolicyRetain,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dc := newFakeController()
+			ctx := context.TODO()
+
+			dataSource := newDataSource("ds", nil, tc.policy, "c1")
+			claim := newDataSourceClaim("claim", nil)
+			claim.Status = datav1alpha1.DataSourceClaimStatus{Phase: datav1alpha1.DSCPhaseBound, BoundDataSource: "ds"}
+			dc.addObjects(t, dataSource, claim)
+
+			if err := dc.syncDataSource("ds"); err != nil {
+				t.Fatalf("sync data source failed: %v", err)
+			}
+			dataSource, err := dc.vcClient.DatadependencyV1alpha1().DataSources().Get(ctx, "ds", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get data source: %v", err)
+			}
+			if dataSource.Status.BoundClaims != 1 || len(dataSource.Status.ClaimRefs) != 1 || dataSource.Status.ClaimRefs[0].Name != "claim" {
+				t.Fatalf("expected data source to refer to the claim, got %+v", dataSource.Status)
+			}
+			dc.dataSourceInformer.Informer().GetIndexer().Update(dataSource)
+
+			// The workload of the claim is gone, so the claim is deleted.
+			if err := dc.syncDataSourceClaim("default/claim"); err != nil {
+				t.Fatalf("sync claim failed: %v", err)
+			}
+			if _, err := dc.vcClient.DatadependencyV1alpha1().DataSourceClaims("default").Get(ctx, "claim", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+				t.Fatalf("expected claim to be deleted, got %v", err)
+			}
+			dc.dataSourceClaimInformer.Informer().GetIndexer().Delete(claim)
+
+			if err := dc.syncDataSource("ds"); err != nil {
+				t.Fatalf("sync data source failed: %v", err)
+			}
+			_, err = dc.vcClient.DatadependencyV1alpha1().DataSources().Get(ctx, "ds", metav1.GetOptions{})
+			if deleted := apierrors.IsNotFound(err); deleted != tc.expectDeleted {
+				t.Errorf("expected data source deleted %v, got %v", tc.expectDeleted, err)
+			}
+		})
+	}
+}
+
+func TestClaimCreatedBeforeWorkload(t *testing.T) {
+	dc := newFakeController()
+	ctx := context.TODO()
+
+	claim := newDataSourceClaim("claim", nil)
+	dc.addObjects(t, newDataSource("ds", nil, datav1alpha1.ReclaimPolicyDelete, "c1"), claim)
+
+	// The claim waits for its workload instead of being deleted.
+	if err := dc.syncDataSourceClaim("default/claim"); err != nil {
+		t.Fatalf("sync claim failed: %v", err)
+	}
+	claim, err := dc.vcClient.DatadependencyV1alpha1().DataSourceClaims("default").Get(ctx, "claim", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected claim to be kept before its workload is created, got %v", err)
+	}
+	if claim.Status.BoundDataSource != "" {
+		t.Errorf("expected claim not to be bound before its workload is created, got %q", claim.Status.BoundDataSource)
+	}
+
+	dc.addObjects(t, &batch.Job{ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "default", UID: "job-uid"}})
+	if err := dc.syncDataSourceClaim("default/claim"); err != nil {
+		t.Fatalf("sync claim failed: %v", err)
+	}
+	claim, err = dc.vcClient.DatadependencyV1alpha1().DataSourceClaims("default").Get(ctx, "claim", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get claim: %v", err)
+	}
+	if claim.Status.Phase != datav1alpha1.DSCPhaseBound || !isWorkloadObserved(claim) {
+		t.Fatalf("expected claim to be bound with its workload observed, got %+v", claim.Status)
+	}
+
+	// The claim is deleted once the observed workload is gone.
+	dc.dataSourceClaimInformer.Informer().GetIndexer().Update(claim)
+	dc.jobInformer.Informer().GetIndexer().Delete(&batch.Job{ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "default"}})
+	if err := dc.syncDataSourceClaim("default/claim"); err != nil {
+		t.Fatalf("sync claim failed: %v", err)
+	}
+	if _, err := dc.vcClient.DatadependencyV1alpha1().DataSourceClaims("default").Get(ctx, "claim", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected claim to be deleted once its workload is gone, got %v", err)
+	}
+}
+
+func TestPodGroupOfJobEnqueuesClaims(t *testing.T) {
+	dc := newFakeController()
+
+	job := &batch.Job{ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "default", UID: "job-uid"}}
+	dc.addObjects(t, newDataSourceClaim("claim", nil), job)
+
+	// The podgroup created for the job after the claim is bound gets the locality by the claim of the job.
+	dc.addWorkload(&scheduling.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "job-job-uid",
+			Namespace:       "default",
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(job, batch.SchemeGroupVersion.WithKind("Job"))},
+		},
+	})
+	if dc.claimQueue.Len() != 1 {
+		t.Fatalf("expected the claim of the job to be enqueued, got %d", dc.claimQueue.Len())
+	}
+	if key, _ := dc.claimQueue.Get(); key != "default/claim" {
+		t.Errorf("expected claim default/claim to be enqueued, got %s", key)
+	}
+}
+
+func TestWorkloadInClaimNamespace(t *testing.T) {
+	dc := newFakeController()
+	ctx := context.TODO()
+
+	// The job of another tenant with the name of the workload is never annotated by the claim.
+	claim := newDataSourceClaim("claim", nil)
+	claim.Spec.Workload.Namespace = "other"
+	dc.addObjects(t, newDataSource("ds", nil, "", "c1"), claim,
+		&batch.Job{ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "other", UID: "other-job-uid"}})
+
+	if err := dc.syncDataSourceClaim("default/claim"); err != nil {
+		t.Fatalf("sync claim failed: %v", err)
+	}
+	job, err := dc.vcClient.BatchV1alpha1().Jobs("other").Get(ctx, "job", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get job: %v", err)
+	}
+	if got, found := job.Annotations[datav1alpha1.DataLocalityClusterNamesAnnotationKey]; found {
+		t.Errorf("expected job in another namespace not to be annotated, got %q", got)
+	}
+	claim, err = dc.vcClient.DatadependencyV1alpha1().DataSourceClaims("default").Get(ctx, "claim", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get claim: %v", err)
+	}
+	if claim.Status.BoundDataSource != "" {
+		t.Errorf("expected claim not to be bound without a workload in its namespace, got %q", claim.Status.BoundDataSource)
+	}
+}
+
+func TestWorkloadLocalityRemovedWithClaim(t *testing.T) {
+	testCases := []struct {
+		name         string
+		removeClaim  func(dc *datadependencycontroller, claim *datav1alpha1.DataSourceClaim)
+		keepLocality bool
+	}{
+		{
+			name: "claim unbound",
+			removeClaim: func(dc *datadependencycontroller, claim *datav1alpha1.DataSourceClaim) {
+				unbound := claim.DeepCopy()
+				unbound.ResourceVersion = "2"
+				unbound.Status = datav1alpha1.DataSourceClaimStatus{Phase: datav1alpha1.DSCPhasePending}
+				dc.dataSourceClaimInformer.Informer().GetIndexer().Update(unbound)
+				dc.updateDataSourceClaim(claim, unbound)
+			},
+		},
+		{
+			name: "claim deleted",
+			removeClaim: func(dc *datadependencycontroller, claim *datav1alpha1.DataSourceClaim) {
+				dc.dataSourceClaimInformer.Informer().GetIndexer().Delete(claim)
+				dc.deleteDataSourceClaim(claim)
+			},
+		},
+		{
+			name: "claim deleted while another claim is still bound",
+			removeClaim: func(dc *datadependencycontroller, claim *datav1alpha1.DataSourceClaim) {
+				another := newDataSourceClaim("another", nil)
+				another.Status = claim.Status
+				dc.dataSourceClaimInformer.Informer().GetIndexer().Add(another)
+				dc.dataSourceClaimInformer.Informer().GetIndexer().Delete(claim)
+				dc.deleteDataSourceClaim(claim)
+			},
+			keepLocality: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dc := newFakeController()
+			ctx := context.TODO()
+
+			locality := map[string]string{datav1alpha1.DataLocalityClusterNamesAnnotationKey: "c1"}
+			job := &batch.Job{ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "default", UID: "job-uid", Annotations: locality}}
+			podGroup := &scheduling.PodGroup{ObjectMeta: metav1.ObjectMeta{
+				Name:            "job-pg",
+				Namespace:       "default",
+				Annotations:     locality,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(job, batch.SchemeGroupVersion.WithKind("Job"))},
+			}}
+			claim := newDataSourceClaim("claim", nil)
+			claim.ResourceVersion = "1"
+			claim.Status = datav1alpha1.DataSourceClaimStatus{Phase: datav1alpha1.DSCPhaseBound, BoundDataSource: "ds"}
+			dc.addObjects(t, job, podGroup, claim)
+
+			tc.removeClaim(dc, claim)
+			if dc.workloadQueue.Len() != 1 {
+				t.Fatalf("expected the workload of the claim to be enqueued, got %d", dc.workloadQueue.Len())
+			}
+			key, _ := dc.workloadQueue.Get()
+			if err := dc.syncWorkload(key); err != nil {
+				t.Fatalf("sync workload failed: %v", err)
+			}
+
+			job, err := dc.vcClient.BatchV1alpha1().Jobs("default").Get(ctx, "job", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get job: %v", err)
+			}
+			podGroup, err = dc.vcClient.SchedulingV1beta1().PodGroups("default").Get(ctx, "job-pg", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get podgroup: %v", err)
+			}
+			for _, annotations := range []map[string]string{job.Annotations, podGroup.Annotations} {
+				if _, found := annotations[datav1alpha1.DataLocalityClusterNamesAnnotationKey]; found != tc.keepLocality {
+					t.Errorf("expected locality kept %v, got annotations %v", tc.keepLocality, annotations)
+				}
+			}
+		})
+	}
+}
+
+func TestReclaimDataSourceWithStaleClaimCache(t *testing.T) {
+	dc := newFakeController()
+	ctx := context.TODO()
+
+	dataSource := newDataSource("ds", nil, datav1alpha1.ReclaimPolicyDelete, "c1")
+	dataSource.Status.ClaimRefs = []v1.ObjectReference{{Namespace: "default", Name: "old-claim"}}
+	dataSource.Status.BoundClaims = 1
+	dc.addObjects(t, dataSource)
+
+	// The claim just bound to the data source is not in the claim cache yet.
+	claim := newDataSourceClaim("claim", nil)
+	claim.Status = datav1alpha1.DataSourceClaimStatus{Phase: datav1alpha1.DSCPhaseBound, BoundDataSource: "ds"}
+	if _, err := dc.vcClient.DatadependencyV1alpha1().DataSourceClaims("default").Create(ctx, claim, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("failed to create claim: %v", err)
+	}
+
+	if err := dc.syncDataSource("ds"); err != nil {
+		t.Fatalf("sync data source failed: %v", err)
+	}
+	if _, err := dc.vcClient.DatadependencyV1alpha1().DataSources().Get(ctx, "ds", metav1.GetOptions{}); err != nil {
+		t.Errorf("expected data source bound by the api server to be kept, got %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datadependency
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	datav1alpha1 "volcano.sh/apis/pkg/apis/datadependency/v1alpha1"
+	scheduling "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+var (
+	jobGroupKind      = batch.SchemeGroupVersion.WithKind("Job").GroupKind()
+	podGroupGroupKind = scheduling.SchemeGroupVersion.WithKind("PodGroup").GroupKind()
+
+	dataSourceClaimKind = datav1alpha1.SchemeGroupVersion.WithKind("DataSourceClaim")
+)
+
+// isDataSourceMatched checks whether the data source satisfies the claim, all the attributes
+// of the claim must be present in the data source with the same value.
+func isDataSourceMatched(claim *datav1alpha1.DataSourceClaim, dataSource *datav1alpha1.DataSource) bool {
+	if dataSource.DeletionTimestamp != nil {
+		return false
+	}
+	if claim.Spec.System != dataSource.Spec.System ||
+		claim.Spec.DataSourceType != dataSource.Spec.Type ||
+		claim.Spec.DataSourceName != dataSource.Spec.Name {
+		return false
+	}
+
+	for key, value := range claim.Spec.Attributes {
+		if dsValue, found := dataSource.Spec.Attributes[key]; !found || dsValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// getClaimWorkloadKind returns the group kind of the claim workload, and whether it is supported by the controller.
+func getClaimWorkloadKind(claim *datav1alpha1.DataSourceClaim) (schema.GroupKind, bool) {
+	gv, err := schema.ParseGroupVersion(claim.Spec.Workload.APIVersion)
+	if err != nil {
+		return schema.GroupKind{}, false
+	}
+	gk := schema.GroupKind{Group: gv.Group, Kind: claim.Spec.Workload.Kind}
+	return gk, gk == jobGroupKind || gk == podGroupGroupKind
+}
+
+// getWorkloadKind returns the group kind of the vcjob or podgroup object.
+func getWorkloadKind(obj interface{}) (schema.GroupKind, bool) {
+	switch obj.(type) {
+	case *batch.Job:
+		return jobGroupKind, true
+	case *scheduling.PodGroup:
+		return podGroupGroupKind, true
+	}
+	return schema.GroupKind{}, false
+}
+
+// buildClaimRefs builds the sorted object references of the claims.
+func buildClaimRefs(claims []*datav1alpha1.DataSourceClaim) []v1.ObjectReference {
+	var refs []v1.ObjectReference
+	for _, claim := range claims {
+		refs = append(refs, v1.ObjectReference{
+			APIVersion: dataSourceClaimKind.GroupVersion().String(),
+			Kind:       dataSourceClaimKind.Kind,
+			Namespace:  claim.Namespace,
+			Name:       claim.Name,
+			UID:        claim.UID,
+		})
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Namespace != refs[j].Namespace {
+			return refs[i].Namespace < refs[j].Namespace
+		}
+		return refs[i].Name < refs[j].Name
+	})
+	return refs
+}
+
+// getLocalityClusterNames returns the sorted, comma separated cluster names of the data source.
+func getLocalityClusterNames(dataSource *datav1alpha1.DataSource) string {
+	if dataSource.Spec.Locality == nil {
+		return ""
+	}
+	clusterNames := append([]string(nil), dataSource.Spec.Locality.ClusterNames...)
+	sort.Strings(clusterNames)
+	return strings.Join(clusterNames, ",")
+}
+
+// isWorkloadObserved checks whether the workload of the claim has been observed, a bound claim must have observed it.
+func isWorkloadObserved(claim *datav1alpha1.DataSourceClaim) bool {
+	return claim.Status.Phase == datav1alpha1.DSCPhaseBound ||
+		meta.IsStatusConditionTrue(claim.Status.Conditions, datav1alpha1.DSCConditionWorkloadObserved)
+}
+
+// isLocalityUpToDate checks whether the locality annotation is already the expected cluster names,
+// or already absent if clusterNames is nil.
+func isLocalityUpToDate(annotations map[string]string, clusterNames *string) bool {
+	value, found := annotations[datav1alpha1.DataLocalityClusterNamesAnnotationKey]
+	if clusterNames == nil {
+		return !found
+	}
+	return found && value == *clusterNames
+}
+
+// workloadKey returns the key of the workload of the claim in the workload queue.
+func workloadKey(claim *datav1alpha1.DataSourceClaim) (string, bool) {
+	kind, supported := getClaimWorkloadKind(claim)
+	if !supported {
+		return "", false
+	}
+	return strings.Join([]string{kind.String(), claim.Namespace, claim.Spec.Workload.Name}, "/"), true
+}
+
+// splitWorkloadKey returns the group kind, namespace and name of the workload from its key.
+func splitWorkloadKey(key string) (schema.GroupKind, string, string, error) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 3 {
+		return schema.GroupKind{}, "", "", fmt.Errorf("unexpected workload key format: %q", key)
+	}
+	return schema.ParseGroupKind(parts[0]), parts[1], parts[2], nil
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hyperjob
+
+const (
+	// HyperJobNameLabelKey the vcjob label of the hyperjob it is created by
+	HyperJobNameLabelKey = "training.volcano.sh/hyperjob-name"
+	// ReplicatedJobNameLabelKey the vcjob label of the replicated job it is created from
+	ReplicatedJobNameLabelKey = "training.volcano.sh/replicatedjob-name"
+	// ReplicatedJobIndexLabelKey the vcjob label of its index in the replicated job
+	ReplicatedJobIndexLabelKey = "training.volcano.sh/replicatedjob-index"
+
+	// MinAvailableNotSatisfiedReason the reason of the Failed condition when too many vcjobs failed
+	MinAvailableNotSatisfiedReason = "MinAvailableNotSatisfied"
+	// JobsCompletedReason the reason of the Completed condition
+	JobsCompletedReason = "JobsCompleted"
+)
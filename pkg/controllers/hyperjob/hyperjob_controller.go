@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hyperjob
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	trainingv1alpha1 "volcano.sh/apis/pkg/apis/training/v1alpha1"
+	vcclientset "volcano.sh/apis/pkg/client/clientset/versioned"
+	vcscheme "volcano.sh/apis/pkg/client/clientset/versioned/scheme"
+	vcinformer "volcano.sh/apis/pkg/client/informers/externalversions"
+	batchinformer "volcano.sh/apis/pkg/client/informers/externalversions/batch/v1alpha1"
+	topologyinformer "volcano.sh/apis/pkg/client/informers/externalversions/topology/v1alpha1"
+	traininginformer "volcano.sh/apis/pkg/client/informers/externalversions/training/v1alpha1"
+	batchlister "volcano.sh/apis/pkg/client/listers/batch/v1alpha1"
+	topologylister "volcano.sh/apis/pkg/client/listers/topology/v1alpha1"
+	traininglister "volcano.sh/apis/pkg/client/listers/training/v1alpha1"
+	"volcano.sh/volcano/pkg/controllers/framework"
+)
+
+var controllerKind = trainingv1alpha1.SchemeGroupVersion.WithKind("HyperJob")
+
+func init() {
+	framework.RegisterController(&hyperjobcontroller{})
+}
+
+// hyperjobcontroller the HyperJob controller type, it fans out every replicated job
+// of a HyperJob into volcano jobs and aggregates their status.
+type hyperjobcontroller struct {
+	kubeClient kubernetes.Interface
+	vcClient   vcclientset.Interface
+
+	hyperJobInformer  traininginformer.HyperJobInformer
+	jobInformer       batchinformer.JobInformer
+	nodeInformer      coreinformers.NodeInformer
+	hyperNodeInformer topologyinformer.HyperNodeInformer
+
+	informerFactory   informers.SharedInformerFactory
+	vcInformerFactory vcinformer.SharedInformerFactory
+
+	hyperJobLister traininglister.HyperJobLister
+	hyperJobSynced cache.InformerSynced
+
+	jobLister batchlister.JobLister
+	jobSynced cache.InformerSynced
+
+	// nodeLister and hyperNodeLister are used to split the replicated jobs in auto mode
+	nodeLister      corelisters.NodeLister
+	hyperNodeLister topologylister.HyperNodeLister
+
+	// HyperJob Event recorder
+	recorder record.EventRecorder
+
+	queue   workqueue.TypedRateLimitingInterface[string]
+	workers uint32
+
+	syncHandler func(key string) error
+
+	maxRequeueNum int
+}
+
+func (hc *hyperjobcontroller) Name() string {
+	return "hyperjob-controller"
+}
+
+func (hc *hyperjobcontroller) Initialize(opt *framework.ControllerOption) error {
+	hc.kubeClient = opt.KubeClient
+	hc.vcClient = opt.VolcanoClient
+
+	factory := opt.VCSharedInformerFactory
+	hc.vcInformerFactory = factory
+
+	hc.hyperJobInformer = factory.Training().V1alpha1().HyperJobs()
+	hc.hyperJobSynced = hc.hyperJobInformer.Informer().HasSynced
+	hc.hyperJobLister = hc.hyperJobInformer.Lister()
+	hc.hyperJobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    hc.addHyperJob,
+		UpdateFunc: hc.updateHyperJob,
+	})
+
+	hc.jobInformer = factory.Batch().V1alpha1().Jobs()
+	hc.jobSynced = hc.jobInformer.Informer().HasSynced
+	hc.jobLister = hc.jobInformer.Lister()
+	hc.jobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    hc.addJob,
+		UpdateFunc: hc.updateJob,
+		DeleteFunc: hc.deleteJob,
+	})
+
+	hc.hyperNodeInformer = factory.Topology().V1alpha1().HyperNodes()
+	hc.hyperNodeLister = hc.hyperNodeInformer.Lister()
+
+	hc.informerFactory = opt.SharedInformerFactory
+	hc.nodeInformer = hc.informerFactory.Core().V1().Nodes()
+	hc.nodeLister = hc.nodeInformer.Lister()
+
+	hc.workers = opt.WorkerNum
+	if hc.workers == 0 {
+		hc.workers = 1
+	}
+
+	hc.maxRequeueNum = opt.MaxRequeueNum
+	if hc.maxRequeueNum < 0 {
+		hc.maxRequeueNum = -1
+	}
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartLogging(klog.Infof)
+	eventBroadcaster.StartRecordingToSink(&corev1.EventSinkImpl{Interface: hc.kubeClient.CoreV1().Events("")})
+	hc.recorder = eventBroadcaster.NewRecorder(vcscheme.Scheme, v1.EventSource{Component: "vc-controller-manager"})
+
+	hc.queue = workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())
+	hc.syncHandler = hc.sync
+
+	return nil
+}
+
+func (hc *hyperjobcontroller) Run(stopCh <-chan struct{}) {
+	defer hc.queue.ShutDown()
+
+	hc.informerFactory.Start(stopCh)
+	hc.vcInformerFactory.Start(stopCh)
+	for informerType, ok := range hc.informerFactory.WaitForCacheSync(stopCh) {
+		if !ok {
+			klog.Errorf("caches failed to sync: %v", informerType)
+			return
+		}
+	}
+	for informerType, ok := range hc.vcInformerFactory.WaitForCacheSync(stopCh) {
+		if !ok {
+			klog.Errorf("caches failed to sync: %v", informerType)
+			return
+		}
+	}
+
+	for i := 0; i < int(hc.workers); i++ {
+		go wait.Until(hc.worker, time.Second, stopCh)
+	}
+
+	klog.Infof("HyperJobController is running ...... ")
+
+	<-stopCh
+}
+
+func (hc *hyperjobcontroller) worker() {
+	for hc.processNextWorkItem() {
+	}
+}
+
+func (hc *hyperjobcontroller) processNextWorkItem() bool {
+	key, shutdown := hc.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer hc.queue.Done(key)
+
+	err := hc.syncHandler(key)
+	hc.handleHyperJobErr(err, key)
+
+	return true
+}
+
+func (hc *hyperjobcontroller) handleHyperJobErr(err error, key string) {
+	if err == nil {
+		hc.queue.Forget(key)
+		return
+	}
+
+	if hc.maxRequeueNum == -1 || hc.queue.NumRequeues(key) < hc.maxRequeueNum {
+		klog.V(4).Infof("Error syncing HyperJob %s for %v.", key, err)
+		hc.queue.AddRateLimited(key)
+		return
+	}
+
+	klog.Errorf("Dropping HyperJob %s out of the queue for %v.", key, err)
+	hc.queue.Forget(key)
+}
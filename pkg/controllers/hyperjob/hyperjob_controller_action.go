@@ -0,0 +1,303 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hyperjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	trainingv1alpha1 "volcano.sh/apis/pkg/apis/training/v1alpha1"
+)
+
+func (hc *hyperjobcontroller) sync(key string) error {
+	startTime := time.Now()
+	defer func() {
+		klog.V(4).Infof("Finished syncing HyperJob %s (%v).", key, time.Since(startTime))
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	hyperJob, err := hc.hyperJobLister.HyperJobs(namespace).Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).Infof("HyperJob %s has been deleted.", key)
+			return nil
+		}
+		return fmt.Errorf("get HyperJob %s failed for %v", key, err)
+	}
+
+	// The vcjobs are garbage collected by owner references once the hyperjob is deleted.
+	if hyperJob.DeletionTimestamp != nil {
+		klog.V(4).Infof("HyperJob %s is terminating, skip sync.", key)
+		return nil
+	}
+
+	// Finished hyperjob keeps the status of the last sync.
+	if isHyperJobFinished(hyperJob) {
+		return nil
+	}
+
+	return hc.syncHyperJob(hyperJob.DeepCopy())
+}
+
+func (hc *hyperjobcontroller) syncHyperJob(hyperJob *trainingv1alpha1.HyperJob) error {
+	klog.V(4).Infof("Begin to sync HyperJob %s/%s.", hyperJob.Namespace, hyperJob.Name)
+	defer klog.V(4).Infof("End sync HyperJob %s/%s.", hyperJob.Namespace, hyperJob.Name)
+
+	jobs, err := hc.getJobsByHyperJob(hyperJob)
+	if err != nil {
+		return err
+	}
+
+	replicatedJobsStatus := hyperJob.Status.DeepCopy().ReplicatedJobsStatus
+	desiredJobs, err := hc.getDesiredJobs(hyperJob)
+	if err != nil {
+		hc.recorder.Eventf(hyperJob, corev1.EventTypeWarning, "FailedSplit", "Failed to get desired jobs: %v", err)
+		return err
+	}
+
+	// The split decisions are persisted before the split vcjobs are created, so that the missing vcjobs,
+	// e.g. failed to create or deleted, are rebuilt by the same decisions.
+	if !equality.Semantic.DeepEqual(replicatedJobsStatus, hyperJob.Status.ReplicatedJobsStatus) {
+		newHyperJob, err := hc.vcClient.TrainingV1alpha1().HyperJobs(hyperJob.Namespace).UpdateStatus(context.TODO(), hyperJob, metav1.UpdateOptions{})
+		if err != nil {
+			klog.Errorf("Failed to record split decisions of HyperJob %s/%s: %v", hyperJob.Namespace, hyperJob.Name, err)
+			return err
+		}
+		hyperJob = newHyperJob
+	}
+
+	// delete the vcjobs which are no longer desired, e.g. the replicas of a replicated job is scaled down.
+	existingJobs := make(map[string]*batch.Job, len(jobs))
+	for _, job := range jobs {
+		if _, found := desiredJobs[job.Name]; found {
+			existingJobs[job.Name] = job
+			continue
+		}
+		if err := hc.deleteVcJob(hyperJob, job); err != nil {
+			return err
+		}
+	}
+
+	for _, job := range desiredJobs {
+		if _, found := existingJobs[job.Name]; found {
+			continue
+		}
+		if err := hc.createVcJob(hyperJob, job); err != nil {
+			return err
+		}
+	}
+
+	status := calculateHyperJobStatus(hyperJob, desiredJobs, existingJobs)
+
+	// Terminate the remaining vcjobs once the hyperjob can not succeed anymore.
+	if meta.IsStatusConditionTrue(status.Conditions, trainingv1alpha1.HyperJobConditionFailed) {
+		for _, job := range existingJobs {
+			if isJobFinished(job) {
+				continue
+			}
+			if err := hc.deleteVcJob(hyperJob, job); err != nil {
+				return err
+			}
+		}
+	}
+
+	if equality.Semantic.DeepEqual(hyperJob.Status, *status) {
+		return nil
+	}
+
+	hyperJob.Status = *status
+	if _, err := hc.vcClient.TrainingV1alpha1().HyperJobs(hyperJob.Namespace).UpdateStatus(context.TODO(), hyperJob, metav1.UpdateOptions{}); err != nil {
+		klog.Errorf("Failed to update status of HyperJob %s/%s: %v", hyperJob.Namespace, hyperJob.Name, err)
+		return err
+	}
+
+	return nil
+}
+
+// getDesiredJobs returns all the vcjobs the hyperjob should have, keyed by the job name.
+// A replicated job in auto split mode is split only once, the split decision is recorded in the hyperjob status
+// so that the vcjobs are not re-split when the cluster changes.
+func (hc *hyperjobcontroller) getDesiredJobs(hyperJob *trainingv1alpha1.HyperJob) (map[string]*batch.Job, error) {
+	desiredJobs := make(map[string]*batch.Job)
+	for i := range hyperJob.Spec.ReplicatedJobs {
+		replicatedJob := &hyperJob.Spec.ReplicatedJobs[i]
+
+		var replicatedJobs []*batch.Job
+		if replicatedJob.SplitPolicy != nil && replicatedJob.SplitPolicy.Mode == trainingv1alpha1.SplitModeAuto {
+			result := getSplitResult(hyperJob, replicatedJob.Name)
+			if result == nil {
+				var err error
+				if result, err = hc.splitReplicatedJob(hyperJob, replicatedJob); err != nil {
+					return nil, err
+				}
+				setSplitResult(hyperJob, replicatedJob.Name, result)
+			}
+			splitJobs, err := buildSplitJobs(hyperJob, replicatedJob, result)
+			if err != nil {
+				return nil, err
+			}
+			replicatedJobs = splitJobs
+		} else {
+			for index := 0; index < int(replicatedJob.Replicas); index++ {
+				replicatedJobs = append(replicatedJobs, buildJob(hyperJob, replicatedJob, index))
+			}
+		}
+
+		for _, job := range replicatedJobs {
+			if _, found := desiredJobs[job.Name]; found {
+				return nil, fmt.Errorf("duplicated job name %s in HyperJob %s/%s", job.Name, hyperJob.Namespace, hyperJob.Name)
+			}
+			desiredJobs[job.Name] = job
+		}
+	}
+
+	return desiredJobs, nil
+}
+
+func (hc *hyperjobcontroller) createVcJob(hyperJob *trainingv1alpha1.HyperJob, job *batch.Job) error {
+	if _, err := hc.vcClient.BatchV1alpha1().Jobs(job.Namespace).Create(context.TODO(), job, metav1.CreateOptions{}); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		hc.recorder.Eventf(hyperJob, corev1.EventTypeWarning, "FailedCreate", "Failed to create job %s: %v", job.Name, err)
+		return err
+	}
+
+	hc.recorder.Eventf(hyperJob, corev1.EventTypeNormal, "SuccessfulCreate", "Created job %s", job.Name)
+	return nil
+}
+
+func (hc *hyperjobcontroller) deleteVcJob(hyperJob *trainingv1alpha1.HyperJob, job *batch.Job) error {
+	propagationPolicy := metav1.DeletePropagationBackground
+	if err := hc.vcClient.BatchV1alpha1().Jobs(job.Namespace).Delete(context.TODO(), job.Name, metav1.DeleteOptions{
+		PropagationPolicy: &propagationPolicy,
+	}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		hc.recorder.Eventf(hyperJob, corev1.EventTypeWarning, "FailedDelete", "Failed to delete job %s: %v", job.Name, err)
+		return err
+	}
+
+	hc.recorder.Eventf(hyperJob, corev1.EventTypeNormal, "SuccessfulDelete", "Deleted job %s", job.Name)
+	return nil
+}
+
+// getJobsByHyperJob returns the vcjobs controlled by the hyperjob.
+func (hc *hyperjobcontroller) getJobsByHyperJob(hyperJob *trainingv1alpha1.HyperJob) ([]*batch.Job, error) {
+	jobs, err := hc.jobLister.Jobs(hyperJob.Namespace).List(hyperJobSelector(hyperJob))
+	if err != nil {
+		return nil, err
+	}
+
+	controlled := make([]*batch.Job, 0, len(jobs))
+	for _, job := range jobs {
+		if metav1.IsControlledBy(job, hyperJob) {
+			controlled = append(controlled, job)
+		}
+	}
+	return controlled, nil
+}
+
+// calculateHyperJobStatus aggregates the status of the vcjobs into the hyperjob status,
+// and sets the terminal conditions according to MinAvailable.
+func calculateHyperJobStatus(hyperJob *trainingv1alpha1.HyperJob, desiredJobs, existingJobs map[string]*batch.Job) *trainingv1alpha1.HyperJobStatus {
+	status := &trainingv1alpha1.HyperJobStatus{
+		Conditions:         append([]metav1.Condition(nil), hyperJob.Status.Conditions...),
+		SplitCount:         ptr.To(int32(len(desiredJobs))),
+		ObservedGeneration: hyperJob.Generation,
+	}
+
+	for _, replicatedJob := range hyperJob.Spec.ReplicatedJobs {
+		replicatedJobStatus := trainingv1alpha1.ReplicatedJobStatus{
+			Name: replicatedJob.Name,
+		}
+		if status := findReplicatedJobStatus(hyperJob.Status.ReplicatedJobsStatus, replicatedJob.Name); status != nil {
+			replicatedJobStatus.SplitReplicas = status.SplitReplicas
+			replicatedJobStatus.SplitTier = status.SplitTier
+		}
+		for _, job := range existingJobs {
+			if job.Labels[ReplicatedJobNameLabelKey] != replicatedJob.Name {
+				continue
+			}
+			if replicatedJobStatus.JobStates == nil {
+				replicatedJobStatus.JobStates = make(map[string]batch.JobState)
+			}
+			replicatedJobStatus.JobStates[job.Name] = job.Status.State
+			replicatedJobStatus.Pending += job.Status.Pending
+			replicatedJobStatus.Running += job.Status.Running
+			replicatedJobStatus.Succeeded += job.Status.Succeeded
+			replicatedJobStatus.Failed += job.Status.Failed
+			replicatedJobStatus.Terminating += job.Status.Terminating
+			replicatedJobStatus.Unknown += job.Status.Unknown
+		}
+		status.ReplicatedJobsStatus = append(status.ReplicatedJobsStatus, replicatedJobStatus)
+	}
+
+	total := int32(len(desiredJobs))
+	minAvailable := getMinAvailable(hyperJob, total)
+
+	var completed, failed int32
+	for name := range desiredJobs {
+		job, found := existingJobs[name]
+		if !found {
+			continue
+		}
+		switch job.Status.State.Phase {
+		case batch.Completed:
+			completed++
+		case batch.Failed, batch.Aborted, batch.Terminated:
+			failed++
+		}
+	}
+
+	switch {
+	case total-failed < minAvailable:
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               trainingv1alpha1.HyperJobConditionFailed,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: hyperJob.Generation,
+			Reason:             MinAvailableNotSatisfiedReason,
+			Message:            fmt.Sprintf("%d/%d jobs failed, minAvailable is %d", failed, total, minAvailable),
+		})
+	case total > 0 && completed+failed == total:
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               trainingv1alpha1.HyperJobConditionCompleted,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: hyperJob.Generation,
+			Reason:             JobsCompletedReason,
+			Message:            fmt.Sprintf("%d/%d jobs completed, minAvailable is %d", completed, total, minAvailable),
+		})
+	}
+
+	return status
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hyperjob
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	trainingv1alpha1 "volcano.sh/apis/pkg/apis/training/v1alpha1"
+)
+
+func (hc *hyperjobcontroller) enqueue(namespace, name string) {
+	hc.queue.Add(namespace + "/" + name)
+}
+
+func (hc *hyperjobcontroller) addHyperJob(obj interface{}) {
+	hyperJob, ok := obj.(*trainingv1alpha1.HyperJob)
+	if !ok {
+		klog.Errorf("Failed to convert %v to HyperJob", obj)
+		return
+	}
+
+	hc.enqueue(hyperJob.Namespace, hyperJob.Name)
+}
+
+func (hc *hyperjobcontroller) updateHyperJob(oldObj, newObj interface{}) {
+	oldHyperJob, ok := oldObj.(*trainingv1alpha1.HyperJob)
+	if !ok {
+		klog.Errorf("Failed to convert %v to HyperJob", oldObj)
+		return
+	}
+
+	newHyperJob, ok := newObj.(*trainingv1alpha1.HyperJob)
+	if !ok {
+		klog.Errorf("Failed to convert %v to HyperJob", newObj)
+		return
+	}
+
+	if newHyperJob.ResourceVersion == oldHyperJob.ResourceVersion {
+		return
+	}
+
+	hc.enqueue(newHyperJob.Namespace, newHyperJob.Name)
+}
+
+func (hc *hyperjobcontroller) addJob(obj interface{}) {
+	job, ok := obj.(*batch.Job)
+	if !ok {
+		klog.Errorf("Failed to convert %v to vcjob", obj)
+		return
+	}
+
+	hc.enqueueControllerOf(job)
+}
+
+func (hc *hyperjobcontroller) updateJob(oldObj, newObj interface{}) {
+	oldJob, ok := oldObj.(*batch.Job)
+	if !ok {
+		klog.Errorf("Failed to convert %v to vcjob", oldObj)
+		return
+	}
+
+	newJob, ok := newObj.(*batch.Job)
+	if !ok {
+		klog.Errorf("Failed to convert %v to vcjob", newObj)
+		return
+	}
+
+	if newJob.ResourceVersion == oldJob.ResourceVersion {
+		return
+	}
+
+	hc.enqueueControllerOf(newJob)
+}
+
+func (hc *hyperjobcontroller) deleteJob(obj interface{}) {
+	job, ok := obj.(*batch.Job)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Couldn't get object from tombstone %#v", obj)
+			return
+		}
+		job, ok = tombstone.Obj.(*batch.Job)
+		if !ok {
+			klog.Errorf("Tombstone contained object that is not a vcjob %#v", obj)
+			return
+		}
+	}
+
+	hc.enqueueControllerOf(job)
+}
+
+// enqueueControllerOf enqueues the hyperjob which the vcjob is created by, vcjobs not created by hyperjob are ignored.
+func (hc *hyperjobcontroller) enqueueControllerOf(job *batch.Job) {
+	controllerRef := metav1.GetControllerOf(job)
+	if controllerRef == nil {
+		return
+	}
+	if controllerRef.APIVersion != controllerKind.GroupVersion().String() || controllerRef.Kind != controllerKind.Kind {
+		return
+	}
+
+	hc.enqueue(job.Namespace, controllerRef.Name)
+}
@@ -0,0 +1,367 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hyperjob
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
+	trainingv1alpha1 "volcano.sh/apis/pkg/apis/training/v1alpha1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// clusterTier is the tier of the virtual domain made up of all the nodes in the cluster,
+// it is tried after all the hypernode tiers.
+const clusterTier = math.MaxInt32
+
+// splitResult is the decision of splitting a replicated job in auto mode.
+type splitResult struct {
+	// tier is the hypernode tier every split vcjob fits in, clusterTier if no hypernode tier fits.
+	tier int
+	// replicas is the replicas of the accelerator task of every split vcjob.
+	replicas []int32
+}
+
+// splitReplicatedJob splits the replicated job in auto mode, so that every split vcjob fits in one topology domain,
+// the number of split vcjobs is bounded by MaxDomains of the hyperjob.
+func (hc *hyperjobcontroller) splitReplicatedJob(hyperJob *trainingv1alpha1.HyperJob, replicatedJob *trainingv1alpha1.ReplicatedJob) (*splitResult, error) {
+	acceleratorType, err := getAcceleratorType(replicatedJob)
+	if err != nil {
+		return nil, err
+	}
+
+	taskIndex, perPod, err := getAcceleratorTask(&replicatedJob.TemplateSpec, acceleratorType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to split replicated job %s: %v", replicatedJob.Name, err)
+	}
+
+	pods := replicatedJob.TemplateSpec.Tasks[taskIndex].Replicas
+	if accelerators := replicatedJob.SplitPolicy.Accelerators; accelerators != nil {
+		pods = int32((int64(*accelerators) + perPod - 1) / perPod)
+	}
+
+	domains, err := hc.getTopologyDomains(acceleratorType, perPod)
+	if err != nil {
+		return nil, err
+	}
+
+	var highestTier *int
+	if nt := replicatedJob.TemplateSpec.NetworkTopology; nt != nil && nt.HighestTierAllowed != nil {
+		highestTier = nt.HighestTierAllowed
+	}
+
+	result, err := calculateSplit(pods, domains, hyperJob.Spec.MaxDomains, highestTier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to split replicated job %s: %v", replicatedJob.Name, err)
+	}
+	klog.V(3).Infof("Split replicated job %s of HyperJob %s/%s into %d jobs at tier %d, replicas %v.",
+		replicatedJob.Name, hyperJob.Namespace, hyperJob.Name, len(result.replicas), result.tier, result.replicas)
+
+	return result, nil
+}
+
+// buildSplitJobs builds the vcjobs of the replicated job in auto mode by the split decision.
+func buildSplitJobs(hyperJob *trainingv1alpha1.HyperJob, replicatedJob *trainingv1alpha1.ReplicatedJob, result *splitResult) ([]*batch.Job, error) {
+	acceleratorType, err := getAcceleratorType(replicatedJob)
+	if err != nil {
+		return nil, err
+	}
+	taskIndex, _, err := getAcceleratorTask(&replicatedJob.TemplateSpec, acceleratorType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to split replicated job %s: %v", replicatedJob.Name, err)
+	}
+
+	jobs := make([]*batch.Job, 0, len(result.replicas))
+	for index, replicas := range result.replicas {
+		job := buildJob(hyperJob, replicatedJob, index)
+		setTaskReplicas(&job.Spec, taskIndex, replicas)
+		if result.tier != clusterTier && job.Spec.NetworkTopology == nil {
+			tier := result.tier
+			job.Spec.NetworkTopology = &batch.NetworkTopologySpec{
+				Mode:               batch.HardNetworkTopologyMode,
+				HighestTierAllowed: &tier,
+			}
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+// getSplitResult returns the split decision of the replicated job recorded in the hyperjob status, nil if it is not split yet.
+func getSplitResult(hyperJob *trainingv1alpha1.HyperJob, name string) *splitResult {
+	status := findReplicatedJobStatus(hyperJob.Status.ReplicatedJobsStatus, name)
+	if status == nil || len(status.SplitReplicas) == 0 {
+		return nil
+	}
+
+	result := &splitResult{
+		tier:     clusterTier,
+		replicas: append([]int32(nil), status.SplitReplicas...),
+	}
+	if status.SplitTier != nil {
+		result.tier = *status.SplitTier
+	}
+	return result
+}
+
+// setSplitResult records the split decision of the replicated job in the hyperjob status.
+func setSplitResult(hyperJob *trainingv1alpha1.HyperJob, name string, result *splitResult) {
+	var tier *int
+	if result.tier != clusterTier {
+		tier = ptr.To(result.tier)
+	}
+
+	status := findReplicatedJobStatus(hyperJob.Status.ReplicatedJobsStatus, name)
+	if status == nil {
+		hyperJob.Status.ReplicatedJobsStatus = append(hyperJob.Status.ReplicatedJobsStatus, trainingv1alpha1.ReplicatedJobStatus{Name: name})
+		status = &hyperJob.Status.ReplicatedJobsStatus[len(hyperJob.Status.ReplicatedJobsStatus)-1]
+	}
+	status.SplitReplicas = append([]int32(nil), result.replicas...)
+	status.SplitTier = tier
+}
+
+func getAcceleratorType(replicatedJob *trainingv1alpha1.ReplicatedJob) (v1.ResourceName, error) {
+	policy := replicatedJob.SplitPolicy
+	if policy.AcceleratorType == nil || *policy.AcceleratorType == "" {
+		return "", fmt.Errorf("acceleratorType of replicated job %s is required in auto split mode", replicatedJob.Name)
+	}
+	return v1.ResourceName(*policy.AcceleratorType), nil
+}
+
+// getAcceleratorTask returns the index of the only task requesting the accelerator and the accelerators requested by each of its pods.
+func getAcceleratorTask(spec *batch.JobSpec, acceleratorType v1.ResourceName) (int, int64, error) {
+	taskIndex := -1
+	var perPod int64
+	for i, task := range spec.Tasks {
+		var request int64
+		for _, container := range task.Template.Spec.Containers {
+			if quantity, found := container.Resources.Requests[acceleratorType]; found {
+				request += quantity.Value()
+			} else if quantity, found := container.Resources.Limits[acceleratorType]; found {
+				request += quantity.Value()
+			}
+		}
+		if request == 0 {
+			continue
+		}
+		if taskIndex != -1 {
+			return -1, 0, fmt.Errorf("both task %s and %s request %s", spec.Tasks[taskIndex].Name, task.Name, acceleratorType)
+		}
+		taskIndex, perPod = i, request
+	}
+
+	if taskIndex == -1 {
+		return -1, 0, fmt.Errorf("no task requests %s", acceleratorType)
+	}
+	return taskIndex, perPod, nil
+}
+
+// getTopologyDomains returns the number of accelerator pods every topology domain can hold, grouped by the hypernode tier.
+// All the nodes of the cluster make up the domain of clusterTier.
+func (hc *hyperjobcontroller) getTopologyDomains(acceleratorType v1.ResourceName, perPod int64) (map[int][]int32, error) {
+	nodes, err := hc.nodeLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	hyperNodes, err := hc.hyperNodeLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	nodeCapacity := make(map[string]int32, len(nodes))
+	var clusterCapacity int32
+	for _, node := range nodes {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		quantity, found := node.Status.Allocatable[acceleratorType]
+		if !found {
+			continue
+		}
+		capacity := int32(quantity.Value() / perPod)
+		nodeCapacity[node.Name] = capacity
+		clusterCapacity += capacity
+	}
+
+	hyperNodesByName := make(map[string]*topologyv1alpha1.HyperNode, len(hyperNodes))
+	for _, hyperNode := range hyperNodes {
+		hyperNodesByName[hyperNode.Name] = hyperNode
+	}
+
+	domains := map[int][]int32{clusterTier: {clusterCapacity}}
+	for _, hyperNode := range hyperNodes {
+		var capacity int32
+		for name := range getHyperNodeLeafNodes(hyperNode, hyperNodesByName, nodes, sets.New[string]()) {
+			capacity += nodeCapacity[name]
+		}
+		domains[hyperNode.Spec.Tier] = append(domains[hyperNode.Spec.Tier], capacity)
+	}
+
+	return domains, nil
+}
+
+// getHyperNodeLeafNodes returns the names of the nodes under the hypernode, visited is used to break cycles.
+func getHyperNodeLeafNodes(hyperNode *topologyv1alpha1.HyperNode, hyperNodes map[string]*topologyv1alpha1.HyperNode,
+	nodes []*v1.Node, visited sets.Set[string]) sets.Set[string] {
+	leafNodes := sets.New[string]()
+	if visited.Has(hyperNode.Name) {
+		return leafNodes
+	}
+	visited.Insert(hyperNode.Name)
+
+	for _, member := range hyperNode.Spec.Members {
+		if member.Type == topologyv1alpha1.MemberTypeNode {
+			leafNodes = leafNodes.Union(api.GetMembers(member.Selector, nodes))
+			continue
+		}
+
+		for _, child := range getChildHyperNodes(member.Selector, hyperNodes) {
+			leafNodes = leafNodes.Union(getHyperNodeLeafNodes(child, hyperNodes, nodes, visited))
+		}
+	}
+
+	return leafNodes
+}
+
+func getChildHyperNodes(selector topologyv1alpha1.MemberSelector, hyperNodes map[string]*topologyv1alpha1.HyperNode) []*topologyv1alpha1.HyperNode {
+	var children []*topologyv1alpha1.HyperNode
+	if selector.ExactMatch != nil {
+		if child, found := hyperNodes[selector.ExactMatch.Name]; found {
+			children = append(children, child)
+		}
+	}
+
+	if selector.RegexMatch != nil {
+		reg, err := regexp.Compile(selector.RegexMatch.Pattern)
+		if err != nil {
+			klog.ErrorS(err, "Failed to compile regular expression", "pattern", selector.RegexMatch.Pattern)
+			return children
+		}
+		for name, child := range hyperNodes {
+			if reg.MatchString(name) {
+				children = append(children, child)
+			}
+		}
+	}
+
+	return children
+}
+
+// calculateSplit finds the lowest tier at which the pods can be placed in no more than maxDomains domains,
+// tiers above highestTier are not considered. The pods are spread as even as the domain capacities allow.
+func calculateSplit(pods int32, domains map[int][]int32, maxDomains *int32, highestTier *int) (*splitResult, error) {
+	if pods <= 0 {
+		return nil, fmt.Errorf("invalid accelerator pods number %d", pods)
+	}
+
+	tiers := make([]int, 0, len(domains))
+	for tier := range domains {
+		if highestTier != nil && tier > *highestTier {
+			continue
+		}
+		tiers = append(tiers, tier)
+	}
+	sort.Ints(tiers)
+
+	for _, tier := range tiers {
+		capacities := append([]int32(nil), domains[tier]...)
+		sort.Slice(capacities, func(i, j int) bool {
+			return capacities[i] > capacities[j]
+		})
+
+		// Take the largest domains first to get the minimal number of domains.
+		var count int
+		var sum int32
+		for count < len(capacities) && sum < pods {
+			sum += capacities[count]
+			count++
+		}
+		if sum < pods {
+			continue
+		}
+		if maxDomains != nil && int32(count) > *maxDomains {
+			continue
+		}
+
+		return &splitResult{
+			tier:     tier,
+			replicas: spreadPods(pods, capacities[:count]),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no topology domains can hold %d accelerator pods within %v domains", pods, maxDomainsString(maxDomains))
+}
+
+// spreadPods spreads the pods evenly to the domains, capacities are sorted in descending order and large enough to hold all the pods.
+func spreadPods(pods int32, capacities []int32) []int32 {
+	count := int32(len(capacities))
+	replicas := make([]int32, count)
+
+	even := true
+	for i := range replicas {
+		replicas[i] = pods / count
+		if int32(i) < pods%count {
+			replicas[i]++
+		}
+		if replicas[i] > capacities[i] {
+			even = false
+		}
+	}
+	if even {
+		return replicas
+	}
+
+	// Fill the domains one by one when they are too uneven to hold an even share.
+	remaining := pods
+	for i := range replicas {
+		replicas[i] = min(remaining, capacities[i])
+		remaining -= replicas[i]
+	}
+	return replicas
+}
+
+// setTaskReplicas sets the replicas of the task, and decreases minAvailable of the job and the task
+// by the number of removed pods, so that the split vcjob tolerates the same number of missing pods.
+func setTaskReplicas(spec *batch.JobSpec, taskIndex int, replicas int32) {
+	task := &spec.Tasks[taskIndex]
+	removed := task.Replicas - replicas
+	task.Replicas = replicas
+
+	if task.MinAvailable != nil {
+		minAvailable := max(*task.MinAvailable-removed, 0)
+		task.MinAvailable = &minAvailable
+	}
+	spec.MinAvailable = max(spec.MinAvailable-removed, 0)
+}
+
+func maxDomainsString(maxDomains *int32) string {
+	if maxDomains == nil {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d", *maxDomains)
+}
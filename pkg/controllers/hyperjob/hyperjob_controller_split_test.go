@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hyperjob
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
+	trainingv1alpha1 "volcano.sh/apis/pkg/apis/training/v1alpha1"
+	hypernodeutils "volcano.sh/volcano/pkg/controllers/hypernode/utils"
+)
+
+const gpu = "nvidia.com/gpu"
+
+func TestCalculateSplit(t *testing.T) {
+	testCases := []struct {
+		name        string
+		pods        int32
+		domains     map[int][]int32
+		maxDomains  *int32
+		highestTier *int
+		expected    *splitResult
+		expectErr   bool
+	}{
+		{
+			name:     "fits in one domain of the lowest tier",
+			pods:     4,
+			domains:  map[int][]int32{1: {8, 8}, 2: {16}, clusterTier: {16}},
+			expected: &splitResult{tier: 1, replicas: []int32{4}},
+		},
+		{
+			name:     "split evenly across domains of the lowest tier",
+			pods:     12,
+			domains:  map[int][]int32{1: {8, 8, 8}, 2: {24}, clusterTier: {24}},
+			expected: &splitResult{tier: 1, replicas: []int32{6, 6}},
+		},
+		{
+			name:     "fill uneven domains",
+			pods:     12,
+			domains:  map[int][]int32{1: {2, 10}, clusterTier: {12}},
+			expected: &splitResult{tier: 1, replicas: []int32{10, 2}},
+		},
+		{
+			name:       "go up one tier when maxDomains is exceeded",
+			pods:       12,
+			domains:    map[int][]int32{1: {4, 4, 4}, 2: {8, 4}, clusterTier: {12}},
+			maxDomains: ptr.To(int32(2)),
+			expected:   &splitResult{tier: 2, replicas: []int32{8, 4}},
+		},
+		{
+			name:     "whole cluster when there is no hypernode",
+			pods:     12,
+			domains:  map[int][]int32{clusterTier: {16}},
+			expected: &splitResult{tier: clusterTier, replicas: []int32{12}},
+		},
+		{
+			name:        "tiers above highestTierAllowed are not considered",
+			pods:        12,
+			domains:     map[int][]int32{1: {4, 4, 4}, 2: {12}, clusterTier: {12}},
+			maxDomains:  ptr.To(int32(1)),
+			highestTier: ptr.To(1),
+			expectErr:   true,
+		},
+		{
+			name:      "insufficient accelerators",
+			pods:      32,
+			domains:   map[int][]int32{1: {8, 8}, clusterTier: {16}},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := calculateSplit(tc.pods, tc.domains, tc.maxDomains, tc.highestTier)
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("expected error %v, got %v", tc.expectErr, err)
+			}
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func buildGPUNode(name string, gpus int64) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				gpu: *resource.NewQuantity(gpus, resource.DecimalSI),
+			},
+		},
+	}
+}
+
+func TestSplitReplicatedJob(t *testing.T) {
+	hc := newFakeController()
+	for _, node := range []*v1.Node{buildGPUNode("node-0", 8), buildGPUNode("node-1", 8), buildGPUNode("node-2", 8), buildGPUNode("node-3", 8)} {
+		hc.nodeInformer.Informer().GetIndexer().Add(node)
+	}
+	for _, hyperNode := range []*topologyv1alpha1.HyperNode{
+		hypernodeutils.BuildHyperNode("rack-0", 1, hypernodeutils.BuildMembers([]string{"node-0", "node-1"}, topologyv1alpha1.MemberTypeNode), nil),
+		hypernodeutils.BuildHyperNode("rack-1", 1, hypernodeutils.BuildMembers([]string{"node-2", "node-3"}, topologyv1alpha1.MemberTypeNode), nil),
+		hypernodeutils.BuildHyperNode("spine", 2, hypernodeutils.BuildMembers([]string{"rack-0", "rack-1"}, topologyv1alpha1.MemberTypeHyperNode), nil),
+	} {
+		hc.hyperNodeInformer.Informer().GetIndexer().Add(hyperNode)
+	}
+
+	hyperJob := newHyperJob("hj", nil, 1)
+	hyperJob.Spec.MaxDomains = ptr.To(int32(2))
+	replicatedJob := &hyperJob.Spec.ReplicatedJobs[0]
+	replicatedJob.SplitPolicy = &trainingv1alpha1.SplitPolicy{
+		Mode:            trainingv1alpha1.SplitModeAuto,
+		Accelerators:    ptr.To(24),
+		AcceleratorType: ptr.To(gpu),
+	}
+	replicatedJob.TemplateSpec = batch.JobSpec{
+		MinAvailable: 5,
+		Tasks: []batch.TaskSpec{
+			{
+				Name:     "master",
+				Replicas: 1,
+			},
+			{
+				Name:     "worker",
+				Replicas: 4,
+				Template: v1.PodTemplateSpec{
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{{
+							Name: "trainer",
+							Resources: v1.ResourceRequirements{
+								Requests: v1.ResourceList{gpu: resource.MustParse("4")},
+							},
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := hc.splitReplicatedJob(hyperJob, replicatedJob)
+	if err != nil {
+		t.Fatalf("split replicated job failed: %v", err)
+	}
+	jobs, err := buildSplitJobs(hyperJob, replicatedJob, result)
+	if err != nil {
+		t.Fatalf("build split jobs failed: %v", err)
+	}
+	if len(jobs) != 2 {
+		t.Fatalf("expected 2 split jobs, got %d", len(jobs))
+	}
+	for i, job := range jobs {
+		if job.Name != getJobName("hj", "a", i) {
+			t.Errorf("unexpected job name %s", job.Name)
+		}
+		if job.Spec.Tasks[0].Replicas != 1 || job.Spec.Tasks[1].Replicas != 3 {
+			t.Errorf("expected replicas 1 master and 3 workers, got %d and %d", job.Spec.Tasks[0].Replicas, job.Spec.Tasks[1].Replicas)
+		}
+		if job.Spec.MinAvailable != 4 {
+			t.Errorf("expected minAvailable 4, got %d", job.Spec.MinAvailable)
+		}
+		if job.Spec.NetworkTopology == nil || *job.Spec.NetworkTopology.HighestTierAllowed != 1 {
+			t.Errorf("expected job to be limited in tier 1, got %+v", job.Spec.NetworkTopology)
+		}
+	}
+
+	overflow := replicatedJob.DeepCopy()
+	overflow.SplitPolicy.Accelerators = ptr.To(64)
+	if _, err := hc.splitReplicatedJob(hyperJob, overflow); err == nil {
+		t.Errorf("expected error when accelerators exceed the cluster")
+	}
+
+	// The split decision is recorded once the vcjobs are created.
+	ctx := context.Background()
+	if _, err := hc.vcClient.TrainingV1alpha1().HyperJobs(hyperJob.Namespace).Create(ctx, hyperJob, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("create hyperjob failed: %v", err)
+	}
+	hc.hyperJobInformer.Informer().GetIndexer().Add(hyperJob)
+	if err := hc.sync("default/hj"); err != nil {
+		t.Fatalf("sync hyperjob failed: %v", err)
+	}
+	recorded, err := hc.vcClient.TrainingV1alpha1().HyperJobs(hyperJob.Namespace).Get(ctx, hyperJob.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get hyperjob failed: %v", err)
+	}
+	status := findReplicatedJobStatus(recorded.Status.ReplicatedJobsStatus, "a")
+	if status == nil || !reflect.DeepEqual(status.SplitReplicas, []int32{3, 3}) || status.SplitTier == nil || *status.SplitTier != 1 {
+		t.Fatalf("expected split decision to be recorded, got %+v", status)
+	}
+
+	// The missing split vcjob is rebuilt by the recorded decision, even if the cluster can not hold it anymore.
+	for _, name := range []string{"node-2", "node-3"} {
+		hc.nodeInformer.Informer().GetIndexer().Delete(buildGPUNode(name, 8))
+	}
+	if err := hc.vcClient.BatchV1alpha1().Jobs(hyperJob.Namespace).Delete(ctx, jobs[1].Name, metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("delete job failed: %v", err)
+	}
+	kept, err := hc.vcClient.BatchV1alpha1().Jobs(hyperJob.Namespace).Get(ctx, jobs[0].Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get job failed: %v", err)
+	}
+	hc.jobInformer.Informer().GetIndexer().Add(kept)
+	hc.hyperJobInformer.Informer().GetIndexer().Update(recorded)
+	if err := hc.sync("default/hj"); err != nil {
+		t.Fatalf("sync hyperjob failed: %v", err)
+	}
+	for _, job := range jobs {
+		got, err := hc.vcClient.BatchV1alpha1().Jobs(hyperJob.Namespace).Get(ctx, job.Name, metav1.GetOptions{})
+		if err != nil {
+			t.Errorf("expected split job %s to be recreated: %v", job.Name, err)
+			continue
+		}
+		if got.Spec.Tasks[1].Replicas != 3 {
+			t.Errorf("expected split job %s to keep 3 workers, got %d", job.Name, got.Spec.Tasks[1].Replicas)
+		}
+	}
+}
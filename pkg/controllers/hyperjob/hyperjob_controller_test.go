@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hyperjob
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	kubeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	trainingv1alpha1 "volcano.sh/apis/pkg/apis/training/v1alpha1"
+	volcanoclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
+	informerfactory "volcano.sh/apis/pkg/client/informers/externalversions"
+	"volcano.sh/volcano/pkg/controllers/framework"
+)
+
+func newFakeController() *hyperjobcontroller {
+	volcanoClientSet := volcanoclient.NewSimpleClientset()
+	kubeClientSet := kubeclient.NewSimpleClientset()
+
+	sharedInformers := informers.NewSharedInformerFactory(kubeClientSet, 0)
+	vcSharedInformers := informerfactory.NewSharedInformerFactory(volcanoClientSet, 0)
+
+	controller := &hyperjobcontroller{}
+	opt := &framework.ControllerOption{
+		VolcanoClient:           volcanoClientSet,
+		KubeClient:              kubeClientSet,
+		SharedInformerFactory:   sharedInformers,
+		VCSharedInformerFactory: vcSharedInformers,
+		WorkerNum:               3,
+	}
+
+	controller.Initialize(opt)
+
+	return controller
+}
+
+func newHyperJob(name string, minAvailable *int32, replicas ...int32) *trainingv1alpha1.HyperJob {
+	hyperJob := &trainingv1alpha1.HyperJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  "default",
+			UID:        "hyperjob-uid",
+			Generation: 1,
+		},
+		Spec: trainingv1alpha1.HyperJobSpec{
+			MinAvailable: minAvailable,
+		},
+	}
+	for i, r := range replicas {
+		hyperJob.Spec.ReplicatedJobs = append(hyperJob.Spec.ReplicatedJobs, trainingv1alpha1.ReplicatedJob{
+			Name:     string(rune('a' + i)),
+			Replicas: r,
+			TemplateSpec: batch.JobSpec{
+				MinAvailable: 1,
+			},
+		})
+	}
+	return hyperJob
+}
+
+func newChildJob(hyperJob *trainingv1alpha1.HyperJob, replicatedJob int, index int, phase batch.JobPhase) *batch.Job {
+	job := buildJob(hyperJob, &hyperJob.Spec.ReplicatedJobs[replicatedJob], index)
+	job.Status.State.Phase = phase
+	job.Status.Running = 2
+	return job
+}
+
+func TestSyncHyperJob(t *testing.T) {
+	testCases := []struct {
+		name              string
+		hyperJob          *trainingv1alpha1.HyperJob
+		jobs              func(hyperJob *trainingv1alpha1.HyperJob) []*batch.Job
+		expectedJobs      []string
+		expectedCondition string
+	}{
+		{
+			name:         "create vcjobs for every replica",
+			hyperJob:     newHyperJob("hj", nil, 2, 1),
+			expectedJobs: []string{"hj-a-0", "hj-a-1", "hj-b-0"},
+		},
+		{
+			name:     "delete vcjobs which are scaled down",
+			hyperJob: newHyperJob("hj", nil, 1),
+			jobs: func(hyperJob *trainingv1alpha1.HyperJob) []*batch.Job {
+				stale := buildJob(hyperJob, &hyperJob.Spec.ReplicatedJobs[0], 1)
+				return []*batch.Job{newChildJob(hyperJob, 0, 0, batch.Running), stale}
+			},
+			expectedJobs: []string{"hj-a-0"},
+		},
+		{
+			name:     "completed when all vcjobs completed",
+			hyperJob: newHyperJob("hj", nil, 2),
+			jobs: func(hyperJob *trainingv1alpha1.HyperJob) []*batch.Job {
+				return []*batch.Job{newChildJob(hyperJob, 0, 0, batch.Completed), newChildJob(hyperJob, 0, 1, batch.Completed)}
+			},
+			expectedJobs:      []string{"hj-a-0", "hj-a-1"},
+			expectedCondition: trainingv1alpha1.HyperJobConditionCompleted,
+		},
+		{
+			name:     "failed vcjob tolerated by minAvailable",
+			hyperJob: newHyperJob("hj", ptr.To(int32(1)), 2),
+			jobs: func(hyperJob *trainingv1alpha1.HyperJob) []*batch.Job {
+				return []*batch.Job{newChildJob(hyperJob, 0, 0, batch.Failed), newChildJob(hyperJob, 0, 1, batch.Running)}
+			},
+			expectedJobs: []string{"hj-a-0", "hj-a-1"},
+		},
+		{
+			name:     "failed and running vcjobs terminated when minAvailable is not satisfied",
+			hyperJob: newHyperJob("hj", nil, 2),
+			jobs: func(hyperJob *trainingv1alpha1.HyperJob) []*batch.Job {
+				return []*batch.Job{newChildJob(hyperJob, 0, 0, batch.Failed), newChildJob(hyperJob, 0, 1, batch.Running)}
+			},
+			expectedJobs:      []string{"hj-a-0"},
+			expectedCondition: trainingv1alpha1.HyperJobConditionFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hc := newFakeController()
+			ctx := context.Background()
+
+			if _, err := hc.vcClient.TrainingV1alpha1().HyperJobs(tc.hyperJob.Namespace).Create(ctx, tc.hyperJob, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("create hyperjob failed: %v", err)
+			}
+			hc.hyperJobInformer.Informer().GetIndexer().Add(tc.hyperJob)
+			if tc.jobs != nil {
+				for _, job := range tc.jobs(tc.hyperJob) {
+					if _, err := hc.vcClient.BatchV1alpha1().Jobs(job.Namespace).Create(ctx, job, metav1.CreateOptions{}); err != nil {
+						t.Fatalf("create job failed: %v", err)
+					}
+					hc.jobInformer.Informer().GetIndexer().Add(job)
+				}
+			}
+
+			if err := hc.sync(tc.hyperJob.Namespace + "/" + tc.hyperJob.Name); err != nil {
+				t.Fatalf("sync hyperjob failed: %v", err)
+			}
+
+			jobList, err := hc.vcClient.BatchV1alpha1().Jobs(tc.hyperJob.Namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("list jobs failed: %v", err)
+			}
+			gotJobs := map[string]bool{}
+			for _, job := range jobList.Items {
+				gotJobs[job.Name] = true
+				if !metav1.IsControlledBy(&job, tc.hyperJob) {
+					t.Errorf("job %s is not controlled by hyperjob", job.Name)
+				}
+			}
+			if len(gotJobs) != len(tc.expectedJobs) {
+				t.Errorf("expected jobs %v, got %v", tc.expectedJobs, gotJobs)
+			}
+			for _, name := range tc.expectedJobs {
+				if !gotJobs[name] {
+					t.Errorf("expected job %s not found, got %v", name, gotJobs)
+				}
+			}
+
+			hyperJob, err := hc.vcClient.TrainingV1alpha1().HyperJobs(tc.hyperJob.Namespace).Get(ctx, tc.hyperJob.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("get hyperjob failed: %v", err)
+			}
+			var replicas int32
+			for _, replicatedJob := range tc.hyperJob.Spec.ReplicatedJobs {
+				replicas += replicatedJob.Replicas
+			}
+			if hyperJob.Status.SplitCount == nil || *hyperJob.Status.SplitCount != replicas {
+				t.Errorf("expected split count %d, got %v", replicas, hyperJob.Status.SplitCount)
+			}
+			for _, conditionType := range []string{trainingv1alpha1.HyperJobConditionCompleted, trainingv1alpha1.HyperJobConditionFailed} {
+				got := meta.IsStatusConditionTrue(hyperJob.Status.Conditions, conditionType)
+				if got != (conditionType == tc.expectedCondition) {
+					t.Errorf("expected condition %q, got conditions %v", tc.expectedCondition, hyperJob.Status.Conditions)
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateHyperJobStatus(t *testing.T) {
+	hyperJob := newHyperJob("hj", nil, 2, 1)
+	desiredJobs, err := (&hyperjobcontroller{}).getDesiredJobs(hyperJob)
+	if err != nil {
+		t.Fatalf("get desired jobs failed: %v", err)
+	}
+	existingJobs := map[string]*batch.Job{}
+	for _, job := range []*batch.Job{newChildJob(hyperJob, 0, 0, batch.Running), newChildJob(hyperJob, 0, 1, batch.Pending), newChildJob(hyperJob, 1, 0, batch.Running)} {
+		existingJobs[job.Name] = job
+	}
+
+	status := calculateHyperJobStatus(hyperJob, desiredJobs, existingJobs)
+	if len(status.ReplicatedJobsStatus) != 2 {
+		t.Fatalf("expected 2 replicated job status, got %d", len(status.ReplicatedJobsStatus))
+	}
+	a := status.ReplicatedJobsStatus[0]
+	if a.Name != "a" || len(a.JobStates) != 2 || a.Running != 4 || a.JobStates["hj-a-1"].Phase != batch.Pending {
+		t.Errorf("unexpected status of replicated job a: %+v", a)
+	}
+	b := status.ReplicatedJobsStatus[1]
+	if b.Name != "b" || len(b.JobStates) != 1 || b.Running != 2 {
+		t.Errorf("unexpected status of replicated job b: %+v", b)
+	}
+	if *status.SplitCount != 3 || status.ObservedGeneration != 1 || len(status.Conditions) != 0 {
+		t.Errorf("unexpected status: %+v", status)
+	}
+}
+
+func TestEnqueueControllerOf(t *testing.T) {
+	hc := newFakeController()
+	hyperJob := newHyperJob("hj", nil, 1)
+
+	hc.addJob(&batch.Job{ObjectMeta: metav1.ObjectMeta{Name: "orphan", Namespace: "default"}})
+	if hc.queue.Len() != 0 {
+		t.Fatalf("expected orphan job to be ignored, queue length %d", hc.queue.Len())
+	}
+
+	hc.addJob(newChildJob(hyperJob, 0, 0, batch.Running))
+	if hc.queue.Len() != 1 {
+		t.Fatalf("expected hyperjob to be enqueued, queue length %d", hc.queue.Len())
+	}
+	key, _ := hc.queue.Get()
+	if key != "default/hj" {
+		t.Errorf("expected key default/hj, got %s", key)
+	}
+}
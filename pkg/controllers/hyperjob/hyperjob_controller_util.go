@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hyperjob
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	trainingv1alpha1 "volcano.sh/apis/pkg/apis/training/v1alpha1"
+)
+
+func getJobName(hyperJobName, replicatedJobName string, index int) string {
+	return fmt.Sprintf("%s-%s-%d", hyperJobName, replicatedJobName, index)
+}
+
+func hyperJobSelector(hyperJob *trainingv1alpha1.HyperJob) labels.Selector {
+	return labels.SelectorFromSet(labels.Set{HyperJobNameLabelKey: hyperJob.Name})
+}
+
+// buildJob builds the index-th vcjob of the replicated job.
+func buildJob(hyperJob *trainingv1alpha1.HyperJob, replicatedJob *trainingv1alpha1.ReplicatedJob, index int) *batch.Job {
+	return &batch.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getJobName(hyperJob.Name, replicatedJob.Name, index),
+			Namespace: hyperJob.Namespace,
+			Labels: map[string]string{
+				HyperJobNameLabelKey:       hyperJob.Name,
+				ReplicatedJobNameLabelKey:  replicatedJob.Name,
+				ReplicatedJobIndexLabelKey: strconv.Itoa(index),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(hyperJob, controllerKind),
+			},
+		},
+		Spec: *replicatedJob.TemplateSpec.DeepCopy(),
+	}
+}
+
+// getMinAvailable returns the minimal number of vcjobs which must complete, all vcjobs by default.
+func getMinAvailable(hyperJob *trainingv1alpha1.HyperJob, total int32) int32 {
+	if hyperJob.Spec.MinAvailable == nil || *hyperJob.Spec.MinAvailable > total {
+		return total
+	}
+	if *hyperJob.Spec.MinAvailable < 0 {
+		return 0
+	}
+	return *hyperJob.Spec.MinAvailable
+}
+
+func isHyperJobFinished(hyperJob *trainingv1alpha1.HyperJob) bool {
+	return meta.IsStatusConditionTrue(hyperJob.Status.Conditions, trainingv1alpha1.HyperJobConditionCompleted) ||
+		meta.IsStatusConditionTrue(hyperJob.Status.Conditions, trainingv1alpha1.HyperJobConditionFailed)
+}
+
+func isJobFinished(job *batch.Job) bool {
+	switch job.Status.State.Phase {
+	case batch.Completed, batch.Failed, batch.Aborted, batch.Terminated:
+		return true
+	}
+	return false
+}
+
+// findReplicatedJobStatus returns the status of the replicated job, nil if not found.
+func findReplicatedJobStatus(statuses []trainingv1alpha1.ReplicatedJobStatus, name string) *trainingv1alpha1.ReplicatedJobStatus {
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
@@ -16,6 +16,8 @@ limitations under the License.
 
 package job
 
+import "time"
+
 // Reasons for pod events.
 const (
 	// FailedCreatePodReason is added in an event and in a replica set condition
@@ -30,4 +32,26 @@ const (
 	// SuccessfulDeletePodReason is added in an event when a pod for a replica set
 	// is successfully deleted.
 	SuccessfulDeletePodReason = "SuccessfulDelete"
+	// ElasticWorldSizeChangedReason is added in an event when the world size
+	// of an elastic job is changed.
+	ElasticWorldSizeChangedReason = "ElasticWorldSizeChanged"
+)
+
+// Reasons of the pods and containers failed because of the infrastructure.
+const (
+	// podEvictedReason is set by kubelet when the pod is evicted.
+	podEvictedReason = "Evicted"
+	// podNodeLostReason is set when the node of the pod is lost.
+	podNodeLostReason = "NodeLost"
+	// podNodeShutdownReason is set by kubelet when the pod is terminated for the node shutdown.
+	podNodeShutdownReason = "Terminated"
+	// containerOOMKilledReason is set when the container is killed for out of memory.
+	containerOOMKilledReason = "OOMKilled"
+)
+
+const (
+	// DefaultRetryBackoffInitialDelay is the default delay of the first restart with backoff.
+	DefaultRetryBackoffInitialDelay = 10 * time.Second
+	// DefaultRetryBackoffMaxDelay is the default upper bound of the delay of the restarts with backoff.
+	DefaultRetryBackoffMaxDelay = 5 * time.Minute
 )
@@ -35,7 +35,7 @@ func FuzzApplyPolicies(f *testing.F) {
 		// Create a random request
 		req := &apis.Request{}
 		fdp.GenerateStruct(req)
-		applyPolicies(job, req)
+		applyPolicies(job, nil, req)
 	})
 }
 
@@ -175,6 +175,23 @@ func GetTaskReplicasUnderJob(taskName string, job *batch.Job) int32 {
 	return 0
 }
 
+// GetTaskSlots gets the slots of each pod of the task for the distributed launchers, which is the number of the
+// resource requested by the containers of the pod, or 1 if the resource is not requested.
+func GetTaskSlots(ts batch.TaskSpec, resourceName v1.ResourceName) int {
+	slots := int64(0)
+	for _, c := range ts.Template.Spec.Containers {
+		if quantity, found := c.Resources.Requests[resourceName]; found {
+			slots += quantity.Value()
+		} else if quantity, found := c.Resources.Limits[resourceName]; found {
+			slots += quantity.Value()
+		}
+	}
+	if slots <= 0 {
+		return 1
+	}
+	return int(slots)
+}
+
 // IsOutOfSyncPod checks whether the pod is marked as out-of-sync.
 func IsOutOfSyncPod(pod *v1.Pod) bool {
 	if pod.Annotations == nil {
@@ -190,6 +207,16 @@ func OutOfSyncJSONPatch() []byte {
 		escapeJSONPointer(OutOfSyncKey)))
 }
 
+// IsElasticJob checks whether the job runs elastically between its minAvailable and replicas.
+func IsElasticJob(job *batch.Job) bool {
+	return job.Annotations[batch.JobElasticKey] == "true"
+}
+
+// ElasticWorldSizeMergePatch generates a merge patch to set the elastic world size annotation of a job or pod.
+func ElasticWorldSizeMergePatch(worldSize int32) []byte {
+	return []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:"%d"}}}`, batch.ElasticWorldSizeKey, worldSize))
+}
+
 // escapeJSONPointer escapes a string for use in a JSON Pointer.
 // See RFC 6901 for details: https://datatracker.ietf.org/doc/html/rfc6901
 func escapeJSONPointer(s string) string {
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
@@ -683,3 +684,56 @@ func TestOutOfSyncJSONPatch(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTaskSlots(t *testing.T) {
+	taskWithContainers := func(containers ...v1.Container) batch.TaskSpec {
+		return batch.TaskSpec{
+			Template: v1.PodTemplateSpec{Spec: v1.PodSpec{Containers: containers}},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		task     batch.TaskSpec
+		expected int
+	}{
+		{
+			name:     "task without gpu should have 1 slot",
+			task:     taskWithContainers(v1.Container{Name: "c1"}),
+			expected: 1,
+		},
+		{
+			name: "slots should be counted from the requests",
+			task: taskWithContainers(v1.Container{
+				Name: "c1",
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{api.GPUResourceName: resource.MustParse("4")},
+					Limits:   v1.ResourceList{api.GPUResourceName: resource.MustParse("4")},
+				},
+			}),
+			expected: 4,
+		},
+		{
+			name: "slots should be counted from the limits of all containers",
+			task: taskWithContainers(
+				v1.Container{
+					Name:      "c1",
+					Resources: v1.ResourceRequirements{Limits: v1.ResourceList{api.GPUResourceName: resource.MustParse("2")}},
+				},
+				v1.Container{
+					Name:      "c2",
+					Resources: v1.ResourceRequirements{Limits: v1.ResourceList{api.GPUResourceName: resource.MustParse("6")}},
+				},
+			),
+			expected: 8,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if slots := GetTaskSlots(tc.task, api.GPUResourceName); slots != tc.expected {
+				t.Errorf("expected %d slots, got %d", tc.expected, slots)
+			}
+		})
+	}
+}
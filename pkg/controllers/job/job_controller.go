@@ -86,6 +86,9 @@ type delayAction struct {
 	// The delay before the action is executed
 	delay time.Duration
 
+	// Whether the action is taken for an infrastructure failure of the pod
+	infrastructure bool
+
 	// The cancel function of the action
 	cancel context.CancelFunc
 }
@@ -267,6 +270,8 @@ func (cc *jobcontroller) Initialize(opt *framework.ControllerOption) error {
 	state.SyncJob = cc.syncJob
 	state.KillJob = cc.killJob
 	state.KillTarget = cc.killTarget
+	state.SuspendJob = cc.suspendJob
+	state.ResumeJob = cc.resumeJob
 	return nil
 }
 
@@ -371,7 +376,7 @@ func (cc *jobcontroller) processNextReq(count uint32) bool {
 		return true
 	}
 
-	delayAct := applyPolicies(jobInfo.Job, &req)
+	delayAct := applyPolicies(jobInfo.Job, getRequestPod(jobInfo, &req), &req)
 
 	if delayAct.delay != 0 {
 		klog.V(3).Infof("Execute <%v> on Job <%s/%s> after %s",
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -268,6 +269,10 @@ func (cc *jobcontroller) killPods(jobInfo *apis.JobInfo, podRetainPhase state.Ph
 		klog.Errorf("Failed to find PodGroup of Job: %s/%s, error: %s", job.Namespace, job.Name, err.Error())
 		return err
 	}
+	if pg != nil && isSuspending(job) {
+		// The PodGroup of a suspended Job is kept, so that the Job does not lose its place in the queue.
+		return cc.suspendPodGroup(job, pg)
+	}
 	if pg != nil {
 		if err := cc.vcClient.SchedulingV1beta1().PodGroups(job.Namespace).Delete(context.TODO(), pg.Name, metav1.DeleteOptions{}); err != nil {
 			if !apierrors.IsNotFound(err) {
@@ -282,6 +287,92 @@ func (cc *jobcontroller) killPods(jobInfo *apis.JobInfo, podRetainPhase state.Ph
 	return nil
 }
 
+// suspendJob marks the Job suspended and kills its Pods, the PodGroup of the Job is kept with its creation ordering
+// and released from the queue until the Job is resumed.
+func (cc *jobcontroller) suspendJob(jobInfo *apis.JobInfo, updateStatus state.UpdateStatusFn) error {
+	reason := cc.getSuspendReason(jobInfo.Job)
+	if err := cc.updateJobSuspend(jobInfo, true); err != nil {
+		return err
+	}
+
+	return cc.killPods(jobInfo, state.PodRetainPhaseSoft, nil, func(status *batch.JobStatus) bool {
+		if status.SuspendedAt == nil {
+			now := metav1.Now()
+			status.SuspendedAt = &now
+			status.SuspendReason = reason
+		}
+		return updateStatus != nil && updateStatus(status)
+	})
+}
+
+// resumeJob clears the suspension of the Job and its PodGroup, the Job is synced up from its place in the queue.
+func (cc *jobcontroller) resumeJob(jobInfo *apis.JobInfo, updateStatus state.UpdateStatusFn) error {
+	if err := cc.updateJobSuspend(jobInfo, false); err != nil {
+		return err
+	}
+
+	return cc.syncJob(jobInfo, func(status *batch.JobStatus) bool {
+		status.SuspendedAt = nil
+		status.SuspendReason = ""
+		return updateStatus != nil && updateStatus(status)
+	})
+}
+
+// updateJobSuspend sets the suspend of the Job spec, so that the suspension requested by a command, a policy or
+// the scheduler is kept until the Job is resumed.
+func (cc *jobcontroller) updateJobSuspend(jobInfo *apis.JobInfo, suspend bool) error {
+	if isSuspended(jobInfo.Job) == suspend {
+		return nil
+	}
+
+	job := jobInfo.Job.DeepCopy()
+	job.Spec.Suspend = &suspend
+	newJob, err := cc.vcClient.BatchV1alpha1().Jobs(job.Namespace).Update(context.TODO(), job, metav1.UpdateOptions{})
+	if err != nil {
+		klog.Errorf("Failed to update suspend of Job %v/%v to %v: %v", job.Namespace, job.Name, suspend, err)
+		return err
+	}
+	if e := cc.cache.Update(newJob); e != nil {
+		klog.Errorf("UpdateJobSuspend - Failed to update Job %v/%v in cache:  %v",
+			newJob.Namespace, newJob.Name, e)
+		return e
+	}
+	jobInfo.Job = newJob
+	return nil
+}
+
+// getSuspendReason returns the reason why the Job is suspended, the suspension is requested by the scheduler
+// if its PodGroup is annotated when the queue is reclaimed.
+func (cc *jobcontroller) getSuspendReason(job *batch.Job) string {
+	pg, err := cc.getPodGroupByJob(job)
+	if err == nil && pg.Annotations[scheduling.SuspendRequestedAnnotationKey] != "" {
+		return batch.SuspendReasonReclaimed
+	}
+	return batch.SuspendReasonUserRequested
+}
+
+// suspendPodGroup marks the PodGroup suspended and moves it back to Pending, so that it is released from the queue
+// while keeping its creation ordering.
+func (cc *jobcontroller) suspendPodGroup(job *batch.Job, pg *scheduling.PodGroup) error {
+	_, requested := pg.Annotations[scheduling.SuspendRequestedAnnotationKey]
+	if pg.Annotations[scheduling.SuspendedAnnotationKey] == "true" && !requested && pg.Status.Phase == scheduling.PodGroupPending {
+		return nil
+	}
+
+	pg = pg.DeepCopy()
+	if pg.Annotations == nil {
+		pg.Annotations = map[string]string{}
+	}
+	pg.Annotations[scheduling.SuspendedAnnotationKey] = "true"
+	delete(pg.Annotations, scheduling.SuspendRequestedAnnotationKey)
+	pg.Status.Phase = scheduling.PodGroupPending
+	if _, err := cc.vcClient.SchedulingV1beta1().PodGroups(pg.Namespace).Update(context.TODO(), pg, metav1.UpdateOptions{}); err != nil {
+		klog.Errorf("Failed to suspend PodGroup of Job %s/%s: %v", job.Namespace, job.Name, err)
+		return err
+	}
+	return nil
+}
+
 func (cc *jobcontroller) initiateJob(job *batch.Job) (*batch.Job, error) {
 	klog.V(3).Infof("Starting to initiate Job <%s/%s>", job.Namespace, job.Name)
 	jobInstance, err := cc.initJobStatus(job)
@@ -374,8 +465,9 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 		}
 	}
 
-	// Skip job initiation if job is already initiated
-	if !isInitiated(job) {
+	// Skip job initiation if job is already initiated, the resumed job is initiated again as the resources
+	// of its plugins are released when it is suspended
+	if !isInitiated(job) || isResuming(job) {
 		if job, err = cc.initiateJob(job); err != nil {
 			return err
 		}
@@ -579,21 +671,30 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 		return fmt.Errorf("failed to delete %d pods of %d", len(deletionErrs), len(podToDelete))
 	}
 
+	if jobhelpers.IsElasticJob(job) {
+		if err := cc.syncElasticWorldSize(job, jobInfo.Pods); err != nil {
+			return err
+		}
+	}
+
 	newStatus := batch.JobStatus{
 		State: job.Status.State,
 
-		Pending:             pending,
-		Running:             running,
-		Succeeded:           succeeded,
-		Failed:              failed,
-		Terminating:         terminating,
-		Unknown:             unknown,
-		Version:             job.Status.Version,
-		MinAvailable:        job.Spec.MinAvailable,
-		TaskStatusCount:     taskStatusCount,
-		ControlledResources: job.Status.ControlledResources,
-		Conditions:          job.Status.Conditions,
-		RetryCount:          job.Status.RetryCount,
+		Pending:                  pending,
+		Running:                  running,
+		Succeeded:                succeeded,
+		Failed:                   failed,
+		Terminating:              terminating,
+		Unknown:                  unknown,
+		Version:                  job.Status.Version,
+		MinAvailable:             job.Spec.MinAvailable,
+		TaskStatusCount:          taskStatusCount,
+		ControlledResources:      job.Status.ControlledResources,
+		Conditions:               job.Status.Conditions,
+		RetryCount:               job.Status.RetryCount,
+		InfrastructureRetryCount: job.Status.InfrastructureRetryCount,
+		SuspendedAt:              job.Status.SuspendedAt,
+		SuspendReason:            job.Status.SuspendReason,
 	}
 
 	if updateStatus != nil {
@@ -623,6 +724,62 @@ func (cc *jobcontroller) syncJob(jobInfo *apis.JobInfo, updateStatus state.Updat
 	return nil
 }
 
+// syncElasticWorldSize records the number of the scheduled pods of an elastic job as its world size on the job and
+// its pods, so that the workload is notified once the scheduler grows or shrinks the job.
+func (cc *jobcontroller) syncElasticWorldSize(job *batch.Job, pods map[string]map[string]*v1.Pod) error {
+	var worldSize int32
+	var alivePods []*v1.Pod
+	for _, taskPods := range pods {
+		for _, pod := range taskPods {
+			if pod.DeletionTimestamp != nil || jobhelpers.IsOutOfSyncPod(pod) {
+				continue
+			}
+			if pod.Status.Phase != v1.PodPending && pod.Status.Phase != v1.PodRunning {
+				continue
+			}
+			alivePods = append(alivePods, pod)
+			if len(pod.Spec.NodeName) != 0 {
+				worldSize++
+			}
+		}
+	}
+	if worldSize == 0 {
+		// Keep the last world size while none of the pods is scheduled, e.g. the job is restarting.
+		return nil
+	}
+
+	value := strconv.Itoa(int(worldSize))
+	patch := jobhelpers.ElasticWorldSizeMergePatch(worldSize)
+	for _, pod := range alivePods {
+		if pod.Annotations[batch.ElasticWorldSizeKey] == value {
+			continue
+		}
+		if _, err := cc.kubeClient.CoreV1().Pods(pod.Namespace).Patch(context.TODO(), pod.Name, types.MergePatchType,
+			patch, metav1.PatchOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			klog.Errorf("Failed to update world size of Pod <%s/%s>: %v", pod.Namespace, pod.Name, err)
+			return err
+		}
+	}
+
+	if job.Annotations[batch.ElasticWorldSizeKey] == value {
+		return nil
+	}
+	newJob, err := cc.vcClient.BatchV1alpha1().Jobs(job.Namespace).Patch(context.TODO(), job.Name, types.MergePatchType,
+		patch, metav1.PatchOptions{})
+	if err != nil {
+		klog.Errorf("Failed to update world size of Job <%s/%s>: %v", job.Namespace, job.Name, err)
+		return err
+	}
+	klog.V(3).Infof("World size of elastic Job <%s/%s> is updated to %d", job.Namespace, job.Name, worldSize)
+	cc.recorder.Eventf(job, v1.EventTypeNormal, ElasticWorldSizeChangedReason,
+		"The world size of the elastic job is changed to %d", worldSize)
+
+	// Keep the status update of the job based on the patched version.
+	job.Annotations = newJob.Annotations
+	job.ResourceVersion = newJob.ResourceVersion
+	return nil
+}
+
 func (cc *jobcontroller) waitDependsOnTaskMeetCondition(taskIndex int, job *batch.Job) bool {
 	if job.Spec.Tasks[taskIndex].DependsOn == nil {
 		return true
@@ -801,7 +958,7 @@ func (cc *jobcontroller) createOrUpdatePodGroup(job *batch.Job) error {
 				Namespace: job.Namespace,
 				// add job.UID into its name when create new PodGroup
 				Name:        cc.generateRelatedPodGroupName(job),
-				Annotations: job.Annotations,
+				Annotations: getPodGroupAnnotations(job),
 				Labels:      job.Labels,
 				OwnerReferences: []metav1.OwnerReference{
 					*metav1.NewControllerRef(job, helpers.JobKind),
@@ -872,6 +1029,14 @@ func (cc *jobcontroller) shouldUpdateExistingPodGroup(pg *scheduling.PodGroup, j
 		pgShouldUpdate = true
 	}
 
+	if updateRunningEstimate(pg, job) {
+		pgShouldUpdate = true
+	}
+
+	if updateSuspension(pg, job) {
+		pgShouldUpdate = true
+	}
+
 	minResources := cc.calcPGMinResources(job)
 	if pg.Spec.MinMember != job.Spec.MinAvailable || !equality.Semantic.DeepEqual(pg.Spec.MinResources, minResources) {
 		pg.Spec.MinMember = job.Spec.MinAvailable
@@ -906,6 +1071,52 @@ func (cc *jobcontroller) shouldUpdateExistingPodGroup(pg *scheduling.PodGroup, j
 	return pgShouldUpdate
 }
 
+// getPodGroupAnnotations returns the annotations of the PodGroup created for the job, the running
+// estimate of the job is passed by annotation for the scheduler to plan backfill.
+func getPodGroupAnnotations(job *batch.Job) map[string]string {
+	if job.Spec.RunningEstimate == nil {
+		return job.Annotations
+	}
+
+	annotations := make(map[string]string, len(job.Annotations)+1)
+	for key, value := range job.Annotations {
+		annotations[key] = value
+	}
+	annotations[scheduling.JobRunningEstimate] = job.Spec.RunningEstimate.Duration.String()
+	return annotations
+}
+
+// updateRunningEstimate sets the running estimate annotation of the PodGroup created before
+// the estimate is passed, it returns true if the PodGroup is changed.
+func updateRunningEstimate(pg *scheduling.PodGroup, job *batch.Job) bool {
+	if job.Spec.RunningEstimate == nil {
+		return false
+	}
+
+	estimate := job.Spec.RunningEstimate.Duration.String()
+	if pg.Annotations[scheduling.JobRunningEstimate] == estimate {
+		return false
+	}
+	if pg.Annotations == nil {
+		pg.Annotations = map[string]string{}
+	}
+	pg.Annotations[scheduling.JobRunningEstimate] = estimate
+	return true
+}
+
+// updateSuspension clears the suspension of the PodGroup once the Job is resumed, it returns true if the PodGroup
+// is changed. The PodGroup is marked suspended when the Pods of the suspended Job are killed.
+func updateSuspension(pg *scheduling.PodGroup, job *batch.Job) bool {
+	if isSuspended(job) {
+		return false
+	}
+	if _, found := pg.Annotations[scheduling.SuspendedAnnotationKey]; !found {
+		return false
+	}
+	delete(pg.Annotations, scheduling.SuspendedAnnotationKey)
+	return true
+}
+
 func (cc *jobcontroller) deleteJobPod(jobName string, pod *v1.Pod) error {
 	err := cc.kubeClient.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{})
 	if err != nil && !apierrors.IsNotFound(err) {
@@ -1053,6 +1264,21 @@ func isInitiated(job *batch.Job) bool {
 	return true
 }
 
+// isSuspending checks whether the Job is being suspended, i.e. it is killed into the suspension phases.
+func isSuspending(job *batch.Job) bool {
+	return isSuspended(job) && (job.Status.State.Phase == batch.Suspending || job.Status.State.Phase == batch.Suspended)
+}
+
+// isResuming checks whether the Job is being resumed, i.e. it is synced up in the suspension phases.
+func isResuming(job *batch.Job) bool {
+	return !isSuspended(job) && (job.Status.State.Phase == batch.Suspending || job.Status.State.Phase == batch.Suspended)
+}
+
+// isSuspended checks whether the Job is suspended by its spec.
+func isSuspended(job *batch.Job) bool {
+	return job.Spec.Suspend != nil && *job.Spec.Suspend
+}
+
 func newCondition(status batch.JobPhase, lastTransitionTime *metav1.Time) batch.JobCondition {
 	return batch.JobCondition{
 		Status:             status,
@@ -1023,6 +1023,108 @@ func TestDeleteJobPod(t *testing.T) {
 	}
 }
 
+func TestSyncElasticWorldSize(t *testing.T) {
+	namespace := "test"
+
+	scheduledPod := func(name string, phase v1.PodPhase) *v1.Pod {
+		pod := buildPod(namespace, name, phase, nil)
+		pod.Spec.NodeName = "node1"
+		return pod
+	}
+
+	testcases := []struct {
+		Name              string
+		Annotations       map[string]string
+		Pods              map[string]map[string]*v1.Pod
+		ExpectedWorldSize string
+		ExpectPodsPatched bool
+	}{
+		{
+			Name:        "count the scheduled pods",
+			Annotations: map[string]string{v1alpha1.JobElasticKey: "true"},
+			Pods: map[string]map[string]*v1.Pod{
+				"task1": {
+					"job1-task1-0": scheduledPod("job1-task1-0", v1.PodRunning),
+					"job1-task1-1": scheduledPod("job1-task1-1", v1.PodPending),
+					"job1-task1-2": buildPod(namespace, "job1-task1-2", v1.PodPending, nil),
+					"job1-task1-3": scheduledPod("job1-task1-3", v1.PodFailed),
+				},
+			},
+			ExpectedWorldSize: "2",
+			ExpectPodsPatched: true,
+		},
+		{
+			Name:        "keep the world size when no pod is scheduled",
+			Annotations: map[string]string{v1alpha1.JobElasticKey: "true", v1alpha1.ElasticWorldSizeKey: "3"},
+			Pods: map[string]map[string]*v1.Pod{
+				"task1": {
+					"job1-task1-0": buildPod(namespace, "job1-task1-0", v1.PodPending, nil),
+				},
+			},
+			ExpectedWorldSize: "3",
+			ExpectPodsPatched: false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			fakeController := newFakeController()
+
+			job := &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "job1",
+					Namespace:   namespace,
+					Annotations: testcase.Annotations,
+				},
+			}
+			if _, err := fakeController.vcClient.BatchV1alpha1().Jobs(namespace).Create(context.TODO(), job, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Failed to create job: %v", err)
+			}
+			for _, pods := range testcase.Pods {
+				for _, pod := range pods {
+					if _, err := fakeController.kubeClient.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
+						t.Fatalf("Failed to create pod: %v", err)
+					}
+				}
+			}
+
+			if err := fakeController.syncElasticWorldSize(job, testcase.Pods); err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if job.Annotations[v1alpha1.ElasticWorldSizeKey] != testcase.ExpectedWorldSize {
+				t.Errorf("Expected world size %s of local job, but got %s", testcase.ExpectedWorldSize, job.Annotations[v1alpha1.ElasticWorldSizeKey])
+			}
+
+			newJob, err := fakeController.vcClient.BatchV1alpha1().Jobs(namespace).Get(context.TODO(), job.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Failed to get job: %v", err)
+			}
+			if newJob.Annotations[v1alpha1.ElasticWorldSizeKey] != testcase.ExpectedWorldSize {
+				t.Errorf("Expected world size %s of job, but got %s", testcase.ExpectedWorldSize, newJob.Annotations[v1alpha1.ElasticWorldSizeKey])
+			}
+
+			for _, pods := range testcase.Pods {
+				for _, pod := range pods {
+					if pod.Status.Phase == v1.PodFailed {
+						continue
+					}
+					newPod, err := fakeController.kubeClient.CoreV1().Pods(namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{})
+					if err != nil {
+						t.Fatalf("Failed to get pod: %v", err)
+					}
+					_, patched := newPod.Annotations[v1alpha1.ElasticWorldSizeKey]
+					if patched != testcase.ExpectPodsPatched {
+						t.Errorf("Expected world size of pod %s patched %t, but got %t", pod.Name, testcase.ExpectPodsPatched, patched)
+					}
+					if patched && newPod.Annotations[v1alpha1.ElasticWorldSizeKey] != testcase.ExpectedWorldSize {
+						t.Errorf("Expected world size %s of pod %s, but got %s", testcase.ExpectedWorldSize, pod.Name, newPod.Annotations[v1alpha1.ElasticWorldSizeKey])
+					}
+				}
+			}
+		})
+	}
+}
+
 func TestRecordPodGroupEvent(t *testing.T) {
 	job1 := &v1alpha1.Job{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1481,3 +1583,196 @@ func TestGetSubGroupPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestPodGroupRunningEstimate(t *testing.T) {
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "test",
+			Name:        "job1",
+			UID:         "e7f18111-1cec-11ea-b688-fa163ec79500",
+			Annotations: map[string]string{"volcano.sh/preemptable": "true"},
+		},
+		Spec: v1alpha1.JobSpec{
+			RunningEstimate: &metav1.Duration{Duration: 90 * time.Minute},
+			Tasks: []v1alpha1.TaskSpec{
+				{
+					Name:     "task1",
+					Replicas: 1,
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{Containers: []v1.Container{{Name: "Containers"}}},
+					},
+				},
+			},
+		},
+	}
+
+	fakeController := newFakeController()
+	if err := fakeController.createOrUpdatePodGroup(job); err != nil {
+		t.Fatalf("Failed to create PodGroup: %v", err)
+	}
+	pg, err := fakeController.vcClient.SchedulingV1beta1().PodGroups("test").Get(context.TODO(), "job1-"+string(job.UID), metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Expected PodGroup to get created, but got: %v", err)
+	}
+	if value := pg.Annotations[schedulingapi.JobRunningEstimate]; value != "1h30m0s" {
+		t.Errorf("Expected running estimate annotation 1h30m0s, but got %q", value)
+	}
+	if _, found := job.Annotations[schedulingapi.JobRunningEstimate]; found {
+		t.Errorf("Expected annotations of job not to be modified, but got %v", job.Annotations)
+	}
+
+	delete(pg.Annotations, schedulingapi.JobRunningEstimate)
+	if !updateRunningEstimate(pg, job) || pg.Annotations[schedulingapi.JobRunningEstimate] != "1h30m0s" {
+		t.Errorf("Expected running estimate annotation to be updated, but got %v", pg.Annotations)
+	}
+	if updateRunningEstimate(pg, job) {
+		t.Errorf("Expected PodGroup with running estimate not to be updated again")
+	}
+}
+
+func TestSuspendAndResumeJob(t *testing.T) {
+	namespace := "test"
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       namespace,
+			Name:            "job1",
+			UID:             "e7f18111-1cec-11ea-b688-fa163ec79500",
+			ResourceVersion: "100",
+		},
+		Spec: v1alpha1.JobSpec{
+			Queue:   "default",
+			Plugins: map[string][]string{"svc": {}, "ssh": {}},
+			Tasks: []v1alpha1.TaskSpec{
+				{
+					Name:     "task1",
+					Replicas: 1,
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{Containers: []v1.Container{{Name: "Containers"}}},
+					},
+				},
+			},
+		},
+		Status: v1alpha1.JobStatus{
+			MinAvailable: 1,
+			Running:      1,
+			State:        v1alpha1.JobState{Phase: v1alpha1.Running},
+			ControlledResources: map[string]string{
+				"plugin-svc": "svc",
+				"plugin-ssh": "ssh",
+			},
+		},
+	}
+	pg := &schedulingapi.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              "job1-e7f18111-1cec-11ea-b688-fa163ec79500",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+			Annotations:       map[string]string{schedulingapi.SuspendRequestedAnnotationKey: "reclaim"},
+		},
+		Status: schedulingapi.PodGroupStatus{Phase: schedulingapi.PodGroupRunning},
+	}
+	pod := buildPod(namespace, "job1-task1-0", v1.PodRunning, nil)
+
+	fakeController := newFakeController()
+	fakeController.queueInformer.Informer().GetIndexer().Add(&schedulingapi.Queue{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+	fakeController.pgInformer.Informer().GetIndexer().Add(pg)
+	if _, err := fakeController.vcClient.SchedulingV1beta1().PodGroups(namespace).Create(context.TODO(), pg, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create PodGroup: %v", err)
+	}
+	if _, err := fakeController.kubeClient.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create Pod: %v", err)
+	}
+	if _, err := fakeController.kubeClient.CoreV1().Services(namespace).Create(context.TODO(),
+		&v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: job.Name}}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create Service: %v", err)
+	}
+	if _, err := fakeController.kubeClient.CoreV1().Secrets(namespace).Create(context.TODO(),
+		&v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: job.Name + "-ssh"}}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create Secret: %v", err)
+	}
+	if _, err := fakeController.vcClient.BatchV1alpha1().Jobs(namespace).Create(context.TODO(), job, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Failed to create Job: %v", err)
+	}
+	if err := fakeController.cache.Add(job); err != nil {
+		t.Fatalf("Failed to add Job in cache: %v", err)
+	}
+
+	jobInfo := &apis.JobInfo{
+		Namespace: namespace,
+		Name:      job.Name,
+		Job:       job,
+		Pods:      map[string]map[string]*v1.Pod{"task1": {pod.Name: pod}},
+	}
+	err := fakeController.suspendJob(jobInfo, func(status *v1alpha1.JobStatus) bool {
+		status.State.Phase = v1alpha1.Suspending
+		return true
+	})
+	if err != nil {
+		t.Fatalf("Expected no error while suspending job, but got: %v", err)
+	}
+
+	suspended, _ := fakeController.vcClient.BatchV1alpha1().Jobs(namespace).Get(context.TODO(), job.Name, metav1.GetOptions{})
+	if !isSuspended(suspended) {
+		t.Errorf("Expected spec.suspend of the job to be set")
+	}
+	if suspended.Status.State.Phase != v1alpha1.Suspending || suspended.Status.SuspendedAt == nil ||
+		suspended.Status.SuspendReason != v1alpha1.SuspendReasonReclaimed {
+		t.Errorf("Expected job to be suspending since a time because of reclaim, but got %v", suspended.Status)
+	}
+	if _, err := fakeController.kubeClient.CoreV1().Pods(namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{}); err == nil {
+		t.Errorf("Expected pod of the suspended job to be deleted")
+	}
+	if _, err := fakeController.kubeClient.CoreV1().Services(namespace).Get(context.TODO(), job.Name, metav1.GetOptions{}); err == nil {
+		t.Errorf("Expected Service of the suspended job to be released")
+	}
+	suspendedPG, err := fakeController.vcClient.SchedulingV1beta1().PodGroups(namespace).Get(context.TODO(), pg.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Expected PodGroup of the suspended job to be kept, but got: %v", err)
+	}
+	if suspendedPG.Annotations[schedulingapi.SuspendedAnnotationKey] != "true" || suspendedPG.Status.Phase != schedulingapi.PodGroupPending {
+		t.Errorf("Expected PodGroup to be suspended and pending, but got %v, %v", suspendedPG.Annotations, suspendedPG.Status.Phase)
+	}
+	if _, found := suspendedPG.Annotations[schedulingapi.SuspendRequestedAnnotationKey]; found {
+		t.Errorf("Expected suspension request of the PodGroup to be cleared")
+	}
+	if !suspendedPG.CreationTimestamp.Equal(&pg.CreationTimestamp) {
+		t.Errorf("Expected PodGroup to keep its creation timestamp")
+	}
+
+	fakeController.pgInformer.Informer().GetIndexer().Update(suspendedPG)
+	got, err := fakeController.cache.Get(fmt.Sprintf("%s/%s", namespace, job.Name))
+	if err != nil {
+		t.Fatalf("Failed to get Job from cache: %v", err)
+	}
+	err = fakeController.resumeJob(got, func(status *v1alpha1.JobStatus) bool {
+		status.State.Phase = v1alpha1.Pending
+		return true
+	})
+	if err != nil {
+		t.Fatalf("Expected no error while resuming job, but got: %v", err)
+	}
+
+	resumed, _ := fakeController.vcClient.BatchV1alpha1().Jobs(namespace).Get(context.TODO(), job.Name, metav1.GetOptions{})
+	if isSuspended(resumed) {
+		t.Errorf("Expected spec.suspend of the job to be cleared")
+	}
+	if resumed.Status.State.Phase != v1alpha1.Pending || resumed.Status.SuspendedAt != nil || resumed.Status.SuspendReason != "" {
+		t.Errorf("Expected job to be pending without suspension, but got %v", resumed.Status)
+	}
+	if resumed.Status.RetryCount != 0 {
+		t.Errorf("Expected resumed job not to count a retry, but got %d", resumed.Status.RetryCount)
+	}
+	if _, err := fakeController.kubeClient.CoreV1().Services(namespace).Get(context.TODO(), job.Name, metav1.GetOptions{}); err != nil {
+		t.Errorf("Expected Service of the resumed job to be recreated, but got: %v", err)
+	}
+	if _, err := fakeController.kubeClient.CoreV1().Secrets(namespace).Get(context.TODO(), job.Name+"-ssh", metav1.GetOptions{}); err != nil {
+		t.Errorf("Expected ssh Secret of the resumed job to be recreated, but got: %v", err)
+	}
+	if resumed.Status.ControlledResources["plugin-svc"] != "svc" || resumed.Status.ControlledResources["plugin-ssh"] != "ssh" {
+		t.Errorf("Expected plugin resources of the resumed job to be controlled, but got %v", resumed.Status.ControlledResources)
+	}
+	resumedPG, _ := fakeController.vcClient.SchedulingV1beta1().PodGroups(namespace).Get(context.TODO(), pg.Name, metav1.GetOptions{})
+	if _, found := resumedPG.Annotations[schedulingapi.SuspendedAnnotationKey]; found {
+		t.Errorf("Expected suspension of the PodGroup to be cleared, but got %v", resumedPG.Annotations)
+	}
+}
@@ -489,6 +489,19 @@ func (cc *jobcontroller) updatePodGroup(oldObj, newObj interface{}) {
 		queue := cc.getWorkerQueue(key)
 		queue.Add(req)
 	}
+
+	// The scheduler requests to suspend the job when its queue is reclaimed.
+	_, requested := oldPG.Annotations[scheduling.SuspendRequestedAnnotationKey]
+	if _, found := newPG.Annotations[scheduling.SuspendRequestedAnnotationKey]; found && !requested {
+		req := apis.Request{
+			Namespace: newPG.Namespace,
+			JobName:   jobNameKey,
+			Action:    bus.SuspendJobAction,
+		}
+		key := jobhelpers.GetJobKeyByReq(&req)
+		queue := cc.getWorkerQueue(key)
+		queue.Add(req)
+	}
 }
 
 // TODO(k82cn): add handler for PodGroup unschedulable event.
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -138,6 +139,15 @@ func createJobPod(job *batch.Job, template *v1.PodTemplateSpec, ix int, jobForwa
 		} else if value, found := job.Annotations[schedulingv2.JDBMaxUnavailable]; found {
 			pod.Annotations[schedulingv2.JDBMaxUnavailable] = value
 		}
+
+		// The world size of an elastic job starts from its minAvailable until the scheduled pods are counted.
+		if jobhelpers.IsElasticJob(job) {
+			if value, found := job.Annotations[batch.ElasticWorldSizeKey]; found {
+				pod.Annotations[batch.ElasticWorldSizeKey] = value
+			} else {
+				pod.Annotations[batch.ElasticWorldSizeKey] = strconv.Itoa(int(job.Spec.MinAvailable))
+			}
+		}
 	}
 
 	if len(pod.Labels) == 0 {
@@ -179,7 +189,7 @@ func createJobPod(job *batch.Job, template *v1.PodTemplateSpec, ix int, jobForwa
 	return pod
 }
 
-func applyPolicies(job *batch.Job, req *apis.Request) (delayAct *delayAction) {
+func applyPolicies(job *batch.Job, pod *v1.Pod, req *apis.Request) (delayAct *delayAction) {
 	delayAct = &delayAction{
 		jobKey:    jobcache.JobKeyByReq(req),
 		event:     req.Event,
@@ -196,11 +206,19 @@ func applyPolicies(job *batch.Job, req *apis.Request) (delayAct *delayAction) {
 		return
 	}
 
+	// The suspension declared by the job spec takes precedence over the policies.
+	if action, found := suspendAction(job); found {
+		delayAct.action = action
+		return
+	}
+
 	// If the event is an internal event, we do not need to perform any action
 	if isInternalEvent(req.Event) {
 		return
 	}
 
+	delayAct.infrastructure = isInfrastructureFailure(req.Event, pod)
+
 	// Solve the scenario: When pod events accumulate and vcjobs with the same name are frequently created,
 	// it is easy for the pod to cause abnormal status of the newly created vcjob with the same name.
 	if len(req.JobUid) != 0 && job != nil && req.JobUid != job.UID {
@@ -231,21 +249,14 @@ func applyPolicies(job *batch.Job, req *apis.Request) (delayAct *delayAction) {
 							// execute the corresponding delay action and set the delay time based on the policy's Timeout.Duration.
 							// If a timeout policy is specified, set the delay to the timeout duration.
 							if !shouldConfigureTimeout(req.Event) || policy.Timeout != nil {
-								delayAct.action = policy.Action
-								if policy.Timeout != nil {
-									delayAct.delay = policy.Timeout.Duration
-								}
+								delayAct.applyPolicy(job, policy)
 								return
 							}
 						}
 					}
 
-					// 0 is not an error code, is prevented in validation admission controller
-					if policy.ExitCode != nil && *policy.ExitCode == req.ExitCode {
-						delayAct.action = policy.Action
-						if policy.Timeout != nil {
-							delayAct.delay = policy.Timeout.Duration
-						}
+					if matchExitCodes(policy, pod, req) {
+						delayAct.applyPolicy(job, policy)
 						return
 					}
 				}
@@ -261,21 +272,14 @@ func applyPolicies(job *batch.Job, req *apis.Request) (delayAct *delayAction) {
 		if len(policyEvents) > 0 && len(req.Event) > 0 {
 			if checkEventExist(policyEvents, req.Event) || checkEventExist(policyEvents, v1alpha1.AnyEvent) {
 				if !(shouldConfigureTimeout(req.Event) && policy.Timeout == nil) {
-					delayAct.action = policy.Action
-					if policy.Timeout != nil {
-						delayAct.delay = policy.Timeout.Duration
-					}
+					delayAct.applyPolicy(job, policy)
 					return
 				}
 			}
 		}
 
-		// 0 is not an error code, is prevented in validation admission controller
-		if policy.ExitCode != nil && *policy.ExitCode == req.ExitCode {
-			delayAct.action = policy.Action
-			if policy.Timeout != nil {
-				delayAct.delay = policy.Timeout.Duration
-			}
+		if matchExitCodes(policy, pod, req) {
+			delayAct.applyPolicy(job, policy)
 			return
 		}
 	}
@@ -283,6 +287,149 @@ func applyPolicies(job *batch.Job, req *apis.Request) (delayAct *delayAction) {
 	return
 }
 
+// applyPolicy sets the action of the policy to the delayed action, which is delayed by the timeout
+// or the restart backoff of the policy, whichever is longer.
+func (da *delayAction) applyPolicy(job *batch.Job, policy batch.LifecyclePolicy) {
+	da.action = policy.Action
+	if policy.Timeout != nil {
+		da.delay = policy.Timeout.Duration
+	}
+	if backoff := restartBackoff(job, policy, da.infrastructure); backoff > da.delay {
+		da.delay = backoff
+	}
+}
+
+// restartBackoff returns the delay of the restart action of the policy, it's the initial delay doubled
+// for each retry of the job of the same kind, and capped by the max delay.
+func restartBackoff(job *batch.Job, policy batch.LifecyclePolicy, infrastructure bool) time.Duration {
+	if policy.Backoff == nil {
+		return 0
+	}
+	switch policy.Action {
+	case v1alpha1.RestartJobAction, v1alpha1.RestartTaskAction, v1alpha1.RestartPodAction, v1alpha1.RestartPartitionAction:
+	default:
+		return 0
+	}
+
+	initialDelay, maxDelay := DefaultRetryBackoffInitialDelay, DefaultRetryBackoffMaxDelay
+	if policy.Backoff.InitialDelay != nil {
+		initialDelay = policy.Backoff.InitialDelay.Duration
+	}
+	if policy.Backoff.MaxDelay != nil {
+		maxDelay = policy.Backoff.MaxDelay.Duration
+	}
+
+	retries := job.Status.RetryCount
+	if infrastructure && job.Spec.MaxInfrastructureRetry != nil {
+		retries = job.Status.InfrastructureRetryCount
+	}
+
+	delay := initialDelay
+	for i := int32(0); i < retries && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// matchExitCodes checks whether the exit codes of the failed pod of the request match the policy.
+func matchExitCodes(policy batch.LifecyclePolicy, pod *v1.Pod, req *apis.Request) bool {
+	// 0 is not an error code, is prevented in validation admission controller
+	if policy.ExitCode != nil && *policy.ExitCode == req.ExitCode {
+		return true
+	}
+
+	requirement := policy.ExitCodes
+	if requirement == nil || req.Event != v1alpha1.PodFailedEvent {
+		return false
+	}
+
+	// Only the exit code of the first container is known if the pod has gone.
+	if pod == nil {
+		return requirement.ContainerName == nil && matchExitCodeRequirement(requirement, req.ExitCode)
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated == nil {
+			continue
+		}
+		if requirement.ContainerName != nil && *requirement.ContainerName != status.Name {
+			continue
+		}
+		if matchExitCodeRequirement(requirement, status.State.Terminated.ExitCode) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchExitCodeRequirement(requirement *batch.ExitCodeRequirement, exitCode int32) bool {
+	// The containers exited with 0 are succeeded, they are never matched.
+	if exitCode == 0 {
+		return false
+	}
+
+	found := false
+	for _, value := range requirement.Values {
+		if value == exitCode {
+			found = true
+			break
+		}
+	}
+	for _, r := range requirement.Ranges {
+		if r.Min <= exitCode && exitCode <= r.Max {
+			found = true
+			break
+		}
+	}
+
+	if requirement.Operator == batch.ExitCodeOpNotIn {
+		return !found
+	}
+	return found
+}
+
+// isInfrastructureFailure checks whether the pod fails because of the infrastructure rather than the
+// application, i.e. the pod is evicted, its node is lost or shut down, or its container is OOMKilled.
+func isInfrastructureFailure(event v1alpha1.Event, pod *v1.Pod) bool {
+	switch event {
+	case v1alpha1.PodEvictedEvent:
+		return true
+	case v1alpha1.PodFailedEvent:
+		if pod == nil {
+			return false
+		}
+	default:
+		return false
+	}
+
+	switch pod.Status.Reason {
+	case podEvictedReason, podNodeLostReason, podNodeShutdownReason:
+		return true
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.DisruptionTarget && condition.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil && status.State.Terminated.Reason == containerOOMKilledReason {
+			return true
+		}
+	}
+	return false
+}
+
+// getRequestPod returns the pod of the request from the job, or nil if it's not found.
+func getRequestPod(jobInfo *apis.JobInfo, req *apis.Request) *v1.Pod {
+	if pods, found := jobInfo.Pods[req.TaskName]; found {
+		return pods[req.PodName]
+	}
+	return nil
+}
+
 func shouldConfigureTimeout(event v1alpha1.Event) bool {
 	return event == v1alpha1.PodPendingEvent
 }
@@ -415,6 +562,26 @@ func isInternalEvent(event v1alpha1.Event) bool {
 	}
 }
 
+// suspendAction returns the action to reconcile the phase of the job with its spec.suspend:
+// the job is suspended once spec.suspend is set, and resumed once it is cleared.
+func suspendAction(job *batch.Job) (v1alpha1.Action, bool) {
+	if job == nil {
+		return "", false
+	}
+
+	switch job.Status.State.Phase {
+	case "", batch.Pending, batch.Running, batch.Restarting:
+		if isSuspended(job) {
+			return v1alpha1.SuspendJobAction, true
+		}
+	case batch.Suspending, batch.Suspended:
+		if !isSuspended(job) {
+			return v1alpha1.ResumeJobAction, true
+		}
+	}
+	return "", false
+}
+
 // isInternalAction checks if the action is an internal action
 func isInternalAction(action v1alpha1.Action) bool {
 	switch action {
@@ -430,7 +597,7 @@ func isInternalAction(action v1alpha1.Action) bool {
 }
 
 func GetStateAction(delayAct *delayAction) state.Action {
-	action := state.Action{Action: delayAct.action}
+	action := state.Action{Action: delayAct.action, Infrastructure: delayAct.infrastructure}
 
 	if delayAct.action == v1alpha1.RestartTaskAction {
 		action.Target = state.Target{TaskName: delayAct.taskName, Type: state.TargetTypeTask}
@@ -458,6 +625,7 @@ func GetActionType(action v1alpha1.Action) ActionType {
 		v1alpha1.RestartJobAction,
 		v1alpha1.TerminateJobAction,
 		v1alpha1.CompleteJobAction,
+		v1alpha1.SuspendJobAction,
 		v1alpha1.ResumeJobAction:
 		return JobAction
 	case v1alpha1.RestartTaskAction:
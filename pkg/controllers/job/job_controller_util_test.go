@@ -272,6 +272,33 @@ func TestCreateJobPod_JobForwarding(t *testing.T) {
 	}
 }
 
+// Test case: Verify the world size annotation of elastic job
+func TestCreateJobPod_Elastic(t *testing.T) {
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "elastic-job",
+			Namespace:   "test-ns",
+			Annotations: map[string]string{v1alpha1.JobElasticKey: "true"},
+		},
+		Spec: v1alpha1.JobSpec{MinAvailable: 2},
+	}
+	template := &v1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Name: "elastic-task"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "test", Image: "busybox"}}},
+	}
+
+	pod := createJobPod(job, template, 0, false, nil, &v1alpha1.TaskSpec{})
+	if pod.Annotations[v1alpha1.ElasticWorldSizeKey] != "2" {
+		t.Errorf("expected world size annotation '2' from minAvailable, got %s", pod.Annotations[v1alpha1.ElasticWorldSizeKey])
+	}
+
+	job.Annotations[v1alpha1.ElasticWorldSizeKey] = "3"
+	pod = createJobPod(job, template, 1, false, nil, &v1alpha1.TaskSpec{})
+	if pod.Annotations[v1alpha1.ElasticWorldSizeKey] != "3" {
+		t.Errorf("expected world size annotation '3' from job, got %s", pod.Annotations[v1alpha1.ElasticWorldSizeKey])
+	}
+}
+
 // Test case: Verify partition policy labels
 func TestCreateJobPod_PartitionPolicy(t *testing.T) {
 	job := &v1alpha1.Job{
@@ -963,7 +990,7 @@ func TestApplyPolicies(t *testing.T) {
 	for i, testcase := range testcases {
 
 		t.Run(testcase.Name, func(t *testing.T) {
-			action := applyPolicies(testcase.Job, testcase.Request)
+			action := applyPolicies(testcase.Job, nil, testcase.Request)
 
 			if testcase.ReturnVal != "" && action.action != "" && testcase.ReturnVal != action.action {
 				t.Errorf("Expected return value to be %s but got %s in case %d", testcase.ReturnVal, action.action, i)
@@ -972,6 +999,287 @@ func TestApplyPolicies(t *testing.T) {
 	}
 }
 
+func TestApplyPolicies_ExitCodesAndBackoff(t *testing.T) {
+	trainer := "trainer"
+	maxInfrastructureRetry := int32(5)
+
+	buildJob := func(retryCount, infrastructureRetryCount int32, policies ...v1alpha1.LifecyclePolicy) *v1alpha1.Job {
+		return &v1alpha1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: "test"},
+			Spec: v1alpha1.JobSpec{
+				MaxRetry:               10,
+				MaxInfrastructureRetry: &maxInfrastructureRetry,
+				Policies:               policies,
+			},
+			Status: v1alpha1.JobStatus{
+				RetryCount:               retryCount,
+				InfrastructureRetryCount: infrastructureRetryCount,
+			},
+		}
+	}
+	buildFailedPod := func(reason string, containers ...v1.ContainerStatus) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "job1-task1-0", Namespace: "test"},
+			Status: v1.PodStatus{
+				Phase:             v1.PodFailed,
+				Reason:            reason,
+				ContainerStatuses: containers,
+			},
+		}
+	}
+	terminated := func(name string, exitCode int32, reason string) v1.ContainerStatus {
+		return v1.ContainerStatus{
+			Name: name,
+			State: v1.ContainerState{
+				Terminated: &v1.ContainerStateTerminated{ExitCode: exitCode, Reason: reason},
+			},
+		}
+	}
+	failedReq := func(exitCode int32) *apis.Request {
+		return &apis.Request{
+			Namespace: "test",
+			JobName:   "job1",
+			TaskName:  "task1",
+			PodName:   "job1-task1-0",
+			Event:     busv1alpha1.PodFailedEvent,
+			ExitCode:  exitCode,
+		}
+	}
+	backoff := &v1alpha1.RetryBackoff{
+		InitialDelay: &metav1.Duration{Duration: 10 * time.Second},
+		MaxDelay:     &metav1.Duration{Duration: time.Minute},
+	}
+
+	testcases := []struct {
+		Name    string
+		Job     *v1alpha1.Job
+		Pod     *v1.Pod
+		Request *apis.Request
+
+		ExpectedAction         busv1alpha1.Action
+		ExpectedDelay          time.Duration
+		ExpectedInfrastructure bool
+	}{
+		{
+			Name: "exit code in range of the named container",
+			Job: buildJob(0, 0, v1alpha1.LifecyclePolicy{
+				Action: busv1alpha1.RestartJobAction,
+				ExitCodes: &v1alpha1.ExitCodeRequirement{
+					ContainerName: &trainer,
+					Ranges:        []v1alpha1.ExitCodeRange{{Min: 128, Max: 255}},
+				},
+			}),
+			Pod:            buildFailedPod("", terminated("sidecar", 0, "Completed"), terminated(trainer, 137, "Error")),
+			Request:        failedReq(0),
+			ExpectedAction: busv1alpha1.RestartJobAction,
+		},
+		{
+			Name: "exit code of the other container is not matched",
+			Job: buildJob(0, 0, v1alpha1.LifecyclePolicy{
+				Action: busv1alpha1.RestartJobAction,
+				ExitCodes: &v1alpha1.ExitCodeRequirement{
+					ContainerName: &trainer,
+					Values:        []int32{1},
+				},
+			}),
+			Pod:            buildFailedPod("", terminated("sidecar", 1, "Error"), terminated(trainer, 2, "Error")),
+			Request:        failedReq(1),
+			ExpectedAction: busv1alpha1.SyncJobAction,
+		},
+		{
+			Name: "exit code not in values",
+			Job: buildJob(0, 0, v1alpha1.LifecyclePolicy{
+				Action: busv1alpha1.AbortJobAction,
+				ExitCodes: &v1alpha1.ExitCodeRequirement{
+					Operator: v1alpha1.ExitCodeOpNotIn,
+					Values:   []int32{1, 2},
+				},
+			}),
+			Pod:            buildFailedPod("", terminated(trainer, 3, "Error")),
+			Request:        failedReq(3),
+			ExpectedAction: busv1alpha1.AbortJobAction,
+		},
+		{
+			Name: "exit code of the request is used if the pod has gone",
+			Job: buildJob(0, 0, v1alpha1.LifecyclePolicy{
+				Action:    busv1alpha1.RestartTaskAction,
+				ExitCodes: &v1alpha1.ExitCodeRequirement{Values: []int32{3}},
+			}),
+			Request:        failedReq(3),
+			ExpectedAction: busv1alpha1.RestartTaskAction,
+		},
+		{
+			Name: "backoff doubles with the retries",
+			Job: buildJob(2, 0, v1alpha1.LifecyclePolicy{
+				Action:    busv1alpha1.RestartJobAction,
+				ExitCodes: &v1alpha1.ExitCodeRequirement{Values: []int32{1}},
+				Backoff:   backoff,
+			}),
+			Pod:            buildFailedPod("", terminated(trainer, 1, "Error")),
+			Request:        failedReq(1),
+			ExpectedAction: busv1alpha1.RestartJobAction,
+			ExpectedDelay:  40 * time.Second,
+		},
+		{
+			Name: "backoff is capped by max delay",
+			Job: buildJob(5, 0, v1alpha1.LifecyclePolicy{
+				Action:    busv1alpha1.RestartJobAction,
+				ExitCodes: &v1alpha1.ExitCodeRequirement{Values: []int32{1}},
+				Backoff:   backoff,
+			}),
+			Pod:            buildFailedPod("", terminated(trainer, 1, "Error")),
+			Request:        failedReq(1),
+			ExpectedAction: busv1alpha1.RestartJobAction,
+			ExpectedDelay:  time.Minute,
+		},
+		{
+			Name: "backoff of infrastructure failure follows the infrastructure retries",
+			Job: buildJob(5, 1, v1alpha1.LifecyclePolicy{
+				Action:  busv1alpha1.RestartJobAction,
+				Event:   busv1alpha1.PodFailedEvent,
+				Backoff: backoff,
+			}),
+			Pod:                    buildFailedPod("", terminated(trainer, 137, "OOMKilled")),
+			Request:                failedReq(137),
+			ExpectedAction:         busv1alpha1.RestartJobAction,
+			ExpectedDelay:          20 * time.Second,
+			ExpectedInfrastructure: true,
+		},
+		{
+			Name: "evicted pod is an infrastructure failure",
+			Job: buildJob(0, 0, v1alpha1.LifecyclePolicy{
+				Action: busv1alpha1.RestartPodAction,
+				Event:  busv1alpha1.PodFailedEvent,
+			}),
+			Pod:                    buildFailedPod("Evicted"),
+			Request:                failedReq(0),
+			ExpectedAction:         busv1alpha1.RestartPodAction,
+			ExpectedInfrastructure: true,
+		},
+		{
+			Name: "timeout longer than backoff",
+			Job: buildJob(0, 0, v1alpha1.LifecyclePolicy{
+				Action:  busv1alpha1.RestartJobAction,
+				Event:   busv1alpha1.PodEvictedEvent,
+				Timeout: &metav1.Duration{Duration: time.Minute},
+				Backoff: backoff,
+			}),
+			Request: &apis.Request{
+				Namespace: "test",
+				JobName:   "job1",
+				TaskName:  "task1",
+				PodName:   "job1-task1-0",
+				Event:     busv1alpha1.PodEvictedEvent,
+			},
+			ExpectedAction:         busv1alpha1.RestartJobAction,
+			ExpectedDelay:          time.Minute,
+			ExpectedInfrastructure: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			delayAct := applyPolicies(testcase.Job, testcase.Pod, testcase.Request)
+
+			if delayAct.action != testcase.ExpectedAction {
+				t.Errorf("Expected action %s, but got %s", testcase.ExpectedAction, delayAct.action)
+			}
+			if delayAct.delay != testcase.ExpectedDelay {
+				t.Errorf("Expected delay %s, but got %s", testcase.ExpectedDelay, delayAct.delay)
+			}
+			if delayAct.infrastructure != testcase.ExpectedInfrastructure {
+				t.Errorf("Expected infrastructure %v, but got %v", testcase.ExpectedInfrastructure, delayAct.infrastructure)
+			}
+		})
+	}
+}
+
+func TestApplyPolicies_Suspend(t *testing.T) {
+	suspend, resume := true, false
+	buildJob := func(phase v1alpha1.JobPhase, suspend *bool) *v1alpha1.Job {
+		return &v1alpha1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: "test"},
+			Spec: v1alpha1.JobSpec{
+				Suspend: suspend,
+				Policies: []v1alpha1.LifecyclePolicy{
+					{Action: busv1alpha1.RestartJobAction, Event: busv1alpha1.PodEvictedEvent},
+				},
+			},
+			Status: v1alpha1.JobStatus{State: v1alpha1.JobState{Phase: phase}},
+		}
+	}
+	evictedReq := &apis.Request{
+		Namespace: "test",
+		JobName:   "job1",
+		TaskName:  "task1",
+		PodName:   "job1-task1-0",
+		Event:     busv1alpha1.PodEvictedEvent,
+	}
+
+	testcases := []struct {
+		Name           string
+		Job            *v1alpha1.Job
+		Request        *apis.Request
+		ExpectedAction busv1alpha1.Action
+	}{
+		{
+			Name:           "running job is suspended by spec",
+			Job:            buildJob(v1alpha1.Running, &suspend),
+			Request:        evictedReq,
+			ExpectedAction: busv1alpha1.SuspendJobAction,
+		},
+		{
+			Name:           "new job is created suspended",
+			Job:            buildJob("", &suspend),
+			Request:        &apis.Request{Namespace: "test", JobName: "job1"},
+			ExpectedAction: busv1alpha1.SuspendJobAction,
+		},
+		{
+			Name:           "suspended job is resumed by spec",
+			Job:            buildJob(v1alpha1.Suspended, &resume),
+			Request:        &apis.Request{Namespace: "test", JobName: "job1"},
+			ExpectedAction: busv1alpha1.ResumeJobAction,
+		},
+		{
+			Name:           "suspended job keeps suspended",
+			Job:            buildJob(v1alpha1.Suspended, &suspend),
+			Request:        &apis.Request{Namespace: "test", JobName: "job1"},
+			ExpectedAction: busv1alpha1.SyncJobAction,
+		},
+		{
+			Name:           "running job follows the policies if not suspended",
+			Job:            buildJob(v1alpha1.Running, nil),
+			Request:        evictedReq,
+			ExpectedAction: busv1alpha1.RestartJobAction,
+		},
+		{
+			Name:           "completing job is not suspended",
+			Job:            buildJob(v1alpha1.Completing, &suspend),
+			Request:        &apis.Request{Namespace: "test", JobName: "job1"},
+			ExpectedAction: busv1alpha1.SyncJobAction,
+		},
+		{
+			Name: "explicit action takes precedence over spec",
+			Job:  buildJob(v1alpha1.Running, &suspend),
+			Request: &apis.Request{
+				Namespace: "test",
+				JobName:   "job1",
+				Action:    busv1alpha1.AbortJobAction,
+			},
+			ExpectedAction: busv1alpha1.AbortJobAction,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			delayAct := applyPolicies(testcase.Job, nil, testcase.Request)
+			if delayAct.action != testcase.ExpectedAction {
+				t.Errorf("Expected action %s, but got %s", testcase.ExpectedAction, delayAct.action)
+			}
+		})
+	}
+}
+
 func TestTasksPriority_Less(t *testing.T) {
 	testcases := []struct {
 		Name          string
@@ -873,6 +873,137 @@ func TestRestartingState_Execute(t *testing.T) {
 	}
 }
 
+func TestRetryBudgets(t *testing.T) {
+	namespace := "test"
+	maxInfrastructureRetry := int32(2)
+
+	testcases := []struct {
+		Name                     string
+		Phase                    v1alpha1.JobPhase
+		MaxInfrastructureRetry   *int32
+		RetryCount               int32
+		InfrastructureRetryCount int32
+		Infrastructure           bool
+
+		ExpectedPhase                    v1alpha1.JobPhase
+		ExpectedRetryCount               int32
+		ExpectedInfrastructureRetryCount int32
+	}{
+		{
+			Name:                             "infrastructure failure is counted apart with MaxInfrastructureRetry",
+			Phase:                            v1alpha1.Running,
+			MaxInfrastructureRetry:           &maxInfrastructureRetry,
+			Infrastructure:                   true,
+			ExpectedPhase:                    v1alpha1.Restarting,
+			ExpectedInfrastructureRetryCount: 1,
+		},
+		{
+			Name:               "infrastructure failure is counted by RetryCount without MaxInfrastructureRetry",
+			Phase:              v1alpha1.Running,
+			Infrastructure:     true,
+			ExpectedPhase:      v1alpha1.Restarting,
+			ExpectedRetryCount: 1,
+		},
+		{
+			Name:                   "application failure is counted by RetryCount",
+			Phase:                  v1alpha1.Running,
+			MaxInfrastructureRetry: &maxInfrastructureRetry,
+			ExpectedPhase:          v1alpha1.Restarting,
+			ExpectedRetryCount:     1,
+		},
+		{
+			Name:                             "job fails once the infrastructure retries are used up",
+			Phase:                            v1alpha1.Restarting,
+			MaxInfrastructureRetry:           &maxInfrastructureRetry,
+			RetryCount:                       1,
+			InfrastructureRetryCount:         2,
+			ExpectedPhase:                    v1alpha1.Failed,
+			ExpectedRetryCount:               1,
+			ExpectedInfrastructureRetryCount: 2,
+		},
+		{
+			Name:                             "job restarts with infrastructure retries left",
+			Phase:                            v1alpha1.Restarting,
+			MaxInfrastructureRetry:           &maxInfrastructureRetry,
+			RetryCount:                       1,
+			InfrastructureRetryCount:         1,
+			ExpectedPhase:                    v1alpha1.Pending,
+			ExpectedRetryCount:               1,
+			ExpectedInfrastructureRetryCount: 1,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			jobInfo := &apis.JobInfo{
+				Namespace: namespace,
+				Name:      "job1",
+				Job: &v1alpha1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "job1",
+						Namespace:       namespace,
+						ResourceVersion: "100",
+					},
+					Spec: v1alpha1.JobSpec{
+						MaxRetry:               3,
+						MaxInfrastructureRetry: testcase.MaxInfrastructureRetry,
+						Tasks: []v1alpha1.TaskSpec{
+							{
+								Name:     "task1",
+								Replicas: 1,
+							},
+						},
+					},
+					Status: v1alpha1.JobStatus{
+						MinAvailable:             1,
+						RetryCount:               testcase.RetryCount,
+						InfrastructureRetryCount: testcase.InfrastructureRetryCount,
+						State: v1alpha1.JobState{
+							Phase: testcase.Phase,
+						},
+					},
+				},
+			}
+
+			testState := state.NewState(jobInfo)
+
+			fakecontroller := newFakeController()
+			state.KillJob = fakecontroller.killJob
+
+			_, err := fakecontroller.vcClient.BatchV1alpha1().Jobs(namespace).Create(context.TODO(), jobInfo.Job, metav1.CreateOptions{})
+			if err != nil {
+				t.Error("Error while creating Job")
+			}
+
+			err = fakecontroller.cache.Add(jobInfo.Job)
+			if err != nil {
+				t.Error("Error while adding Job in cache")
+			}
+
+			err = testState.Execute(state.Action{Action: busv1alpha1.RestartJobAction, Infrastructure: testcase.Infrastructure})
+			if err != nil {
+				t.Errorf("Expected Error not to occur but got: %s", err)
+			}
+
+			got, err := fakecontroller.cache.Get(fmt.Sprintf("%s/%s", namespace, jobInfo.Job.Name))
+			if err != nil {
+				t.Error("Error while retrieving value from Cache")
+			}
+
+			if got.Job.Status.State.Phase != testcase.ExpectedPhase {
+				t.Errorf("Expected Job phase to %s, but got %s", testcase.ExpectedPhase, got.Job.Status.State.Phase)
+			}
+			if got.Job.Status.RetryCount != testcase.ExpectedRetryCount {
+				t.Errorf("Expected RetryCount to %d, but got %d", testcase.ExpectedRetryCount, got.Job.Status.RetryCount)
+			}
+			if got.Job.Status.InfrastructureRetryCount != testcase.ExpectedInfrastructureRetryCount {
+				t.Errorf("Expected InfrastructureRetryCount to %d, but got %d",
+					testcase.ExpectedInfrastructureRetryCount, got.Job.Status.InfrastructureRetryCount)
+			}
+		})
+	}
+}
+
 func TestRunningState_Execute(t *testing.T) {
 	namespace := "test"
 
@@ -1385,6 +1516,100 @@ func TestRunningState_Execute(t *testing.T) {
 	}
 }
 
+func TestSuspendedState_Execute(t *testing.T) {
+	namespace := "test"
+	suspend := true
+
+	testcases := []struct {
+		Name          string
+		Phase         v1alpha1.JobPhase
+		Action        busv1alpha1.Action
+		ExpectedPhase v1alpha1.JobPhase
+	}{
+		{
+			Name:          "SuspendedState-AbortAction case",
+			Phase:         v1alpha1.Suspended,
+			Action:        busv1alpha1.AbortJobAction,
+			ExpectedPhase: v1alpha1.Aborting,
+		},
+		{
+			Name:          "SuspendedState-TerminateAction case",
+			Phase:         v1alpha1.Suspended,
+			Action:        busv1alpha1.TerminateJobAction,
+			ExpectedPhase: v1alpha1.Terminating,
+		},
+		{
+			Name:          "SuspendingState-AbortAction case",
+			Phase:         v1alpha1.Suspending,
+			Action:        busv1alpha1.AbortJobAction,
+			ExpectedPhase: v1alpha1.Aborting,
+		},
+		{
+			Name:          "SuspendedState-AnyOtherAction case",
+			Phase:         v1alpha1.Suspended,
+			Action:        busv1alpha1.SyncJobAction,
+			ExpectedPhase: v1alpha1.Suspended,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			job := &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "job1",
+					Namespace:       namespace,
+					UID:             "e7f18111-1cec-11ea-b688-fa163ec79500",
+					ResourceVersion: "100",
+				},
+				Spec: v1alpha1.JobSpec{Suspend: &suspend},
+				Status: v1alpha1.JobStatus{
+					State: v1alpha1.JobState{Phase: testcase.Phase},
+				},
+			}
+			pg := &schedulingapi.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   namespace,
+					Name:        "job1-e7f18111-1cec-11ea-b688-fa163ec79500",
+					Annotations: map[string]string{schedulingapi.SuspendedAnnotationKey: "true"},
+				},
+				Status: schedulingapi.PodGroupStatus{Phase: schedulingapi.PodGroupPending},
+			}
+
+			fakecontroller := newFakeController()
+			state.KillJob = fakecontroller.killJob
+			state.SuspendJob = fakecontroller.suspendJob
+
+			fakecontroller.pgInformer.Informer().GetIndexer().Add(pg)
+			if _, err := fakecontroller.vcClient.SchedulingV1beta1().PodGroups(namespace).Create(context.TODO(), pg, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Error while creating PodGroup: %v", err)
+			}
+			if _, err := fakecontroller.vcClient.BatchV1alpha1().Jobs(namespace).Create(context.TODO(), job, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Error while creating Job: %v", err)
+			}
+			if err := fakecontroller.cache.Add(job); err != nil {
+				t.Fatalf("Error while adding Job in cache: %v", err)
+			}
+
+			jobInfo := &apis.JobInfo{Namespace: namespace, Name: job.Name, Job: job}
+			if err := state.NewState(jobInfo).Execute(state.Action{Action: testcase.Action}); err != nil {
+				t.Fatalf("Expected Error not to occur but got: %s", err)
+			}
+
+			got, err := fakecontroller.cache.Get(fmt.Sprintf("%s/%s", namespace, job.Name))
+			if err != nil {
+				t.Fatalf("Error while retrieving value from Cache: %v", err)
+			}
+			if got.Job.Status.State.Phase != testcase.ExpectedPhase {
+				t.Errorf("Expected Phase to be %s, but got %s", testcase.ExpectedPhase, got.Job.Status.State.Phase)
+			}
+			_, err = fakecontroller.vcClient.SchedulingV1beta1().PodGroups(namespace).Get(context.TODO(), pg.Name, metav1.GetOptions{})
+			if kept := err == nil; kept != (testcase.ExpectedPhase == v1alpha1.Suspended) {
+				t.Errorf("Expected PodGroup kept only while the job is suspended, but got: %v", err)
+			}
+		})
+	}
+}
+
 func TestTerminatingState_Execute(t *testing.T) {
 	namespace := "test"
 
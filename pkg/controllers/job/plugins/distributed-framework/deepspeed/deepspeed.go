@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deepspeed
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/apis/pkg/apis/helpers"
+	jobhelpers "volcano.sh/volcano/pkg/controllers/job/helpers"
+	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+const (
+	// DeepSpeedPluginName is the name of the plugin
+	DeepSpeedPluginName = "deepspeed"
+	// DefaultPort is the default port for ssh
+	DefaultPort = 22
+	// DefaultLauncher is the default task name of launcher host
+	DefaultLauncher = "launcher"
+	// DefaultWorker is the default task name of worker host
+	DefaultWorker = "worker"
+
+	// HostfileMountPath is the mount path of the hostfile in the launcher
+	HostfileMountPath = "/etc/deepspeed"
+	// HostfileName is the name of the hostfile
+	HostfileName = "hostfile"
+	// EnvHostfile is the env name of the hostfile path, for `deepspeed --hostfile`
+	EnvHostfile = "DEEPSPEED_HOSTFILE"
+)
+
+type deepSpeedPlugin struct {
+	deepSpeedArguments []string
+	clientset          pluginsinterface.PluginClientset
+	launcherName       string
+	workerName         string
+	port               int
+	slots              int
+	slotResource       string
+}
+
+// New creates deepspeed plugin.
+func New(client pluginsinterface.PluginClientset, arguments []string) pluginsinterface.PluginInterface {
+	dp := deepSpeedPlugin{deepSpeedArguments: arguments, clientset: client}
+	dp.addFlags()
+	return &dp
+}
+
+func (dp *deepSpeedPlugin) addFlags() {
+	flagSet := flag.NewFlagSet(dp.Name(), flag.ContinueOnError)
+	flagSet.StringVar(&dp.launcherName, "launcher", DefaultLauncher, "name of launcher role task")
+	flagSet.StringVar(&dp.workerName, "worker", DefaultWorker, "name of worker role task")
+	flagSet.IntVar(&dp.port, "port", DefaultPort, "open port for containers")
+	flagSet.IntVar(&dp.slots, "slots", 0, "slots of each worker, it's the number of the slot-resource requested by the worker if not set")
+	flagSet.StringVar(&dp.slotResource, "slot-resource", api.GPUResourceName, "resource requested by the worker to count its slots")
+	if err := flagSet.Parse(dp.deepSpeedArguments); err != nil {
+		klog.Errorf("plugin %s flagset parse failed, err: %v", dp.Name(), err)
+	}
+}
+
+func (dp *deepSpeedPlugin) Name() string {
+	return DeepSpeedPluginName
+}
+
+func (dp *deepSpeedPlugin) OnPodCreate(pod *v1.Pod, job *batch.Job) error {
+	isLauncher := jobhelpers.GetTaskKey(pod) == dp.launcherName
+	if isLauncher {
+		dp.mountHostfile(pod, job)
+	}
+
+	// open port for ssh and add the hostfile env for launcher task
+	for i := range pod.Spec.Containers {
+		dp.openContainerPort(i, pod)
+		if isLauncher {
+			pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, v1.EnvVar{
+				Name:  EnvHostfile,
+				Value: filepath.Join(HostfileMountPath, HostfileName),
+			})
+		}
+	}
+
+	return nil
+}
+
+// generateHostfile generates the hostfile of the workers for the deepspeed launcher, e.g.
+//
+//	job-worker-0.job slots=8
+//	job-worker-1.job slots=8
+func (dp *deepSpeedPlugin) generateHostfile(job *batch.Job) map[string]string {
+	workerIndex := jobhelpers.GetTaskIndexUnderJob(dp.workerName, job)
+	if workerIndex == -1 {
+		klog.Errorf("job %v doesn't have task %v", job.Name, dp.workerName)
+		return map[string]string{HostfileName: ""}
+	}
+
+	ts := job.Spec.Tasks[workerIndex]
+	slots := dp.slots
+	if slots <= 0 {
+		slots = jobhelpers.GetTaskSlots(ts, v1.ResourceName(dp.slotResource))
+	}
+
+	var builder strings.Builder
+	for i := 0; i < int(ts.Replicas); i++ {
+		builder.WriteString(fmt.Sprintf("%s slots=%d\n", jobhelpers.MakeDomainName(ts, job, i), slots))
+	}
+	return map[string]string{HostfileName: builder.String()}
+}
+
+func (dp *deepSpeedPlugin) mountHostfile(pod *v1.Pod, job *batch.Job) {
+	cmName := dp.cmName(job)
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: cmName,
+		VolumeSource: v1.VolumeSource{
+			ConfigMap: &v1.ConfigMapVolumeSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: cmName},
+			},
+		},
+	})
+
+	vm := v1.VolumeMount{
+		Name:      cmName,
+		MountPath: HostfileMountPath,
+		ReadOnly:  true,
+	}
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].VolumeMounts = append(pod.Spec.Containers[i].VolumeMounts, vm)
+	}
+}
+
+func (dp *deepSpeedPlugin) openContainerPort(index int, pod *v1.Pod) {
+	for _, p := range pod.Spec.Containers[index].Ports {
+		if p.ContainerPort == int32(dp.port) {
+			return
+		}
+	}
+
+	pod.Spec.Containers[index].Ports = append(pod.Spec.Containers[index].Ports, v1.ContainerPort{
+		Name:          "deepspeed-port",
+		ContainerPort: int32(dp.port),
+	})
+}
+
+func (dp *deepSpeedPlugin) OnJobAdd(job *batch.Job) error {
+	if job.Status.ControlledResources["plugin-"+dp.Name()] == dp.Name() {
+		return nil
+	}
+
+	// Create ConfigMap of hostfile for launcher to mount.
+	if err := helpers.CreateOrUpdateConfigMap(job, dp.clientset.KubeClients, dp.generateHostfile(job), dp.cmName(job)); err != nil {
+		return err
+	}
+
+	job.Status.ControlledResources["plugin-"+dp.Name()] = dp.Name()
+	return nil
+}
+
+func (dp *deepSpeedPlugin) OnJobDelete(job *batch.Job) error {
+	if job.Status.ControlledResources["plugin-"+dp.Name()] != dp.Name() {
+		return nil
+	}
+
+	if err := helpers.DeleteConfigmap(job, dp.clientset.KubeClients, dp.cmName(job)); err != nil {
+		return err
+	}
+
+	delete(job.Status.ControlledResources, "plugin-"+dp.Name())
+	return nil
+}
+
+func (dp *deepSpeedPlugin) OnJobUpdate(job *batch.Job) error {
+	// updates ConfigMap of hostfile when the workers are scaled.
+	return helpers.CreateOrUpdateConfigMap(job, dp.clientset.KubeClients, dp.generateHostfile(job), dp.cmName(job))
+}
+
+func (dp *deepSpeedPlugin) cmName(job *batch.Job) string {
+	return fmt.Sprintf("%s-%s", job.Name, dp.Name())
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deepspeed
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func newTestJob() *v1alpha1.Job {
+	return &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-deepspeed", Namespace: "default"},
+		Spec: v1alpha1.JobSpec{
+			Tasks: []v1alpha1.TaskSpec{
+				{
+					Name:     "launcher",
+					Replicas: 1,
+					Template: v1.PodTemplateSpec{},
+				},
+				{
+					Name:     "worker",
+					Replicas: 2,
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{
+								{
+									Name: "worker",
+									Resources: v1.ResourceRequirements{
+										Requests: v1.ResourceList{api.GPUResourceName: resource.MustParse("8")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Status: v1alpha1.JobStatus{ControlledResources: map[string]string{}},
+	}
+}
+
+func TestDeepSpeedHostfile(t *testing.T) {
+	testcases := []struct {
+		Name       string
+		PluginArgs []string
+		hostfile   string
+	}{
+		{
+			Name:     "slots from gpu requests",
+			hostfile: "test-deepspeed-worker-0.test-deepspeed slots=8\ntest-deepspeed-worker-1.test-deepspeed slots=8\n",
+		},
+		{
+			Name:       "slots from arguments",
+			PluginArgs: []string{"--slots=4"},
+			hostfile:   "test-deepspeed-worker-0.test-deepspeed slots=4\ntest-deepspeed-worker-1.test-deepspeed slots=4\n",
+		},
+		{
+			Name:       "worker task not found",
+			PluginArgs: []string{"--worker=trainer"},
+			hostfile:   "",
+		},
+	}
+
+	for index, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset()
+			job := newTestJob()
+			dp := New(pluginsinterface.PluginClientset{KubeClients: kubeClient}, testcase.PluginArgs)
+			if err := dp.OnJobAdd(job); err != nil {
+				t.Fatalf("Case %d (%s): OnJobAdd failed: %v", index, testcase.Name, err)
+			}
+			if job.Status.ControlledResources["plugin-"+dp.Name()] != dp.Name() {
+				t.Errorf("Case %d (%s): ControlledResources not updated: %v", index, testcase.Name, job.Status.ControlledResources)
+			}
+
+			cm, err := kubeClient.CoreV1().ConfigMaps(job.Namespace).Get(context.TODO(), "test-deepspeed-deepspeed", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Case %d (%s): failed to get hostfile configmap: %v", index, testcase.Name, err)
+			}
+			if cm.Data[HostfileName] != testcase.hostfile {
+				t.Errorf("Case %d (%s): wrong hostfile, got %q, expected %q", index, testcase.Name, cm.Data[HostfileName], testcase.hostfile)
+			}
+
+			if err := dp.OnJobDelete(job); err != nil {
+				t.Fatalf("Case %d (%s): OnJobDelete failed: %v", index, testcase.Name, err)
+			}
+			if _, ok := job.Status.ControlledResources["plugin-"+dp.Name()]; ok {
+				t.Errorf("Case %d (%s): expected ControlledResources entry to be deleted", index, testcase.Name)
+			}
+			_, err = kubeClient.CoreV1().ConfigMaps(job.Namespace).Get(context.TODO(), "test-deepspeed-deepspeed", metav1.GetOptions{})
+			if !apierrors.IsNotFound(err) {
+				t.Errorf("Case %d (%s): expected hostfile configmap to be deleted, got %v", index, testcase.Name, err)
+			}
+		})
+	}
+}
+
+func TestDeepSpeedOnPodCreate(t *testing.T) {
+	buildPod := func(task string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-deepspeed-" + task + "-0",
+				Annotations: map[string]string{v1alpha1.TaskSpecKey: task},
+			},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: task}},
+			},
+		}
+	}
+
+	dp := New(pluginsinterface.PluginClientset{}, nil)
+
+	launcher := buildPod("launcher")
+	if err := dp.OnPodCreate(launcher, newTestJob()); err != nil {
+		t.Fatalf("OnPodCreate failed: %v", err)
+	}
+	if len(launcher.Spec.Volumes) != 1 || launcher.Spec.Volumes[0].ConfigMap == nil ||
+		launcher.Spec.Volumes[0].ConfigMap.Name != "test-deepspeed-deepspeed" {
+		t.Errorf("expected hostfile volume in launcher, got %v", launcher.Spec.Volumes)
+	}
+	container := launcher.Spec.Containers[0]
+	if len(container.VolumeMounts) != 1 || container.VolumeMounts[0].MountPath != HostfileMountPath {
+		t.Errorf("expected hostfile mounted at %s, got %v", HostfileMountPath, container.VolumeMounts)
+	}
+	if len(container.Env) != 1 || container.Env[0].Name != EnvHostfile || container.Env[0].Value != "/etc/deepspeed/hostfile" {
+		t.Errorf("expected env %s in launcher, got %v", EnvHostfile, container.Env)
+	}
+	if len(container.Ports) != 1 || container.Ports[0].ContainerPort != DefaultPort {
+		t.Errorf("expected port %d in launcher, got %v", DefaultPort, container.Ports)
+	}
+
+	worker := buildPod("worker")
+	if err := dp.OnPodCreate(worker, newTestJob()); err != nil {
+		t.Fatalf("OnPodCreate failed: %v", err)
+	}
+	if len(worker.Spec.Volumes) != 0 || len(worker.Spec.Containers[0].Env) != 0 {
+		t.Errorf("expected no hostfile in worker, got volumes %v, envs %v", worker.Spec.Volumes, worker.Spec.Containers[0].Env)
+	}
+	if len(worker.Spec.Containers[0].Ports) != 1 || worker.Spec.Containers[0].Ports[0].ContainerPort != DefaultPort {
+		t.Errorf("expected port %d in worker, got %v", DefaultPort, worker.Spec.Containers[0].Ports)
+	}
+}
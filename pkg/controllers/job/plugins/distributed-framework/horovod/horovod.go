@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package horovod
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/volcano/pkg/controllers/job/helpers"
+	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+const (
+	// HorovodPluginName is the name of the plugin
+	HorovodPluginName = "horovod"
+	// DefaultPort is the default port for ssh
+	DefaultPort = 22
+	// DefaultLauncher is the default task name of launcher host
+	DefaultLauncher = "launcher"
+	// DefaultWorker is the default task name of worker host
+	DefaultWorker = "worker"
+
+	// EnvHosts is the env name of the worker hosts with their slots, e.g. host1:4,host2:4, for `horovodrun -H`
+	EnvHosts = "HOROVOD_HOSTS"
+	// EnvNumProc is the env name of the total slots of the workers, for `horovodrun -np`
+	EnvNumProc = "HOROVOD_NUM_PROC"
+)
+
+type horovodPlugin struct {
+	horovodArguments []string
+	clientset        pluginsinterface.PluginClientset
+	launcherName     string
+	workerName       string
+	port             int
+	slots            int
+	slotResource     string
+}
+
+// New creates horovod plugin.
+func New(client pluginsinterface.PluginClientset, arguments []string) pluginsinterface.PluginInterface {
+	hp := horovodPlugin{horovodArguments: arguments, clientset: client}
+	hp.addFlags()
+	return &hp
+}
+
+func (hp *horovodPlugin) addFlags() {
+	flagSet := flag.NewFlagSet(hp.Name(), flag.ContinueOnError)
+	flagSet.StringVar(&hp.launcherName, "launcher", DefaultLauncher, "name of launcher role task")
+	flagSet.StringVar(&hp.workerName, "worker", DefaultWorker, "name of worker role task")
+	flagSet.IntVar(&hp.port, "port", DefaultPort, "open port for containers")
+	flagSet.IntVar(&hp.slots, "slots", 0, "slots of each worker, it's the number of the slot-resource requested by the worker if not set")
+	flagSet.StringVar(&hp.slotResource, "slot-resource", api.GPUResourceName, "resource requested by the worker to count its slots")
+	if err := flagSet.Parse(hp.horovodArguments); err != nil {
+		klog.Errorf("plugin %s flagset parse failed, err: %v", hp.Name(), err)
+	}
+}
+
+func (hp *horovodPlugin) Name() string {
+	return HorovodPluginName
+}
+
+func (hp *horovodPlugin) OnPodCreate(pod *v1.Pod, job *batch.Job) error {
+	var envVars []v1.EnvVar
+	if helpers.GetTaskKey(pod) == hp.launcherName {
+		workerIndex := helpers.GetTaskIndexUnderJob(hp.workerName, job)
+		if workerIndex == -1 {
+			klog.Errorf("job %v doesn't have task %v", job.Name, hp.workerName)
+			return nil
+		}
+		hosts, numProc := hp.generateHosts(job.Spec.Tasks[workerIndex], job)
+		envVars = []v1.EnvVar{
+			{Name: EnvHosts, Value: hosts},
+			{Name: EnvNumProc, Value: strconv.Itoa(numProc)},
+		}
+	}
+
+	// open port for ssh and add the host list for launcher task
+	for i := range pod.Spec.Containers {
+		hp.openContainerPort(i, pod)
+		pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, envVars...)
+	}
+
+	return nil
+}
+
+// generateHosts generates the host list of the workers for horovodrun, and the total slots of them.
+func (hp *horovodPlugin) generateHosts(ts batch.TaskSpec, job *batch.Job) (string, int) {
+	slots := hp.slots
+	if slots <= 0 {
+		slots = helpers.GetTaskSlots(ts, v1.ResourceName(hp.slotResource))
+	}
+
+	hosts := make([]string, 0, ts.Replicas)
+	for i := 0; i < int(ts.Replicas); i++ {
+		hosts = append(hosts, fmt.Sprintf("%s:%d", helpers.MakeDomainName(ts, job, i), slots))
+	}
+	return strings.Join(hosts, ","), slots * int(ts.Replicas)
+}
+
+func (hp *horovodPlugin) openContainerPort(index int, pod *v1.Pod) {
+	for _, p := range pod.Spec.Containers[index].Ports {
+		if p.ContainerPort == int32(hp.port) {
+			return
+		}
+	}
+
+	pod.Spec.Containers[index].Ports = append(pod.Spec.Containers[index].Ports, v1.ContainerPort{
+		Name:          "horovodjob-port",
+		ContainerPort: int32(hp.port),
+	})
+}
+
+func (hp *horovodPlugin) OnJobAdd(job *batch.Job) error {
+	if job.Status.ControlledResources["plugin-"+hp.Name()] == hp.Name() {
+		return nil
+	}
+	job.Status.ControlledResources["plugin-"+hp.Name()] = hp.Name()
+	return nil
+}
+
+func (hp *horovodPlugin) OnJobDelete(job *batch.Job) error {
+	if job.Status.ControlledResources["plugin-"+hp.Name()] != hp.Name() {
+		return nil
+	}
+	delete(job.Status.ControlledResources, "plugin-"+hp.Name())
+	return nil
+}
+
+func (hp *horovodPlugin) OnJobUpdate(job *batch.Job) error {
+	return nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package horovod
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestHorovodPodEnvAndPort(t *testing.T) {
+	gpuWorker := v1.PodTemplateSpec{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name: "worker",
+					Resources: v1.ResourceRequirements{
+						Limits: v1.ResourceList{api.GPUResourceName: resource.MustParse("4")},
+					},
+				},
+			},
+		},
+	}
+	buildJob := func(worker v1.PodTemplateSpec) *v1alpha1.Job {
+		return &v1alpha1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-horovod"},
+			Spec: v1alpha1.JobSpec{
+				Tasks: []v1alpha1.TaskSpec{
+					{
+						Name:     "launcher",
+						Replicas: 1,
+						Template: v1.PodTemplateSpec{},
+					},
+					{
+						Name:     "worker",
+						Replicas: 2,
+						Template: worker,
+					},
+				},
+			},
+		}
+	}
+	buildPod := func(name, task string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{v1alpha1.TaskSpecKey: task},
+			},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: task}},
+			},
+		}
+	}
+
+	testcases := []struct {
+		Name       string
+		PluginArgs []string
+		Job        *v1alpha1.Job
+		Pod        *v1.Pod
+		envs       []v1.EnvVar
+	}{
+		{
+			Name: "launcher gets the hosts with slots from gpu requests",
+			Job:  buildJob(gpuWorker),
+			Pod:  buildPod("test-horovod-launcher-0", "launcher"),
+			envs: []v1.EnvVar{
+				{Name: EnvHosts, Value: "test-horovod-worker-0.test-horovod:4,test-horovod-worker-1.test-horovod:4"},
+				{Name: EnvNumProc, Value: "8"},
+			},
+		},
+		{
+			Name:       "launcher gets the hosts with the given slots",
+			PluginArgs: []string{"--slots=2"},
+			Job:        buildJob(gpuWorker),
+			Pod:        buildPod("test-horovod-launcher-0", "launcher"),
+			envs: []v1.EnvVar{
+				{Name: EnvHosts, Value: "test-horovod-worker-0.test-horovod:2,test-horovod-worker-1.test-horovod:2"},
+				{Name: EnvNumProc, Value: "4"},
+			},
+		},
+		{
+			Name: "launcher gets 1 slot of each worker without gpu",
+			Job:  buildJob(v1.PodTemplateSpec{}),
+			Pod:  buildPod("test-horovod-launcher-0", "launcher"),
+			envs: []v1.EnvVar{
+				{Name: EnvHosts, Value: "test-horovod-worker-0.test-horovod:1,test-horovod-worker-1.test-horovod:1"},
+				{Name: EnvNumProc, Value: "2"},
+			},
+		},
+		{
+			Name: "worker only opens the ssh port",
+			Job:  buildJob(gpuWorker),
+			Pod:  buildPod("test-horovod-worker-0", "worker"),
+			envs: nil,
+		},
+	}
+
+	for index, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			hp := New(pluginsinterface.PluginClientset{}, testcase.PluginArgs)
+			if err := hp.OnPodCreate(testcase.Pod, testcase.Job); err != nil {
+				t.Errorf("Case %d (%s): expect no error, but got error %v", index, testcase.Name, err)
+			}
+
+			container := testcase.Pod.Spec.Containers[0]
+			if len(container.Ports) != 1 || container.Ports[0].ContainerPort != DefaultPort {
+				t.Errorf("Case %d (%s): wrong ports, got %v, expected %v", index, testcase.Name, container.Ports, DefaultPort)
+			}
+
+			if !equality.Semantic.DeepEqual(container.Env, testcase.envs) {
+				t.Errorf("Case %d (%s): wrong envs, got %v, expected %v", index, testcase.Name, container.Env, testcase.envs)
+			}
+		})
+	}
+}
+
+func TestHorovodOnJobAdd(t *testing.T) {
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-horovod"},
+		Status:     v1alpha1.JobStatus{ControlledResources: map[string]string{}},
+	}
+
+	hp := New(pluginsinterface.PluginClientset{}, nil)
+	if err := hp.OnJobAdd(job); err != nil {
+		t.Fatalf("OnJobAdd failed: %v", err)
+	}
+	if job.Status.ControlledResources["plugin-"+hp.Name()] != hp.Name() {
+		t.Errorf("ControlledResources not updated: %v", job.Status.ControlledResources)
+	}
+
+	if err := hp.OnJobDelete(job); err != nil {
+		t.Fatalf("OnJobDelete failed: %v", err)
+	}
+	if _, ok := job.Status.ControlledResources["plugin-"+hp.Name()]; ok {
+		t.Errorf("expected ControlledResources entry to be deleted")
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jax
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/volcano/pkg/controllers/job/helpers"
+	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+)
+
+const (
+	// JAXPluginName is the name of the plugin
+	JAXPluginName = "jax"
+	// DefaultPort is the default port of the coordinator
+	DefaultPort = 1234
+
+	// EnvCoordinatorAddress is the env name of the coordinator address, which is the process 0
+	EnvCoordinatorAddress = "JAX_COORDINATOR_ADDRESS"
+	// EnvNumProcesses is the env name of the number of processes
+	EnvNumProcesses = "JAX_NUM_PROCESSES"
+	// EnvProcessID is the env name of the id of the process
+	EnvProcessID = "JAX_PROCESS_ID"
+)
+
+type jaxPlugin struct {
+	jaxArguments []string
+	clientset    pluginsinterface.PluginClientset
+	port         int
+}
+
+// New creates jax plugin.
+func New(client pluginsinterface.PluginClientset, arguments []string) pluginsinterface.PluginInterface {
+	jp := jaxPlugin{jaxArguments: arguments, clientset: client}
+	jp.addFlags()
+	return &jp
+}
+
+func (jp *jaxPlugin) addFlags() {
+	flagSet := flag.NewFlagSet(jp.Name(), flag.ContinueOnError)
+	flagSet.IntVar(&jp.port, "port", DefaultPort, "open port of the coordinator")
+	if err := flagSet.Parse(jp.jaxArguments); err != nil {
+		klog.Errorf("plugin %s flagset parse failed, err: %v", jp.Name(), err)
+	}
+}
+
+func (jp *jaxPlugin) Name() string {
+	return JAXPluginName
+}
+
+// OnPodCreate makes every pod of the job a process of the multi-host jax, the processes are numbered by the order
+// of the tasks and the index of the pod in its task, and the process 0 is the coordinator.
+func (jp *jaxPlugin) OnPodCreate(pod *v1.Pod, job *batch.Job) error {
+	if len(job.Spec.Tasks) == 0 {
+		return nil
+	}
+
+	index, err := strconv.Atoi(helpers.GetPodIndexUnderTask(pod))
+	if err != nil {
+		return err
+	}
+	taskIndex := helpers.GetTaskIndexUnderJob(helpers.GetTaskKey(pod), job)
+	if taskIndex == -1 {
+		klog.Errorf("job %v doesn't have task %v", job.Name, helpers.GetTaskKey(pod))
+		return nil
+	}
+
+	processID := index
+	numProcesses := 0
+	for i, ts := range job.Spec.Tasks {
+		if i < taskIndex {
+			processID += int(ts.Replicas)
+		}
+		numProcesses += int(ts.Replicas)
+	}
+
+	coordinator := fmt.Sprintf("%s:%d", helpers.MakeDomainName(job.Spec.Tasks[0], job, 0), jp.port)
+	envVars := []v1.EnvVar{
+		{Name: EnvCoordinatorAddress, Value: coordinator},
+		{Name: EnvNumProcesses, Value: strconv.Itoa(numProcesses)},
+		{Name: EnvProcessID, Value: strconv.Itoa(processID)},
+	}
+
+	for i := range pod.Spec.Containers {
+		if processID == 0 {
+			jp.openContainerPort(i, pod)
+		}
+		pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, envVars...)
+	}
+
+	return nil
+}
+
+func (jp *jaxPlugin) openContainerPort(index int, pod *v1.Pod) {
+	for _, p := range pod.Spec.Containers[index].Ports {
+		if p.ContainerPort == int32(jp.port) {
+			return
+		}
+	}
+
+	pod.Spec.Containers[index].Ports = append(pod.Spec.Containers[index].Ports, v1.ContainerPort{
+		Name:          "jaxjob-port",
+		ContainerPort: int32(jp.port),
+	})
+}
+
+func (jp *jaxPlugin) OnJobAdd(job *batch.Job) error {
+	if job.Status.ControlledResources["plugin-"+jp.Name()] == jp.Name() {
+		return nil
+	}
+	job.Status.ControlledResources["plugin-"+jp.Name()] = jp.Name()
+	return nil
+}
+
+func (jp *jaxPlugin) OnJobDelete(job *batch.Job) error {
+	if job.Status.ControlledResources["plugin-"+jp.Name()] != jp.Name() {
+		return nil
+	}
+	delete(job.Status.ControlledResources, "plugin-"+jp.Name())
+	return nil
+}
+
+func (jp *jaxPlugin) OnJobUpdate(job *batch.Job) error {
+	return nil
+}
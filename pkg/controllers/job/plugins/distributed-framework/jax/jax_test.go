@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jax
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
+)
+
+func TestJAXPodEnvAndPort(t *testing.T) {
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-jax"},
+		Spec: v1alpha1.JobSpec{
+			Tasks: []v1alpha1.TaskSpec{
+				{
+					Name:     "chief",
+					Replicas: 1,
+					Template: v1.PodTemplateSpec{},
+				},
+				{
+					Name:     "worker",
+					Replicas: 3,
+					Template: v1.PodTemplateSpec{},
+				},
+			},
+		},
+	}
+	buildPod := func(name, task, index string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Annotations: map[string]string{
+					v1alpha1.TaskSpecKey: task,
+					v1alpha1.TaskIndex:   index,
+				},
+			},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: task}},
+			},
+		}
+	}
+
+	testcases := []struct {
+		Name       string
+		PluginArgs []string
+		Pod        *v1.Pod
+		port       int32
+		envs       []v1.EnvVar
+	}{
+		{
+			Name: "the first pod of the first task is the coordinator",
+			Pod:  buildPod("test-jax-chief-0", "chief", "0"),
+			port: DefaultPort,
+			envs: []v1.EnvVar{
+				{Name: EnvCoordinatorAddress, Value: "test-jax-chief-0.test-jax:1234"},
+				{Name: EnvNumProcesses, Value: "4"},
+				{Name: EnvProcessID, Value: "0"},
+			},
+		},
+		{
+			Name:       "the process id of the worker follows the tasks before it",
+			PluginArgs: []string{"--port=5000"},
+			Pod:        buildPod("test-jax-worker-2", "worker", "2"),
+			port:       -1,
+			envs: []v1.EnvVar{
+				{Name: EnvCoordinatorAddress, Value: "test-jax-chief-0.test-jax:5000"},
+				{Name: EnvNumProcesses, Value: "4"},
+				{Name: EnvProcessID, Value: "3"},
+			},
+		},
+	}
+
+	for index, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			jp := New(pluginsinterface.PluginClientset{}, testcase.PluginArgs)
+			if err := jp.OnPodCreate(testcase.Pod, job); err != nil {
+				t.Errorf("Case %d (%s): expect no error, but got error %v", index, testcase.Name, err)
+			}
+
+			container := testcase.Pod.Spec.Containers[0]
+			if testcase.port != -1 {
+				if len(container.Ports) != 1 || container.Ports[0].ContainerPort != testcase.port {
+					t.Errorf("Case %d (%s): wrong ports, got %v, expected %v", index, testcase.Name, container.Ports, testcase.port)
+				}
+			} else if container.Ports != nil {
+				t.Errorf("Case %d (%s): wrong ports, got %v, expected empty", index, testcase.Name, container.Ports)
+			}
+
+			if !equality.Semantic.DeepEqual(container.Env, testcase.envs) {
+				t.Errorf("Case %d (%s): wrong envs, got %v, expected %v", index, testcase.Name, container.Env, testcase.envs)
+			}
+		})
+	}
+}
+
+func TestJAXOnJobAdd(t *testing.T) {
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-jax"},
+		Status:     v1alpha1.JobStatus{ControlledResources: map[string]string{}},
+	}
+
+	jp := New(pluginsinterface.PluginClientset{}, nil)
+	if err := jp.OnJobAdd(job); err != nil {
+		t.Fatalf("OnJobAdd failed: %v", err)
+	}
+	if job.Status.ControlledResources["plugin-"+jp.Name()] != jp.Name() {
+		t.Errorf("ControlledResources not updated: %v", job.Status.ControlledResources)
+	}
+
+	if err := jp.OnJobDelete(job); err != nil {
+		t.Fatalf("OnJobDelete failed: %v", err)
+	}
+	if _, ok := job.Status.ControlledResources["plugin-"+jp.Name()]; ok {
+		t.Errorf("expected ControlledResources entry to be deleted")
+	}
+}
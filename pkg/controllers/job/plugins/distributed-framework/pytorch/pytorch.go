@@ -17,14 +17,19 @@ limitations under the License.
 package pytorch
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"strconv"
 
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/klog/v2"
 
 	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	apishelpers "volcano.sh/apis/pkg/apis/helpers"
 	"volcano.sh/volcano/pkg/controllers/job/helpers"
 	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
 )
@@ -42,6 +47,12 @@ const (
 	DefaultTimeout = 300
 	// DefaultWaitMasterImage is the default image for wait-for-master init container
 	DefaultWaitMasterImage = "busybox:1.36.1"
+	// DefaultRdzvPort is the default port of the c10d rendezvous in elastic mode
+	DefaultRdzvPort = 29400
+	// DefaultMaxRestarts is the default max restarts of the worker group in elastic mode
+	DefaultMaxRestarts = 3
+	// RdzvBackendC10d is the c10d rendezvous backend of torch elastic
+	RdzvBackendC10d = "c10d"
 
 	// EnvMasterPort is the env name of master port
 	EnvMasterPort = "MASTER_PORT"
@@ -51,6 +62,21 @@ const (
 	EnvWorldSize = "WORLD_SIZE"
 	// EnvRank is the env name of rank
 	EnvRank = "RANK"
+
+	// EnvRdzvBackend is the env name of rendezvous backend for torchrun
+	EnvRdzvBackend = "PET_RDZV_BACKEND"
+	// EnvRdzvEndpoint is the env name of rendezvous endpoint for torchrun
+	EnvRdzvEndpoint = "PET_RDZV_ENDPOINT"
+	// EnvRdzvID is the env name of rendezvous id for torchrun
+	EnvRdzvID = "PET_RDZV_ID"
+	// EnvNnodes is the env name of the number of nodes for torchrun, which is in the form of min:max in elastic mode
+	EnvNnodes = "PET_NNODES"
+	// EnvMaxRestarts is the env name of max restarts for torchrun
+	EnvMaxRestarts = "PET_MAX_RESTARTS"
+	// EnvNprocPerNode is the env name of the number of processes per node for torchrun
+	EnvNprocPerNode = "PET_NPROC_PER_NODE"
+	// EnvRdzvConf is the env name of rendezvous configs for torchrun
+	EnvRdzvConf = "PET_RDZV_CONF"
 )
 
 type pytorchPlugin struct {
@@ -62,6 +88,10 @@ type pytorchPlugin struct {
 	waitMasterEnabled bool
 	waitMasterTimeout int
 	waitMasterImage   string
+	elastic           bool
+	rdzvPort          int
+	maxRestarts       int
+	nprocPerNode      string
 }
 
 // New creates pytorch plugin.
@@ -79,6 +109,10 @@ func (pp *pytorchPlugin) addFlags() {
 	flagSet.BoolVar(&pp.waitMasterEnabled, "wait-master-enabled", false, "enable init container to wait for master")
 	flagSet.IntVar(&pp.waitMasterTimeout, "wait-master-timeout", DefaultTimeout, "timeout in seconds for waiting master to be ready (only effective when wait-master-enabled=true)")
 	flagSet.StringVar(&pp.waitMasterImage, "wait-master-image", DefaultWaitMasterImage, "image for wait-for-master init container (only effective when wait-master-enabled=true)")
+	flagSet.BoolVar(&pp.elastic, "elastic", false, "run the job by torchrun with c10d rendezvous instead of a static master")
+	flagSet.IntVar(&pp.rdzvPort, "rdzv-port", DefaultRdzvPort, "port of the c10d rendezvous (only effective when elastic=true)")
+	flagSet.IntVar(&pp.maxRestarts, "max-restarts", DefaultMaxRestarts, "max restarts of the worker group before failing (only effective when elastic=true)")
+	flagSet.StringVar(&pp.nprocPerNode, "nproc-per-node", "", "number of processes per node, e.g. 8 or gpu (only effective when elastic=true)")
 	if err := flagSet.Parse(pp.pytorchArguments); err != nil {
 		klog.Errorf("plugin %s flagset parse failed, err: %v", pp.Name(), err)
 	}
@@ -89,6 +123,10 @@ func (pp *pytorchPlugin) Name() string {
 }
 
 func (pp *pytorchPlugin) OnPodCreate(pod *v1.Pod, job *batch.Job) error {
+	if pp.isElastic(job) {
+		return pp.onElasticPodCreate(pod, job)
+	}
+
 	taskType := helpers.GetTaskKey(pod)
 	masterIndex := helpers.GetTaskIndexUnderJob(pp.masterName, job)
 	if masterIndex == -1 {
@@ -145,6 +183,117 @@ func (pp *pytorchPlugin) OnPodCreate(pod *v1.Pod, job *batch.Job) error {
 	return nil
 }
 
+// isElastic checks whether the pods of the job join the c10d rendezvous. An elastic job always does, as its ranks
+// can only be assigned by the rendezvous among the pods scheduled rather than by the pod indexes.
+func (pp *pytorchPlugin) isElastic(job *batch.Job) bool {
+	return pp.elastic || helpers.IsElasticJob(job)
+}
+
+// onElasticPodCreate prepares the pod to join the c10d rendezvous reached by the rendezvous service of the job, every
+// pod is a node of torchrun and there is no master role, so that the pods can join and leave without restarting the
+// whole job. The rendezvous store is hosted by the first worker, which is told so by the rendezvous configs, as
+// torchrun could not match its hostname with the name of the service.
+func (pp *pytorchPlugin) onElasticPodCreate(pod *v1.Pod, job *batch.Job) error {
+	workerIndex := helpers.GetTaskIndexUnderJob(pp.workerName, job)
+	if workerIndex == -1 {
+		klog.Errorf("job %v doesn't have task %v", job.Name, pp.workerName)
+		return nil
+	}
+
+	worker := job.Spec.Tasks[workerIndex]
+	maxNodes := worker.Replicas
+	minNodes := job.Spec.MinAvailable
+	if worker.MinAvailable != nil {
+		minNodes = *worker.MinAvailable
+	}
+	if minNodes <= 0 || minNodes > maxNodes {
+		minNodes = maxNodes
+	}
+
+	envVars := []v1.EnvVar{
+		{Name: EnvRdzvBackend, Value: RdzvBackendC10d},
+		{Name: EnvRdzvEndpoint, Value: fmt.Sprintf("%s.%s.svc:%d", pp.rdzvServiceName(job), job.Namespace, pp.rdzvPort)},
+		{Name: EnvRdzvID, Value: job.Name},
+		{Name: EnvNnodes, Value: fmt.Sprintf("%d:%d", minNodes, maxNodes)},
+		{Name: EnvMaxRestarts, Value: strconv.Itoa(pp.maxRestarts)},
+	}
+	if len(pp.nprocPerNode) != 0 {
+		envVars = append(envVars, v1.EnvVar{Name: EnvNprocPerNode, Value: pp.nprocPerNode})
+	}
+	isHost := helpers.GetTaskKey(pod) == pp.workerName && helpers.GetPodIndexUnderTask(pod) == "0"
+	envVars = append(envVars, v1.EnvVar{Name: EnvRdzvConf, Value: fmt.Sprintf("is_host=%t", isHost)})
+
+	for i := range pod.Spec.Containers {
+		pp.openRdzvPort(i, pod)
+		pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, envVars...)
+	}
+
+	return nil
+}
+
+// rdzvServiceName returns the name of the rendezvous service of the job.
+func (pp *pytorchPlugin) rdzvServiceName(job *batch.Job) string {
+	return fmt.Sprintf("%s-rdzv", job.Name)
+}
+
+// createRdzvServiceIfNotExist creates the headless rendezvous service of the job in elastic mode, which resolves to the
+// first worker hosting the rendezvous store, so that the other workers reach the store by a stable name before the
+// first worker is scheduled.
+func (pp *pytorchPlugin) createRdzvServiceIfNotExist(job *batch.Job) error {
+	name := pp.rdzvServiceName(job)
+	if _, err := pp.clientset.KubeClients.CoreV1().Services(job.Namespace).Get(context.TODO(), name, metav1.GetOptions{}); err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		klog.V(3).Infof("Failed to get rendezvous Service for Job <%s/%s>: %v", job.Namespace, job.Name, err)
+		return err
+	}
+
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: job.Namespace,
+			Name:      name,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(job, apishelpers.JobKind),
+			},
+		},
+		Spec: v1.ServiceSpec{
+			ClusterIP: v1.ClusterIPNone,
+			Selector: map[string]string{
+				batch.JobNameKey:      job.Name,
+				batch.JobNamespaceKey: job.Namespace,
+				batch.TaskSpecKey:     pp.workerName,
+				batch.TaskIndex:       "0",
+			},
+			Ports: []v1.ServicePort{
+				{
+					Name:       "pytorchjob-rdzv",
+					Port:       int32(pp.rdzvPort),
+					TargetPort: intstr.FromInt32(int32(pp.rdzvPort)),
+				},
+			},
+			PublishNotReadyAddresses: true,
+		},
+	}
+	if _, err := pp.clientset.KubeClients.CoreV1().Services(job.Namespace).Create(context.TODO(), svc, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		klog.V(3).Infof("Failed to create rendezvous Service for Job <%s/%s>: %v", job.Namespace, job.Name, err)
+		return err
+	}
+	return nil
+}
+
+func (pp *pytorchPlugin) openRdzvPort(index int, pod *v1.Pod) {
+	for _, p := range pod.Spec.Containers[index].Ports {
+		if p.ContainerPort == int32(pp.rdzvPort) {
+			return
+		}
+	}
+
+	pod.Spec.Containers[index].Ports = append(pod.Spec.Containers[index].Ports, v1.ContainerPort{
+		Name:          "pytorchjob-rdzv",
+		ContainerPort: int32(pp.rdzvPort),
+	})
+}
+
 func (pp *pytorchPlugin) getTotalReplicas(job *batch.Job) int32 {
 	jobReplicas := int32(0)
 	for _, task := range job.Spec.Tasks {
@@ -234,6 +383,11 @@ func (pp *pytorchPlugin) OnJobAdd(job *batch.Job) error {
 	if job.Status.ControlledResources["plugin-"+pp.Name()] == pp.Name() {
 		return nil
 	}
+	if pp.isElastic(job) {
+		if err := pp.createRdzvServiceIfNotExist(job); err != nil {
+			return err
+		}
+	}
 	job.Status.ControlledResources["plugin-"+pp.Name()] = pp.Name()
 	return nil
 }
@@ -242,6 +396,14 @@ func (pp *pytorchPlugin) OnJobDelete(job *batch.Job) error {
 	if job.Status.ControlledResources["plugin-"+pp.Name()] != pp.Name() {
 		return nil
 	}
+	if pp.isElastic(job) {
+		if err := pp.clientset.KubeClients.CoreV1().Services(job.Namespace).Delete(context.TODO(), pp.rdzvServiceName(job), metav1.DeleteOptions{}); err != nil {
+			if !apierrors.IsNotFound(err) {
+				klog.Errorf("Failed to delete rendezvous Service of Job %v/%v: %v", job.Namespace, job.Name, err)
+				return err
+			}
+		}
+	}
 	delete(job.Status.ControlledResources, "plugin-"+pp.Name())
 	return nil
 }
@@ -17,13 +17,17 @@ limitations under the License.
 package pytorch
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	pluginsinterface "volcano.sh/volcano/pkg/controllers/job/plugins/interface"
@@ -378,6 +382,50 @@ func TestPytorchPodEnvAndPort(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "test worker pod of elastic job joins the rendezvous",
+			Job: &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-pytorch",
+					Namespace:   "default",
+					Annotations: map[string]string{v1alpha1.JobElasticKey: "true"},
+				},
+				Spec: v1alpha1.JobSpec{
+					MinAvailable: 3,
+					Tasks: []v1alpha1.TaskSpec{
+						{
+							Name:     "worker",
+							Replicas: 4,
+							Template: v1.PodTemplateSpec{},
+						},
+					},
+				},
+			},
+			Pod: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pytorch-worker-0",
+					Annotations: map[string]string{
+						v1alpha1.TaskSpecKey: "worker",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name: "worker",
+						},
+					},
+				},
+			},
+			port: DefaultRdzvPort,
+			envs: []v1.EnvVar{
+				{Name: EnvRdzvBackend, Value: "c10d"},
+				{Name: EnvRdzvEndpoint, Value: "test-pytorch-rdzv.default.svc:29400"},
+				{Name: EnvRdzvID, Value: "test-pytorch"},
+				{Name: EnvNnodes, Value: "3:4"},
+				{Name: EnvMaxRestarts, Value: "3"},
+				{Name: EnvRdzvConf, Value: "is_host=true"},
+			},
+		},
 	}
 
 	for index, testcase := range testcases {
@@ -679,3 +727,213 @@ func TestPytorchInitContainer(t *testing.T) {
 		})
 	}
 }
+
+func TestPytorchElastic(t *testing.T) {
+	workerJob := func(minAvailable, replicas int32) *v1alpha1.Job {
+		return &v1alpha1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pytorch", Namespace: "default"},
+			Spec: v1alpha1.JobSpec{
+				MinAvailable: minAvailable,
+				Tasks: []v1alpha1.TaskSpec{
+					{
+						Name:     "worker",
+						Replicas: replicas,
+						Template: v1.PodTemplateSpec{},
+					},
+				},
+			},
+		}
+	}
+	workerPod := func(index int) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("test-pytorch-worker-%d", index),
+				Annotations: map[string]string{
+					v1alpha1.TaskSpecKey: "worker",
+				},
+			},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{
+					{Name: "worker"},
+				},
+			},
+		}
+	}
+
+	testcases := []struct {
+		Name                 string
+		PluginArgs           []string
+		Job                  *v1alpha1.Job
+		Pod                  *v1.Pod
+		expectPort           int32
+		expectEnvs           []v1.EnvVar
+		expectInitContainers []v1.Container
+	}{
+		{
+			Name:       "worker pod joins the rendezvous with default arguments",
+			PluginArgs: []string{"--elastic=true", "--wait-master-enabled=true"},
+			Job:        workerJob(2, 4),
+			Pod:        workerPod(1),
+			expectPort: DefaultRdzvPort,
+			expectEnvs: []v1.EnvVar{
+				{Name: EnvRdzvBackend, Value: "c10d"},
+				{Name: EnvRdzvEndpoint, Value: "test-pytorch-rdzv.default.svc:29400"},
+				{Name: EnvRdzvID, Value: "test-pytorch"},
+				{Name: EnvNnodes, Value: "2:4"},
+				{Name: EnvMaxRestarts, Value: "3"},
+				{Name: EnvRdzvConf, Value: "is_host=false"},
+			},
+		},
+		{
+			Name:       "first worker pod hosts the rendezvous store",
+			PluginArgs: []string{"--elastic=true"},
+			Job:        workerJob(2, 4),
+			Pod:        workerPod(0),
+			expectPort: DefaultRdzvPort,
+			expectEnvs: []v1.EnvVar{
+				{Name: EnvRdzvBackend, Value: "c10d"},
+				{Name: EnvRdzvEndpoint, Value: "test-pytorch-rdzv.default.svc:29400"},
+				{Name: EnvRdzvID, Value: "test-pytorch"},
+				{Name: EnvNnodes, Value: "2:4"},
+				{Name: EnvMaxRestarts, Value: "3"},
+				{Name: EnvRdzvConf, Value: "is_host=true"},
+			},
+		},
+		{
+			Name:       "worker pod joins the rendezvous with given arguments",
+			PluginArgs: []string{"--elastic=true", "--rdzv-port=30000", "--max-restarts=10", "--nproc-per-node=gpu"},
+			Job:        workerJob(0, 4),
+			Pod:        workerPod(1),
+			expectPort: 30000,
+			expectEnvs: []v1.EnvVar{
+				{Name: EnvRdzvBackend, Value: "c10d"},
+				{Name: EnvRdzvEndpoint, Value: "test-pytorch-rdzv.default.svc:30000"},
+				{Name: EnvRdzvID, Value: "test-pytorch"},
+				{Name: EnvNnodes, Value: "4:4"},
+				{Name: EnvMaxRestarts, Value: "10"},
+				{Name: EnvNprocPerNode, Value: "gpu"},
+				{Name: EnvRdzvConf, Value: "is_host=false"},
+			},
+		},
+		{
+			Name:       "only the worker task is counted as the nodes",
+			PluginArgs: []string{"--elastic=true"},
+			Job: &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pytorch", Namespace: "default"},
+				Spec: v1alpha1.JobSpec{
+					MinAvailable: 3,
+					Tasks: []v1alpha1.TaskSpec{
+						{
+							Name:     "master",
+							Replicas: 1,
+							Template: v1.PodTemplateSpec{},
+						},
+						{
+							Name:         "worker",
+							Replicas:     4,
+							MinAvailable: ptr.To[int32](2),
+							Template:     v1.PodTemplateSpec{},
+						},
+					},
+				},
+			},
+			Pod:        workerPod(1),
+			expectPort: DefaultRdzvPort,
+			expectEnvs: []v1.EnvVar{
+				{Name: EnvRdzvBackend, Value: "c10d"},
+				{Name: EnvRdzvEndpoint, Value: "test-pytorch-rdzv.default.svc:29400"},
+				{Name: EnvRdzvID, Value: "test-pytorch"},
+				{Name: EnvNnodes, Value: "2:4"},
+				{Name: EnvMaxRestarts, Value: "3"},
+				{Name: EnvRdzvConf, Value: "is_host=false"},
+			},
+		},
+		{
+			Name:       "pod without worker task is left as it is",
+			PluginArgs: []string{"--elastic=true"},
+			Job: &v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pytorch"},
+				Spec: v1alpha1.JobSpec{
+					Tasks: []v1alpha1.TaskSpec{
+						{
+							Name:     "trainer",
+							Replicas: 2,
+							Template: v1.PodTemplateSpec{},
+						},
+					},
+				},
+			},
+			Pod:        workerPod(1),
+			expectPort: -1,
+		},
+	}
+
+	for index, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			mp := New(pluginsinterface.PluginClientset{}, testcase.PluginArgs)
+			if err := mp.OnPodCreate(testcase.Pod, testcase.Job); err != nil {
+				t.Errorf("Case %d (%s): expect no error, but got error %v", index, testcase.Name, err)
+			}
+
+			container := testcase.Pod.Spec.Containers[0]
+			if testcase.expectPort != -1 {
+				if len(container.Ports) != 1 || container.Ports[0].ContainerPort != testcase.expectPort {
+					t.Errorf("Case %d (%s): wrong ports, got %v, expected %v", index, testcase.Name, container.Ports, testcase.expectPort)
+				}
+			} else if container.Ports != nil {
+				t.Errorf("Case %d (%s): wrong ports, got %v, expected empty", index, testcase.Name, container.Ports)
+			}
+
+			if !equality.Semantic.DeepEqual(container.Env, testcase.expectEnvs) {
+				t.Errorf("Case %d (%s): wrong envs, got %v, expected %v", index, testcase.Name, container.Env, testcase.expectEnvs)
+			}
+
+			if !equality.Semantic.DeepEqual(testcase.Pod.Spec.InitContainers, testcase.expectInitContainers) {
+				t.Errorf("Case %d (%s): wrong init containers, got %v, expected %v", index, testcase.Name, testcase.Pod.Spec.InitContainers, testcase.expectInitContainers)
+			}
+		})
+	}
+}
+
+func TestPytorchElasticRdzvService(t *testing.T) {
+	job := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pytorch", Namespace: "default"},
+		Spec: v1alpha1.JobSpec{
+			Tasks: []v1alpha1.TaskSpec{
+				{
+					Name:     "worker",
+					Replicas: 4,
+					Template: v1.PodTemplateSpec{},
+				},
+			},
+		},
+		Status: v1alpha1.JobStatus{ControlledResources: map[string]string{}},
+	}
+	kubeClient := fake.NewSimpleClientset()
+	pp := New(pluginsinterface.PluginClientset{KubeClients: kubeClient}, []string{"--elastic=true"})
+
+	if err := pp.OnJobAdd(job); err != nil {
+		t.Fatalf("expect no error on job add, but got %v", err)
+	}
+	svc, err := kubeClient.CoreV1().Services("default").Get(context.TODO(), "test-pytorch-rdzv", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expect rendezvous service to be created, but got %v", err)
+	}
+	if svc.Spec.Selector[v1alpha1.TaskSpecKey] != "worker" || svc.Spec.Selector[v1alpha1.TaskIndex] != "0" ||
+		svc.Spec.Selector[v1alpha1.JobNameKey] != "test-pytorch" {
+		t.Errorf("expect rendezvous service to select the first worker of the job, but got %v", svc.Spec.Selector)
+	}
+	if svc.Spec.ClusterIP != v1.ClusterIPNone {
+		t.Errorf("expect rendezvous service to be headless, but got cluster ip %q", svc.Spec.ClusterIP)
+	}
+	if len(svc.Spec.Ports) != 1 || svc.Spec.Ports[0].Port != DefaultRdzvPort {
+		t.Errorf("expect rendezvous service on port %d, but got %v", DefaultRdzvPort, svc.Spec.Ports)
+	}
+
+	if err := pp.OnJobDelete(job); err != nil {
+		t.Fatalf("expect no error on job delete, but got %v", err)
+	}
+	if _, err := kubeClient.CoreV1().Services("default").Get(context.TODO(), "test-pytorch-rdzv", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expect rendezvous service to be deleted, but got %v", err)
+	}
+}
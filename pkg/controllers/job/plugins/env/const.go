@@ -25,4 +25,16 @@ const (
 
 	// TaskIndex is used as key in container env
 	TaskIndex = "VC_TASK_INDEX"
+
+	// ElasticWorldSize is used as key in container env of elastic jobs, it's the world size when the container starts
+	ElasticWorldSize = "VC_ELASTIC_WORLD_SIZE"
+
+	// ElasticVolumeName is the name of the downward api volume of elastic jobs
+	ElasticVolumeName = "volcano-elastic"
+
+	// ElasticMountPath mount path of the downward api volume of elastic jobs
+	ElasticMountPath = "/etc/volcano-elastic"
+
+	// ElasticWorldSizeFile is the file in ElasticMountPath, which is refreshed once the world size changes
+	ElasticWorldSizeFile = "world_size"
 )
@@ -17,6 +17,8 @@ limitations under the License.
 package env
 
 import (
+	"fmt"
+
 	v1 "k8s.io/api/core/v1"
 
 	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
@@ -55,9 +57,42 @@ func (ep *envPlugin) OnPodCreate(pod *v1.Pod, job *batch.Job) error {
 		pod.Spec.InitContainers[i].Env = append(pod.Spec.InitContainers[i].Env, v1.EnvVar{Name: TaskVkIndex, Value: index}, v1.EnvVar{Name: TaskIndex, Value: index})
 	}
 
+	if jobhelpers.IsElasticJob(job) {
+		ep.mountElasticWorldSize(pod)
+	}
+
 	return nil
 }
 
+// mountElasticWorldSize exposes the world size of the elastic job to the containers, by env when the container
+// starts and by file which is refreshed when the job is scaled.
+func (ep *envPlugin) mountElasticWorldSize(pod *v1.Pod) {
+	fieldPath := fmt.Sprintf("metadata.annotations['%s']", batch.ElasticWorldSizeKey)
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: ElasticVolumeName,
+		VolumeSource: v1.VolumeSource{
+			DownwardAPI: &v1.DownwardAPIVolumeSource{
+				Items: []v1.DownwardAPIVolumeFile{
+					{
+						Path:     ElasticWorldSizeFile,
+						FieldRef: &v1.ObjectFieldSelector{FieldPath: fieldPath},
+					},
+				},
+			},
+		},
+	})
+
+	env := v1.EnvVar{
+		Name:      ElasticWorldSize,
+		ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: fieldPath}},
+	}
+	mount := v1.VolumeMount{Name: ElasticVolumeName, MountPath: ElasticMountPath, ReadOnly: true}
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, env)
+		pod.Spec.Containers[i].VolumeMounts = append(pod.Spec.Containers[i].VolumeMounts, mount)
+	}
+}
+
 func (ep *envPlugin) OnJobAdd(job *batch.Job) error {
 	if job.Status.ControlledResources["plugin-"+ep.Name()] == ep.Name() {
 		return nil
@@ -19,7 +19,10 @@ package plugins
 import (
 	"sync"
 
+	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/deepspeed"
 	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/hcclrank"
+	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/horovod"
+	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/jax"
 	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/mpi"
 	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/pytorch"
 	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/ray"
@@ -39,6 +42,9 @@ func init() {
 	RegisterPluginBuilder("pytorch", pytorch.New)
 	RegisterPluginBuilder("hcclrank", hcclrank.New)
 	RegisterPluginBuilder("ray", ray.New)
+	RegisterPluginBuilder(jax.JAXPluginName, jax.New)
+	RegisterPluginBuilder(deepspeed.DeepSpeedPluginName, deepspeed.New)
+	RegisterPluginBuilder(horovod.HorovodPluginName, horovod.New)
 }
 
 var pluginMutex sync.Mutex
@@ -55,6 +55,10 @@ var (
 	KillJob KillActionFn
 	// KillTarget kill the target with given name.
 	KillTarget KillTargetFn
+	// SuspendJob marks the Job suspended and kills all its Pods, the PodGroup of Job is kept in the queue.
+	SuspendJob ActionFn
+	// ResumeJob clears the suspension of the Job, so that its PodGroup is enqueued again.
+	ResumeJob ActionFn
 )
 
 type TargetType string
@@ -75,6 +79,9 @@ type Target struct {
 type Action struct {
 	Action v1alpha1.Action
 	Target Target
+	// Infrastructure is true if the action is taken for an infrastructure failure of the pod,
+	// e.g. the pod is evicted, so that the retry is counted against MaxInfrastructureRetry.
+	Infrastructure bool
 }
 
 // State interface.
@@ -103,6 +110,10 @@ func NewState(jobInfo *apis.JobInfo) State {
 		return &abortedState{job: jobInfo}
 	case vcbatch.Completing:
 		return &completingState{job: jobInfo}
+	case vcbatch.Suspending:
+		return &suspendingState{job: jobInfo}
+	case vcbatch.Suspended:
+		return &suspendedState{job: jobInfo}
 	}
 
 	// It's pending by default.
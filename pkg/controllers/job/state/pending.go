@@ -30,16 +30,21 @@ func (ps *pendingState) Execute(action Action) error {
 	switch action.Action {
 	case v1alpha1.RestartJobAction:
 		return KillJob(ps.job, PodRetainPhaseNone, func(status *vcbatch.JobStatus) bool {
-			status.RetryCount++
+			increaseRetryCount(ps.job.Job, status, action.Infrastructure)
 			status.State.Phase = vcbatch.Restarting
 			return true
 		})
 	case v1alpha1.RestartTaskAction, v1alpha1.RestartPodAction, v1alpha1.RestartPartitionAction:
 		return KillTarget(ps.job, action.Target, func(status *vcbatch.JobStatus) bool {
-			status.RetryCount++
+			increaseRetryCount(ps.job.Job, status, action.Infrastructure)
 			status.State.Phase = vcbatch.Restarting
 			return true
 		})
+	case v1alpha1.SuspendJobAction:
+		return SuspendJob(ps.job, func(status *vcbatch.JobStatus) bool {
+			status.State.Phase = vcbatch.Suspending
+			return true
+		})
 	case v1alpha1.AbortJobAction:
 		return KillJob(ps.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
 			status.State.Phase = vcbatch.Aborting
@@ -29,10 +29,7 @@ type restartingState struct {
 }
 
 func (ps *restartingState) restartingUpdateStatus(status *vcbatch.JobStatus) bool {
-	// Get the maximum number of retries.
-	maxRetry := ps.job.Job.Spec.MaxRetry
-
-	if status.RetryCount >= maxRetry {
+	if retryExhausted(ps.job.Job, status) {
 		// Failed is the phase that the job is restarted failed reached the maximum number of retries.
 		status.State.Phase = vcbatch.Failed
 		UpdateJobFailed(fmt.Sprintf("%s/%s", ps.job.Job.Namespace, ps.job.Job.Name), ps.job.Job.Spec.Queue)
@@ -57,6 +54,11 @@ func (ps *restartingState) Execute(action Action) error {
 		return SyncJob(ps.job, ps.restartingUpdateStatus)
 	case v1alpha1.RestartTaskAction, v1alpha1.RestartPodAction, v1alpha1.RestartPartitionAction:
 		return KillTarget(ps.job, action.Target, ps.restartingUpdateStatus)
+	case v1alpha1.SuspendJobAction:
+		return SuspendJob(ps.job, func(status *vcbatch.JobStatus) bool {
+			status.State.Phase = vcbatch.Suspending
+			return true
+		})
 	default:
 		return KillJob(ps.job, PodRetainPhaseNone, ps.restartingUpdateStatus)
 	}
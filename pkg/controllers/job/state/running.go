@@ -35,13 +35,18 @@ func (ps *runningState) Execute(action Action) error {
 	case v1alpha1.RestartJobAction:
 		return KillJob(ps.job, PodRetainPhaseNone, func(status *vcbatch.JobStatus) bool {
 			status.State.Phase = vcbatch.Restarting
-			status.RetryCount++
+			increaseRetryCount(ps.job.Job, status, action.Infrastructure)
 			return true
 		})
 	case v1alpha1.RestartTaskAction, v1alpha1.RestartPodAction, v1alpha1.RestartPartitionAction:
 		return KillTarget(ps.job, action.Target, func(status *vcbatch.JobStatus) bool {
 			status.State.Phase = vcbatch.Restarting
-			status.RetryCount++
+			increaseRetryCount(ps.job.Job, status, action.Infrastructure)
+			return true
+		})
+	case v1alpha1.SuspendJobAction:
+		return SuspendJob(ps.job, func(status *vcbatch.JobStatus) bool {
+			status.State.Phase = vcbatch.Suspending
 			return true
 		})
 	case v1alpha1.AbortJobAction:
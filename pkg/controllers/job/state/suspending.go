@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	vcbatch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/apis/pkg/apis/bus/v1alpha1"
+	"volcano.sh/volcano/pkg/controllers/apis"
+)
+
+type suspendingState struct {
+	job *apis.JobInfo
+}
+
+func (ps *suspendingState) Execute(action Action) error {
+	switch action.Action {
+	case v1alpha1.ResumeJobAction:
+		return ResumeJob(ps.job, resumeUpdateStatus)
+	case v1alpha1.AbortJobAction:
+		return KillJob(ps.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
+			status.State.Phase = vcbatch.Aborting
+			return true
+		})
+	case v1alpha1.TerminateJobAction:
+		return KillJob(ps.job, PodRetainPhaseSoft, func(status *vcbatch.JobStatus) bool {
+			status.State.Phase = vcbatch.Terminating
+			return true
+		})
+	default:
+		return SuspendJob(ps.job, func(status *vcbatch.JobStatus) bool {
+			// If any "alive" pods, still in Suspending phase
+			if status.Terminating != 0 || status.Pending != 0 || status.Running != 0 {
+				return false
+			}
+			status.State.Phase = vcbatch.Suspended
+			return true
+		})
+	}
+}
+
+// resumeUpdateStatus moves the resumed job back to Pending, the retries of the job are not counted
+// as its PodGroup is kept in the queue while it is suspended.
+func resumeUpdateStatus(status *vcbatch.JobStatus) bool {
+	status.State.Phase = vcbatch.Pending
+	return true
+}
@@ -45,3 +45,22 @@ func TotalTaskMinAvailable(job *vcbatch.Job) int32 {
 
 	return rep
 }
+
+// increaseRetryCount counts a retry of the job. The retries for infrastructure failures are counted
+// by InfrastructureRetryCount once the job sets MaxInfrastructureRetry, so that they do not consume MaxRetry.
+func increaseRetryCount(job *vcbatch.Job, status *vcbatch.JobStatus, infrastructure bool) {
+	if infrastructure && job.Spec.MaxInfrastructureRetry != nil {
+		status.InfrastructureRetryCount++
+		return
+	}
+	status.RetryCount++
+}
+
+// retryExhausted checks whether the job has used up any of its retry budgets.
+func retryExhausted(job *vcbatch.Job, status *vcbatch.JobStatus) bool {
+	if status.RetryCount >= job.Spec.MaxRetry {
+		return true
+	}
+	maxInfrastructureRetry := job.Spec.MaxInfrastructureRetry
+	return maxInfrastructureRetry != nil && status.InfrastructureRetryCount >= *maxInfrastructureRetry
+}
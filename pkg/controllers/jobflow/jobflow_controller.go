@@ -23,8 +23,11 @@ import (
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -55,8 +58,10 @@ type jobflowcontroller struct {
 	jobFlowInformer     flowinformer.JobFlowInformer
 	jobTemplateInformer flowinformer.JobTemplateInformer
 	jobInformer         batchinformer.JobInformer
+	podInformer         coreinformers.PodInformer
 
 	//InformerFactory
+	informerFactory   informers.SharedInformerFactory
 	vcInformerFactory vcinformer.SharedInformerFactory
 
 	//jobFlowLister
@@ -71,6 +76,10 @@ type jobflowcontroller struct {
 	jobLister batchlister.JobLister
 	jobSynced cache.InformerSynced
 
+	//podLister, pods of the target jobs are probed before deploying the dependent jobs
+	podLister corelisters.PodLister
+	podSynced cache.InformerSynced
+
 	// JobFlow Event recorder
 	recorder record.EventRecorder
 
@@ -110,8 +119,14 @@ func (jf *jobflowcontroller) Initialize(opt *framework.ControllerOption) error {
 	jf.jobLister = jf.jobInformer.Lister()
 	jf.jobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		UpdateFunc: jf.updateJob,
+		DeleteFunc: jf.deleteJob,
 	})
 
+	jf.informerFactory = opt.SharedInformerFactory
+	jf.podInformer = opt.SharedInformerFactory.Core().V1().Pods()
+	jf.podSynced = jf.podInformer.Informer().HasSynced
+	jf.podLister = jf.podInformer.Lister()
+
 	jf.maxRequeueNum = opt.MaxRequeueNum
 	if jf.maxRequeueNum < 0 {
 		jf.maxRequeueNum = -1
@@ -135,7 +150,14 @@ func (jf *jobflowcontroller) Initialize(opt *framework.ControllerOption) error {
 func (jf *jobflowcontroller) Run(stopCh <-chan struct{}) {
 	defer jf.queue.ShutDown()
 
+	jf.informerFactory.Start(stopCh)
 	jf.vcInformerFactory.Start(stopCh)
+	for informerType, ok := range jf.informerFactory.WaitForCacheSync(stopCh) {
+		if !ok {
+			klog.Errorf("caches failed to sync: %v", informerType)
+			return
+		}
+	}
 	for informerType, ok := range jf.vcInformerFactory.WaitForCacheSync(stopCh) {
 		if !ok {
 			klog.Errorf("caches failed to sync: %v", informerType)
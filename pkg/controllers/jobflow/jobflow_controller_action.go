@@ -32,6 +32,7 @@ import (
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	v1alpha1flow "volcano.sh/apis/pkg/apis/flow/v1alpha1"
 	"volcano.sh/apis/pkg/client/clientset/versioned/scheme"
+	"volcano.sh/volcano/pkg/controllers/apis"
 	"volcano.sh/volcano/pkg/controllers/jobflow/state"
 )
 
@@ -61,8 +62,14 @@ func (jf *jobflowcontroller) syncJobFlow(jobFlow *v1alpha1flow.JobFlow, updateSt
 	if err != nil {
 		return err
 	}
+	// retry, skip or abort on the failed jobs by the failure policies of their flows
+	if err := jf.handleFailedJobs(jobFlow, jobFlowStatus); err != nil {
+		klog.Errorf("Failed to handle failed jobs of JobFlow %v/%v: %v",
+			jobFlow.Namespace, jobFlow.Name, err)
+		return err
+	}
 	jobFlow.Status = *jobFlowStatus
-	updateStateFn(&jobFlow.Status, len(jobFlow.Spec.Flows))
+	updateStateFn(&jobFlow.Status, getExpectedJobCount(jobFlow))
 	_, err = jf.vcClient.FlowV1alpha1().JobFlows(jobFlow.Namespace).UpdateStatus(context.Background(), jobFlow, metav1.UpdateOptions{})
 	if err != nil {
 		klog.Errorf("Failed to update status of JobFlow %v/%v: %v",
@@ -75,60 +82,130 @@ func (jf *jobflowcontroller) syncJobFlow(jobFlow *v1alpha1flow.JobFlow, updateSt
 
 func (jf *jobflowcontroller) deployJob(jobFlow *v1alpha1flow.JobFlow) error {
 	// load jobTemplate by flow and deploy it
-	for _, flow := range jobFlow.Spec.Flows {
-		jobName := getJobName(jobFlow.Name, flow.Name)
-		if _, err := jf.jobLister.Jobs(jobFlow.Namespace).Get(jobName); err != nil {
-			if errors.IsNotFound(err) {
-				// If it is not distributed, judge whether the dependency of the VcJob meets the requirements
-				if flow.DependsOn == nil || flow.DependsOn.Targets == nil {
-					if err := jf.createJob(jobFlow, flow); err != nil {
-						return err
-					}
-				} else {
-					// query whether the dependencies of the job have been met
-					flag, err := jf.judge(jobFlow, flow)
-					if err != nil {
-						return err
-					}
-					if flag {
-						if err := jf.createJob(jobFlow, flow); err != nil {
-							return err
-						}
-					}
+	for i := range jobFlow.Spec.Flows {
+		flow := &jobFlow.Spec.Flows[i]
+		if isFlowSkipped(&jobFlow.Status, flow.Name) {
+			continue
+		}
+
+		var undeployed []*v1alpha1flow.FlowParameter
+		for _, parameter := range getFlowParameters(flow) {
+			if _, err := jf.jobLister.Jobs(jobFlow.Namespace).Get(getFlowJobName(jobFlow.Name, flow, parameter)); err != nil {
+				if !errors.IsNotFound(err) {
+					return err
+				}
+				undeployed = append(undeployed, parameter)
+			}
+		}
+		if len(undeployed) == 0 {
+			continue
+		}
+
+		// If it is not distributed, judge whether the dependency of the VcJob meets the requirements
+		if flow.DependsOn != nil && flow.DependsOn.Targets != nil {
+			// query whether the dependencies of the job have been met
+			flag, err := jf.judge(jobFlow, *flow)
+			if err != nil {
+				return err
+			}
+			if !flag {
+				if hasProbe(flow.DependsOn) {
+					// Probes are not triggered by any event, run them again later.
+					jf.queue.AddAfter(apis.FlowRequest{
+						Namespace:   jobFlow.Namespace,
+						JobFlowName: jobFlow.Name,
+						Action:      v1alpha1flow.SyncJobFlowAction,
+						Event:       v1alpha1flow.OutOfSyncEvent,
+					}, probeRetryInterval)
 				}
 				continue
 			}
-			return err
+		}
+
+		// the flow is skipped rather than waiting if its condition is not met by the finished targets
+		if flow.When != nil {
+			met, reason, err := jf.evaluateCondition(jobFlow, flow)
+			if err != nil {
+				return err
+			}
+			if !met {
+				skipFlow(&jobFlow.Status, flow.Name)
+				jf.recorder.Eventf(jobFlow, corev1.EventTypeNormal, "Skipped", fmt.Sprintf("skip the flow %v: %v", flow.Name, reason))
+				continue
+			}
+		}
+
+		for _, parameter := range undeployed {
+			if err := jf.createJob(jobFlow, *flow, parameter); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
-// judge query whether the dependencies of the job have been met. If it is satisfied, create the job, if not, judge the next job. Create the job if satisfied
+// judge query whether the dependencies of the job have been met. If it is satisfied, create the job, if not, judge the next job. Create the job if satisfied.
+// A target is met once all its jobs are completed or skipped by the failure policy, or all the probes declared in the dependency are passed against its jobs.
+// A target skipped by its condition is always met, and a target of the flow with condition is met once all its jobs are finished.
 func (jf *jobflowcontroller) judge(jobFlow *v1alpha1flow.JobFlow, flow v1alpha1flow.Flow) (bool, error) {
+	met := true
+	var probeStatuses []v1alpha1flow.ProbeStatus
 	for _, targetName := range flow.DependsOn.Targets {
-		targetJobName := getJobName(jobFlow.Name, targetName)
-		job, err := jf.jobLister.Jobs(jobFlow.Namespace).Get(targetJobName)
-		if err != nil {
-			if errors.IsNotFound(err) {
-				klog.Info(fmt.Sprintf("No %v Job found！", targetJobName))
+		if isFlowSkipped(&jobFlow.Status, targetName) {
+			continue
+		}
+
+		target := getFlow(jobFlow, targetName)
+		for _, targetJobName := range getTargetJobNames(jobFlow, targetName) {
+			job, err := jf.jobLister.Jobs(jobFlow.Namespace).Get(targetJobName)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					klog.Info(fmt.Sprintf("No %v Job found！", targetJobName))
+					return false, nil
+				}
+				return false, err
+			}
+			if job.Status.State.Phase == v1alpha1.Completed || isJobSkipped(jobFlow, target, job) {
+				continue
+			}
+			if flow.When != nil && isJobFinished(jobFlow, target, job) {
+				continue
+			}
+			if !hasProbe(flow.DependsOn) {
 				return false, nil
 			}
-			return false, err
-		}
-		if job.Status.State.Phase != v1alpha1.Completed {
-			return false, nil
+
+			statuses, err := jf.runProbes(flow, targetName, job)
+			if err != nil {
+				return false, err
+			}
+			for _, status := range statuses {
+				if !status.Passed {
+					klog.V(4).Infof("%s probe of flow %s against job %s is not passed: %s",
+						status.Type, flow.Name, targetJobName, status.Message)
+					met = false
+				}
+			}
+			probeStatuses = append(probeStatuses, statuses...)
 		}
 	}
-	return true, nil
+
+	if hasProbe(flow.DependsOn) {
+		setProbeStatuses(&jobFlow.Status, flow.Name, probeStatuses)
+	}
+	return met, nil
 }
 
-// createJob
-func (jf *jobflowcontroller) createJob(jobFlow *v1alpha1flow.JobFlow, flow v1alpha1flow.Flow) error {
+// createJob creates the job of the flow, or the fan-out job of the flow with the parameter set.
+func (jf *jobflowcontroller) createJob(jobFlow *v1alpha1flow.JobFlow, flow v1alpha1flow.Flow, parameter *v1alpha1flow.FlowParameter) error {
 	job := new(v1alpha1.Job)
-	if err := jf.loadJobTemplateAndSetJob(jobFlow, flow.Name, getJobName(jobFlow.Name, flow.Name), job); err != nil {
+	if err := jf.loadJobTemplateAndSetJob(jobFlow, flow.Name, getFlowJobName(jobFlow.Name, &flow, parameter), job); err != nil {
 		return err
 	}
+	if parameter != nil {
+		job.Spec = *job.Spec.DeepCopy()
+		setParameterEnvs(job, parameter)
+	}
 	if _, err := jf.vcClient.BatchV1alpha1().Jobs(jobFlow.Namespace).Create(context.Background(), job, metav1.CreateOptions{}); err != nil {
 		if errors.IsAlreadyExists(err) {
 			return nil
@@ -194,6 +271,8 @@ func (jf *jobflowcontroller) getAllJobStatus(jobFlow *v1alpha1flow.JobFlow) (*v1
 		for i := range jobStatusList {
 			if jobStatusList[i].Name == jobStatus.Name {
 				jobFlag = false
+				jobStatus.Retries = jobStatusList[i].Retries
+				jobStatus.RetriedUID = jobStatusList[i].RetriedUID
 				jobStatusList[i] = jobStatus
 			}
 		}
@@ -212,6 +291,8 @@ func (jf *jobflowcontroller) getAllJobStatus(jobFlow *v1alpha1flow.JobFlow) (*v1
 		JobStatusList:  jobStatusList,
 		Conditions:     conditions,
 		State:          jobFlow.Status.State,
+		ProbeStatuses:  jobFlow.Status.ProbeStatuses,
+		SkippedFlows:   jobFlow.Status.SkippedFlows,
 	}
 	return &jobFlowStatus, nil
 }
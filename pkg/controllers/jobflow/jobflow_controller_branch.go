@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobflow
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	v1alpha1flow "volcano.sh/apis/pkg/apis/flow/v1alpha1"
+)
+
+// getFlowJobName returns the name of the job of the flow, or the fan-out job of the flow created with the parameter set.
+func getFlowJobName(jobFlowName string, flow *v1alpha1flow.Flow, parameter *v1alpha1flow.FlowParameter) string {
+	if parameter == nil {
+		return getJobName(jobFlowName, flow.Name)
+	}
+	return getJobName(jobFlowName, flow.Name) + "-" + parameter.Name
+}
+
+// getFlowParameters returns the parameter sets of the fan-out jobs of the flow, a flow without parameters has a single nil one.
+func getFlowParameters(flow *v1alpha1flow.Flow) []*v1alpha1flow.FlowParameter {
+	if len(flow.Parameters) == 0 {
+		return []*v1alpha1flow.FlowParameter{nil}
+	}
+
+	parameters := make([]*v1alpha1flow.FlowParameter, 0, len(flow.Parameters))
+	for i := range flow.Parameters {
+		parameters = append(parameters, &flow.Parameters[i])
+	}
+	return parameters
+}
+
+// getFlowJobNames returns the names of all the jobs of the flow.
+func getFlowJobNames(jobFlowName string, flow *v1alpha1flow.Flow) []string {
+	var jobNames []string
+	for _, parameter := range getFlowParameters(flow) {
+		jobNames = append(jobNames, getFlowJobName(jobFlowName, flow, parameter))
+	}
+	return jobNames
+}
+
+// getFlowByJobName returns the flow which the job is created for.
+func getFlowByJobName(jobFlow *v1alpha1flow.JobFlow, jobName string) *v1alpha1flow.Flow {
+	for i := range jobFlow.Spec.Flows {
+		if slices.Contains(getFlowJobNames(jobFlow.Name, &jobFlow.Spec.Flows[i]), jobName) {
+			return &jobFlow.Spec.Flows[i]
+		}
+	}
+	return nil
+}
+
+// getTargetJobNames returns the names of the jobs of the target flow, the target is taken as a single job flow if it is not defined.
+func getTargetJobNames(jobFlow *v1alpha1flow.JobFlow, targetName string) []string {
+	if target := getFlow(jobFlow, targetName); target != nil {
+		return getFlowJobNames(jobFlow.Name, target)
+	}
+	return []string{getJobName(jobFlow.Name, targetName)}
+}
+
+// getExpectedJobCount returns the number of jobs to be created for the JobFlow, the skipped flows have no job.
+func getExpectedJobCount(jobFlow *v1alpha1flow.JobFlow) int {
+	count := 0
+	for i := range jobFlow.Spec.Flows {
+		if isFlowSkipped(&jobFlow.Status, jobFlow.Spec.Flows[i].Name) {
+			continue
+		}
+		count += len(getFlowJobNames(jobFlow.Name, &jobFlow.Spec.Flows[i]))
+	}
+	return count
+}
+
+// isFlowSkipped checks whether the flow is skipped as its When condition is not met.
+func isFlowSkipped(status *v1alpha1flow.JobFlowStatus, flowName string) bool {
+	return slices.Contains(status.SkippedFlows, flowName)
+}
+
+// skipFlow records the flow as skipped, the flows depending on it proceed as if it completed.
+func skipFlow(status *v1alpha1flow.JobFlowStatus, flowName string) {
+	if isFlowSkipped(status, flowName) {
+		return
+	}
+	status.SkippedFlows = append(status.SkippedFlows, flowName)
+	sort.Strings(status.SkippedFlows)
+}
+
+// isJobFinished checks whether the job of the flow will not run anymore, a failed job to be retried is not finished.
+func isJobFinished(jobFlow *v1alpha1flow.JobFlow, flow *v1alpha1flow.Flow, job *v1alpha1.Job) bool {
+	switch job.Status.State.Phase {
+	case v1alpha1.Completed, v1alpha1.Terminated, v1alpha1.Aborted:
+		return true
+	case v1alpha1.Failed:
+		policy := getFailurePolicy(flow)
+		return policy == nil || (getJobRetries(&jobFlow.Status, job.Name) >= policy.MaxRetries && !isJobRetried(&jobFlow.Status, job))
+	}
+	return false
+}
+
+// isFailureBranched checks whether the failure of the flow is taken by a branch, i.e. a flow which is not skipped has
+// a When condition on it. A branch whose condition is not met by the failure is skipped, and then the failure counts.
+func isFailureBranched(jobFlow *v1alpha1flow.JobFlow, flow *v1alpha1flow.Flow) bool {
+	if flow == nil {
+		return false
+	}
+	for i := range jobFlow.Spec.Flows {
+		branch := &jobFlow.Spec.Flows[i]
+		if branch.When == nil || isFlowSkipped(&jobFlow.Status, branch.Name) {
+			continue
+		}
+		for _, requirement := range branch.When.Requirements {
+			if requirement.Target == flow.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// evaluateCondition checks whether all the requirements of the When condition of the flow are met by the jobs of the targets.
+func (jf *jobflowcontroller) evaluateCondition(jobFlow *v1alpha1flow.JobFlow, flow *v1alpha1flow.Flow) (bool, string, error) {
+	for _, requirement := range flow.When.Requirements {
+		if isFlowSkipped(&jobFlow.Status, requirement.Target) {
+			return false, fmt.Sprintf("target %s is skipped", requirement.Target), nil
+		}
+
+		for _, jobName := range getTargetJobNames(jobFlow, requirement.Target) {
+			job, err := jf.jobLister.Jobs(jobFlow.Namespace).Get(jobName)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return false, fmt.Sprintf("job %s of target %s is not found", jobName, requirement.Target), nil
+				}
+				return false, "", err
+			}
+
+			if requirement.Phase != "" && job.Status.State.Phase != requirement.Phase {
+				return false, fmt.Sprintf("job %s is %s rather than %s", jobName, job.Status.State.Phase, requirement.Phase), nil
+			}
+			for key, value := range requirement.Annotations {
+				if job.Annotations[key] != value {
+					return false, fmt.Sprintf("annotation %s of job %s is not %q", key, jobName, value), nil
+				}
+			}
+			if len(requirement.ExitCodes) > 0 {
+				matched, err := jf.hasExitCode(job, requirement.ExitCodes)
+				if err != nil {
+					return false, "", err
+				}
+				if !matched {
+					return false, fmt.Sprintf("no container of job %s exited with code in %v", jobName, requirement.ExitCodes), nil
+				}
+			}
+		}
+	}
+
+	return true, "", nil
+}
+
+// hasExitCode checks whether any container of the pods of the job terminated with one of the exit codes.
+func (jf *jobflowcontroller) hasExitCode(job *v1alpha1.Job, exitCodes []int32) (bool, error) {
+	pods, err := jf.podLister.Pods(job.Namespace).List(labels.SelectorFromSet(labels.Set{v1alpha1.JobNameKey: job.Name}))
+	if err != nil {
+		return false, err
+	}
+
+	for _, pod := range pods {
+		for _, status := range pod.Status.ContainerStatuses {
+			for _, state := range []corev1.ContainerState{status.State, status.LastTerminationState} {
+				if state.Terminated != nil && slices.Contains(exitCodes, state.Terminated.ExitCode) {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// setParameterEnvs sets the values of the parameter set as environment variables in all the containers of the job.
+func setParameterEnvs(job *v1alpha1.Job, parameter *v1alpha1flow.FlowParameter) {
+	names := make([]string, 0, len(parameter.Values))
+	for name := range parameter.Values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	setEnvs := func(containers []corev1.Container) {
+		for i := range containers {
+			for _, name := range names {
+				containers[i].Env = append(containers[i].Env, corev1.EnvVar{Name: name, Value: parameter.Values[name]})
+			}
+		}
+	}
+	for i := range job.Spec.Tasks {
+		setEnvs(job.Spec.Tasks[i].Template.Spec.InitContainers)
+		setEnvs(job.Spec.Tasks[i].Template.Spec.Containers)
+	}
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobflow
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	jobflowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
+	"volcano.sh/volcano/pkg/controllers/jobflow/state"
+)
+
+func buildJobTemplate(name string) *jobflowv1alpha1.JobTemplate {
+	return &jobflowv1alpha1.JobTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: v1alpha1.JobSpec{
+			Tasks: []v1alpha1.TaskSpec{{
+				Name:     "worker",
+				Replicas: 1,
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "busybox"}}},
+				},
+			}},
+		},
+	}
+}
+
+func TestDeployFanOutJobs(t *testing.T) {
+	fakeController := newFakeController()
+	jobTemplate := buildJobTemplate("sweep")
+	fakeController.jobTemplateInformer.Informer().GetIndexer().Add(jobTemplate)
+
+	jobFlow := &jobflowv1alpha1.JobFlow{
+		ObjectMeta: metav1.ObjectMeta{Name: "jobflow", Namespace: "default"},
+		Spec: jobflowv1alpha1.JobFlowSpec{
+			Flows: []jobflowv1alpha1.Flow{{
+				Name: "sweep",
+				Parameters: []jobflowv1alpha1.FlowParameter{
+					{Name: "lr-1", Values: map[string]string{"LR": "0.1", "EPOCHS": "10"}},
+					{Name: "lr-2", Values: map[string]string{"LR": "0.01", "EPOCHS": "10"}},
+				},
+			}},
+		},
+	}
+
+	if err := fakeController.deployJob(jobFlow); err != nil {
+		t.Fatalf("deployJob failed: %v", err)
+	}
+
+	expectedEnvs := map[string][]corev1.EnvVar{
+		"jobflow-sweep-lr-1": {{Name: "EPOCHS", Value: "10"}, {Name: "LR", Value: "0.1"}},
+		"jobflow-sweep-lr-2": {{Name: "EPOCHS", Value: "10"}, {Name: "LR", Value: "0.01"}},
+	}
+	for jobName, envs := range expectedEnvs {
+		job, err := fakeController.vcClient.BatchV1alpha1().Jobs("default").Get(context.Background(), jobName, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("expected fan-out job %s to be created: %v", jobName, err)
+		}
+		if got := job.Spec.Tasks[0].Template.Spec.Containers[0].Env; !reflect.DeepEqual(got, envs) {
+			t.Errorf("expected envs %v of job %s, got %v", envs, jobName, got)
+		}
+	}
+	if envs := jobTemplate.Spec.Tasks[0].Template.Spec.Containers[0].Env; len(envs) != 0 {
+		t.Errorf("expected job template not to be modified, got envs %v", envs)
+	}
+	if count := getExpectedJobCount(jobFlow); count != 2 {
+		t.Errorf("expected 2 jobs of the JobFlow, got %d", count)
+	}
+}
+
+func TestDeployJobWithCondition(t *testing.T) {
+	testCases := []struct {
+		name          string
+		requirement   jobflowv1alpha1.ConditionRequirement
+		exitCode      int32
+		expectCreated bool
+	}{
+		{
+			name:          "condition on failed phase is met",
+			requirement:   jobflowv1alpha1.ConditionRequirement{Target: "train", Phase: v1alpha1.Failed},
+			expectCreated: true,
+		},
+		{
+			name:        "condition on completed phase is not met",
+			requirement: jobflowv1alpha1.ConditionRequirement{Target: "train", Phase: v1alpha1.Completed},
+		},
+		{
+			name:          "condition on exit code and annotation is met",
+			requirement:   jobflowv1alpha1.ConditionRequirement{Target: "train", ExitCodes: []int32{1, 137}, Annotations: map[string]string{"result": "oom"}},
+			exitCode:      137,
+			expectCreated: true,
+		},
+		{
+			name:        "condition on exit code is not met",
+			requirement: jobflowv1alpha1.ConditionRequirement{Target: "train", ExitCodes: []int32{1}},
+			exitCode:    137,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeController := newFakeController()
+			fakeController.jobTemplateInformer.Informer().GetIndexer().Add(buildJobTemplate("alert"))
+
+			trainJob := buildFlowJob("jobflow", "train", v1alpha1.Failed, time.Now())
+			trainJob.Annotations = map[string]string{"result": "oom"}
+			fakeController.jobInformer.Informer().GetIndexer().Add(trainJob)
+			pod := buildProbedPod("train-0", trainJob.Name, "worker", "", corev1.PodFailed)
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+				Name:  "main",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: tc.exitCode}},
+			}}
+			fakeController.podInformer.Informer().GetIndexer().Add(pod)
+
+			jobFlow := &jobflowv1alpha1.JobFlow{
+				ObjectMeta: metav1.ObjectMeta{Name: "jobflow", Namespace: "default"},
+				Spec: jobflowv1alpha1.JobFlowSpec{
+					Flows: []jobflowv1alpha1.Flow{
+						{Name: "train", FailurePolicy: &jobflowv1alpha1.FailurePolicy{Action: jobflowv1alpha1.SkipFailureAction}},
+						{
+							Name:      "alert",
+							DependsOn: &jobflowv1alpha1.DependsOn{Targets: []string{"train"}},
+							When:      &jobflowv1alpha1.FlowCondition{Requirements: []jobflowv1alpha1.ConditionRequirement{tc.requirement}},
+						},
+					},
+				},
+			}
+
+			if err := fakeController.deployJob(jobFlow); err != nil {
+				t.Fatalf("deployJob failed: %v", err)
+			}
+
+			_, err := fakeController.vcClient.BatchV1alpha1().Jobs("default").Get(context.Background(), "jobflow-alert", metav1.GetOptions{})
+			if created := err == nil; created != tc.expectCreated {
+				t.Errorf("expected job created %v, got %v", tc.expectCreated, err)
+			}
+			if skipped := isFlowSkipped(&jobFlow.Status, "alert"); skipped == tc.expectCreated {
+				t.Errorf("expected flow skipped %v, got %v", !tc.expectCreated, skipped)
+			}
+		})
+	}
+}
+
+func TestJudgeWithSkippedFlowAndFanOutTarget(t *testing.T) {
+	fakeController := newFakeController()
+	fakeController.jobInformer.Informer().GetIndexer().Add(buildFlowJob("jobflow", "sweep-a", v1alpha1.Completed, time.Now()))
+
+	jobFlow := &jobflowv1alpha1.JobFlow{
+		ObjectMeta: metav1.ObjectMeta{Name: "jobflow", Namespace: "default"},
+		Spec: jobflowv1alpha1.JobFlowSpec{
+			Flows: []jobflowv1alpha1.Flow{
+				{Name: "sweep", Parameters: []jobflowv1alpha1.FlowParameter{{Name: "a"}, {Name: "b"}}},
+				{Name: "branch"},
+				{Name: "join", DependsOn: &jobflowv1alpha1.DependsOn{Targets: []string{"sweep", "branch"}}},
+			},
+		},
+		Status: jobflowv1alpha1.JobFlowStatus{SkippedFlows: []string{"branch"}},
+	}
+
+	met, err := fakeController.judge(jobFlow, jobFlow.Spec.Flows[2])
+	if err != nil || met {
+		t.Fatalf("expected join to wait for all the fan-out jobs, got %v, %v", met, err)
+	}
+
+	fakeController.jobInformer.Informer().GetIndexer().Add(buildFlowJob("jobflow", "sweep-b", v1alpha1.Completed, time.Now()))
+	met, err = fakeController.judge(jobFlow, jobFlow.Spec.Flows[2])
+	if err != nil || !met {
+		t.Fatalf("expected join to proceed once all the fan-out jobs completed, got %v, %v", met, err)
+	}
+}
+
+func TestSyncJobFlowWithFailureBranch(t *testing.T) {
+	fakeController := newFakeController()
+	ctx := context.Background()
+	for _, name := range []string{"alert", "deploy"} {
+		fakeController.jobTemplateInformer.Informer().GetIndexer().Add(buildJobTemplate(name))
+	}
+
+	jobFlow := &jobflowv1alpha1.JobFlow{
+		ObjectMeta: metav1.ObjectMeta{Name: "jobflow", Namespace: "default"},
+		Spec: jobflowv1alpha1.JobFlowSpec{
+			Flows: []jobflowv1alpha1.Flow{
+				{Name: "train"},
+				{
+					Name:      "alert",
+					DependsOn: &jobflowv1alpha1.DependsOn{Targets: []string{"train"}},
+					When: &jobflowv1alpha1.FlowCondition{Requirements: []jobflowv1alpha1.ConditionRequirement{
+						{Target: "train", Phase: v1alpha1.Failed},
+					}},
+				},
+				{
+					Name:      "deploy",
+					DependsOn: &jobflowv1alpha1.DependsOn{Targets: []string{"train"}},
+					When: &jobflowv1alpha1.FlowCondition{Requirements: []jobflowv1alpha1.ConditionRequirement{
+						{Target: "train", Phase: v1alpha1.Completed},
+					}},
+				},
+			},
+		},
+		Status: jobflowv1alpha1.JobFlowStatus{State: jobflowv1alpha1.State{Phase: jobflowv1alpha1.Running}},
+	}
+	if _, err := fakeController.vcClient.FlowV1alpha1().JobFlows("default").Create(ctx, jobFlow, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("failed to create JobFlow: %v", err)
+	}
+	trainJob := buildFlowJob("jobflow", "train", v1alpha1.Failed, time.Now())
+	trainJob.Labels = map[string]string{CreatedByJobFlow: GenerateObjectString("default", "jobflow")}
+	fakeController.jobInformer.Informer().GetIndexer().Add(trainJob)
+
+	sync := func(phase v1alpha1.JobPhase) *jobflowv1alpha1.JobFlow {
+		if err := state.NewState(jobFlow).Execute(jobflowv1alpha1.SyncJobFlowAction); err != nil {
+			t.Fatalf("failed to sync JobFlow: %v", err)
+		}
+		jobFlow, _ = fakeController.vcClient.FlowV1alpha1().JobFlows("default").Get(ctx, "jobflow", metav1.GetOptions{})
+
+		alertJob, err := fakeController.vcClient.BatchV1alpha1().Jobs("default").Get(ctx, "jobflow-alert", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("expected the failure branch to be created: %v", err)
+		}
+		alertJob.Status.State.Phase = phase
+		fakeController.jobInformer.Informer().GetIndexer().Update(alertJob)
+		return jobFlow
+	}
+
+	// The failed job is taken by the branch, the JobFlow keeps running until the branch finishes.
+	jobFlow = sync(v1alpha1.Completed)
+	if jobFlow.Status.State.Phase != jobflowv1alpha1.Running {
+		t.Fatalf("expected JobFlow to keep running, got %s", jobFlow.Status.State.Phase)
+	}
+	if !isFlowSkipped(&jobFlow.Status, "deploy") {
+		t.Errorf("expected flow deploy to be skipped, got skipped flows %v", jobFlow.Status.SkippedFlows)
+	}
+
+	jobFlow = sync(v1alpha1.Completed)
+	if jobFlow.Status.State.Phase != jobflowv1alpha1.Succeed {
+		t.Errorf("expected JobFlow to succeed once the branch completes, got %s", jobFlow.Status.State.Phase)
+	}
+}
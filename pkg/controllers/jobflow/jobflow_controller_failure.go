@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	busv1alpha1 "volcano.sh/apis/pkg/apis/bus/v1alpha1"
+	v1alpha1flow "volcano.sh/apis/pkg/apis/flow/v1alpha1"
+	"volcano.sh/apis/pkg/apis/helpers"
+	"volcano.sh/volcano/pkg/controllers/apis"
+)
+
+const (
+	// defaultFailureBackoff is the delay before the first retry of a failed job if the backoff is not set
+	defaultFailureBackoff = 10 * time.Second
+	// maxFailureBackoff is the upper bound of the delay before retrying a failed job
+	maxFailureBackoff = 10 * time.Minute
+)
+
+// getFailurePolicy returns the failure policy of the flow with defaults applied, nil means the flow has no failure policy.
+func getFailurePolicy(flow *v1alpha1flow.Flow) *v1alpha1flow.FailurePolicy {
+	if flow == nil || flow.FailurePolicy == nil {
+		return nil
+	}
+
+	policy := flow.FailurePolicy.DeepCopy()
+	if policy.Backoff == nil {
+		policy.Backoff = &metav1.Duration{Duration: defaultFailureBackoff}
+	}
+	if policy.Action == "" {
+		policy.Action = v1alpha1flow.AbortFailureAction
+	}
+	return policy
+}
+
+// failureBackoff returns the delay before retrying the job which has been retried for the given times.
+func failureBackoff(policy *v1alpha1flow.FailurePolicy, retries int32) time.Duration {
+	backoff := policy.Backoff.Duration
+	for i := int32(0); i < retries && backoff < maxFailureBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxFailureBackoff {
+		backoff = maxFailureBackoff
+	}
+	return backoff
+}
+
+// getFlow returns the flow with the given name.
+func getFlow(jobFlow *v1alpha1flow.JobFlow, flowName string) *v1alpha1flow.Flow {
+	for i := range jobFlow.Spec.Flows {
+		if jobFlow.Spec.Flows[i].Name == flowName {
+			return &jobFlow.Spec.Flows[i]
+		}
+	}
+	return nil
+}
+
+// getJobStatus returns the status of the job with the given name, nil if it is not found.
+func getJobStatus(status *v1alpha1flow.JobFlowStatus, jobName string) *v1alpha1flow.JobStatus {
+	for i := range status.JobStatusList {
+		if status.JobStatusList[i].Name == jobName {
+			return &status.JobStatusList[i]
+		}
+	}
+	return nil
+}
+
+// getJobRetries returns the times the job has been retried by the failure policy of its flow.
+func getJobRetries(status *v1alpha1flow.JobFlowStatus, jobName string) int32 {
+	if jobStatus := getJobStatus(status, jobName); jobStatus != nil {
+		return jobStatus.Retries
+	}
+	return 0
+}
+
+// isJobRetried checks whether the failed job has already been deleted to be retried, the job may still be seen in
+// the informer until its deletion is observed.
+func isJobRetried(status *v1alpha1flow.JobFlowStatus, job *v1alpha1.Job) bool {
+	jobStatus := getJobStatus(status, job.Name)
+	return jobStatus != nil && jobStatus.RetriedUID != "" && jobStatus.RetriedUID == job.UID
+}
+
+// isJobSkipped checks whether the failed job of the flow is skipped, so that the dependent flows proceed.
+func isJobSkipped(jobFlow *v1alpha1flow.JobFlow, flow *v1alpha1flow.Flow, job *v1alpha1.Job) bool {
+	if job.Status.State.Phase != v1alpha1.Failed {
+		return false
+	}
+	policy := getFailurePolicy(flow)
+	if policy == nil || policy.Action != v1alpha1flow.SkipFailureAction {
+		return false
+	}
+	return getJobRetries(&jobFlow.Status, job.Name) >= policy.MaxRetries && !isJobRetried(&jobFlow.Status, job)
+}
+
+// handleFailedJobs applies the failure policies of the flows to their failed jobs. The jobs with retries left are
+// recreated after the backoff and removed from the failed jobs, the failed jobs of the flows with Skip action or taken
+// by the branch flows are recorded as skipped, and the jobs of the other flows are terminated once a flow with Abort
+// action fails.
+func (jf *jobflowcontroller) handleFailedJobs(jobFlow *v1alpha1flow.JobFlow, status *v1alpha1flow.JobFlowStatus) error {
+	failedJobs := make([]string, 0, len(status.FailedJobs))
+	skippedJobs := make([]string, 0)
+	aborted := false
+	for _, jobName := range status.FailedJobs {
+		job, err := jf.jobLister.Jobs(jobFlow.Namespace).Get(jobName)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		flow := getFlowByJobName(jobFlow, jobName)
+		policy := getFailurePolicy(flow)
+		if policy != nil && (isJobRetried(status, job) || getJobRetries(status, jobName) < policy.MaxRetries) {
+			if err := jf.retryJob(jobFlow, job, policy, status); err != nil {
+				return err
+			}
+			continue
+		}
+
+		failedJobs = append(failedJobs, jobName)
+		// The failure is handled by the branch flows conditioned on it rather than failing the JobFlow.
+		if isFailureBranched(jobFlow, flow) {
+			skippedJobs = append(skippedJobs, jobName)
+			continue
+		}
+		if policy == nil {
+			continue
+		}
+		switch policy.Action {
+		case v1alpha1flow.SkipFailureAction:
+			skippedJobs = append(skippedJobs, jobName)
+		case v1alpha1flow.AbortFailureAction:
+			aborted = true
+		}
+	}
+
+	status.FailedJobs = failedJobs
+	status.SkippedJobs = skippedJobs
+	if aborted && jobFlow.Status.State.Phase != v1alpha1flow.Failed {
+		return jf.terminateJobs(jobFlow, append(status.PendingJobs, status.RunningJobs...))
+	}
+	return nil
+}
+
+// retryJob deletes the failed job once the backoff is passed, so that it is recreated by the next sync. The retry is
+// recorded in the status of the JobFlow before the job is deleted, so that it is neither lost nor counted twice.
+func (jf *jobflowcontroller) retryJob(jobFlow *v1alpha1flow.JobFlow, job *v1alpha1.Job, policy *v1alpha1flow.FailurePolicy, status *v1alpha1flow.JobFlowStatus) error {
+	if job.DeletionTimestamp != nil {
+		return nil
+	}
+
+	jobStatus := getJobStatus(status, job.Name)
+	if jobStatus == nil {
+		return fmt.Errorf("status of job %s not found in JobFlow %s/%s", job.Name, jobFlow.Namespace, jobFlow.Name)
+	}
+
+	if jobStatus.RetriedUID != job.UID {
+		backoff := failureBackoff(policy, jobStatus.Retries)
+		if delay := backoff - time.Since(job.Status.State.LastTransitionTime.Time); delay > 0 {
+			klog.V(4).Infof("Retry failed job %s/%s after %v.", jobFlow.Namespace, job.Name, delay)
+			jf.queue.AddAfter(apis.FlowRequest{
+				Namespace:   jobFlow.Namespace,
+				JobFlowName: jobFlow.Name,
+				Action:      v1alpha1flow.SyncJobFlowAction,
+				Event:       v1alpha1flow.OutOfSyncEvent,
+			}, delay)
+			return nil
+		}
+
+		jobStatus.Retries++
+		jobStatus.RetriedUID = job.UID
+		if err := jf.updateJobStatusList(jobFlow, status.JobStatusList); err != nil {
+			jobStatus.Retries--
+			jobStatus.RetriedUID = ""
+			return err
+		}
+		jf.recorder.Eventf(jobFlow, corev1.EventTypeNormal, "Retry",
+			fmt.Sprintf("retry the failed job %v (%d/%d)", job.Name, jobStatus.Retries, policy.MaxRetries))
+	}
+
+	err := jf.vcClient.BatchV1alpha1().Jobs(jobFlow.Namespace).Delete(context.Background(), job.Name, metav1.DeleteOptions{
+		Preconditions: metav1.NewUIDPreconditions(string(job.UID)),
+	})
+	if err != nil && !errors.IsNotFound(err) && !errors.IsConflict(err) {
+		return err
+	}
+	return nil
+}
+
+// updateJobStatusList persists the job status list into the status of the JobFlow, the resource version of the
+// JobFlow is updated so that the status update at the end of the sync does not conflict with it.
+func (jf *jobflowcontroller) updateJobStatusList(jobFlow *v1alpha1flow.JobFlow, jobStatusList []v1alpha1flow.JobStatus) error {
+	newJobFlow := jobFlow.DeepCopy()
+	newJobFlow.Status.JobStatusList = make([]v1alpha1flow.JobStatus, len(jobStatusList))
+	for i := range jobStatusList {
+		jobStatusList[i].DeepCopyInto(&newJobFlow.Status.JobStatusList[i])
+	}
+	updated, err := jf.vcClient.FlowV1alpha1().JobFlows(jobFlow.Namespace).UpdateStatus(context.Background(), newJobFlow, metav1.UpdateOptions{})
+	if err != nil {
+		klog.Errorf("Failed to update job status of JobFlow %v/%v: %v", jobFlow.Namespace, jobFlow.Name, err)
+		return err
+	}
+	jobFlow.ResourceVersion = updated.ResourceVersion
+	return nil
+}
+
+// terminateJobs terminates the jobs by commands, so that they are handled by the job controller as usual. The command
+// of a job is named after the job, so that the job is terminated once however many times the JobFlow is synced.
+func (jf *jobflowcontroller) terminateJobs(jobFlow *v1alpha1flow.JobFlow, jobNames []string) error {
+	for _, jobName := range jobNames {
+		job, err := jf.jobLister.Jobs(jobFlow.Namespace).Get(jobName)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		switch job.Status.State.Phase {
+		case v1alpha1.Terminating, v1alpha1.Terminated, v1alpha1.Aborting, v1alpha1.Aborted,
+			v1alpha1.Completing, v1alpha1.Completed, v1alpha1.Failed:
+			continue
+		}
+
+		ctrlRef := metav1.NewControllerRef(job, helpers.JobKind)
+		cmd := &busv1alpha1.Command{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", job.Name, strings.ToLower(string(busv1alpha1.TerminateJobAction))),
+				Namespace: job.Namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					*ctrlRef,
+				},
+			},
+			TargetObject: ctrlRef,
+			Action:       string(busv1alpha1.TerminateJobAction),
+		}
+		if _, err := jf.vcClient.BusV1alpha1().Commands(job.Namespace).Create(context.Background(), cmd, metav1.CreateOptions{}); err != nil {
+			if errors.IsAlreadyExists(err) {
+				continue
+			}
+			klog.Errorf("Failed to terminate job %s/%s of JobFlow %s: %v", job.Namespace, job.Name, jobFlow.Name, err)
+			return err
+		}
+		jf.recorder.Eventf(jobFlow, corev1.EventTypeNormal, "Terminate", fmt.Sprintf("terminate the job %v as the JobFlow is aborted", job.Name))
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobflow
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	busv1alpha1 "volcano.sh/apis/pkg/apis/bus/v1alpha1"
+	jobflowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
+)
+
+func buildFlowJob(jobFlowName, flowName string, phase v1alpha1.JobPhase, lastTransitionTime time.Time) *v1alpha1.Job {
+	return &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getJobName(jobFlowName, flowName),
+			Namespace: "default",
+			UID:       types.UID("uid-" + flowName),
+		},
+		Status: v1alpha1.JobStatus{
+			State: v1alpha1.JobState{Phase: phase, LastTransitionTime: metav1.NewTime(lastTransitionTime)},
+		},
+	}
+}
+
+func TestHandleFailedJobs(t *testing.T) {
+	longAgo := time.Now().Add(-time.Hour)
+	testCases := []struct {
+		name            string
+		failurePolicy   *jobflowv1alpha1.FailurePolicy
+		retries         int32
+		retriedUID      types.UID
+		failedAt        time.Time
+		expectedFailed  []string
+		expectedSkipped []string
+		expectedRetries int32
+		expectDeleted   bool
+		expectTerminate bool
+	}{
+		{
+			name:            "without failure policy the failed job is kept",
+			failedAt:        longAgo,
+			expectedFailed:  []string{"jobflow-a"},
+			expectedSkipped: []string{},
+		},
+		{
+			name:            "wait for the backoff before retrying the failed job",
+			failurePolicy:   &jobflowv1alpha1.FailurePolicy{MaxRetries: 2, Backoff: &metav1.Duration{Duration: time.Minute}},
+			failedAt:        time.Now(),
+			expectedFailed:  []string{},
+			expectedSkipped: []string{},
+		},
+		{
+			name:            "retry the failed job after the backoff",
+			failurePolicy:   &jobflowv1alpha1.FailurePolicy{MaxRetries: 2},
+			retries:         1,
+			failedAt:        longAgo,
+			expectedFailed:  []string{},
+			expectedSkipped: []string{},
+			expectedRetries: 2,
+			expectDeleted:   true,
+		},
+		{
+			name:            "the failed job already retried is not aborted again",
+			failurePolicy:   &jobflowv1alpha1.FailurePolicy{MaxRetries: 1},
+			retries:         1,
+			retriedUID:      "uid-a",
+			failedAt:        longAgo,
+			expectedFailed:  []string{},
+			expectedSkipped: []string{},
+			expectedRetries: 1,
+			expectDeleted:   true,
+		},
+		{
+			name:            "skip the failed job once the retries are exhausted",
+			failurePolicy:   &jobflowv1alpha1.FailurePolicy{MaxRetries: 1, Action: jobflowv1alpha1.SkipFailureAction},
+			retries:         1,
+			failedAt:        longAgo,
+			expectedFailed:  []string{"jobflow-a"},
+			expectedSkipped: []string{"jobflow-a"},
+			expectedRetries: 1,
+		},
+		{
+			name:            "abort the JobFlow and terminate the running jobs",
+			failurePolicy:   &jobflowv1alpha1.FailurePolicy{},
+			failedAt:        longAgo,
+			expectedFailed:  []string{"jobflow-a"},
+			expectedSkipped: []string{},
+			expectTerminate: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeController := newFakeController()
+			ctx := context.Background()
+
+			jobFlow := &jobflowv1alpha1.JobFlow{
+				ObjectMeta: metav1.ObjectMeta{Name: "jobflow", Namespace: "default"},
+				Spec: jobflowv1alpha1.JobFlowSpec{
+					Flows: []jobflowv1alpha1.Flow{
+						{Name: "a", FailurePolicy: tc.failurePolicy},
+						{Name: "b"},
+					},
+				},
+				Status: jobflowv1alpha1.JobFlowStatus{State: jobflowv1alpha1.State{Phase: jobflowv1alpha1.Running}},
+			}
+			if _, err := fakeController.vcClient.FlowV1alpha1().JobFlows("default").Create(ctx, jobFlow, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("failed to create jobflow: %v", err)
+			}
+			for _, job := range []*v1alpha1.Job{
+				buildFlowJob("jobflow", "a", v1alpha1.Failed, tc.failedAt),
+				buildFlowJob("jobflow", "b", v1alpha1.Running, longAgo),
+			} {
+				fakeController.jobInformer.Informer().GetIndexer().Add(job)
+				if _, err := fakeController.vcClient.BatchV1alpha1().Jobs("default").Create(ctx, job, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("failed to create job: %v", err)
+				}
+			}
+
+			status := &jobflowv1alpha1.JobFlowStatus{
+				FailedJobs:  []string{"jobflow-a"},
+				RunningJobs: []string{"jobflow-b"},
+				JobStatusList: []jobflowv1alpha1.JobStatus{
+					{Name: "jobflow-a", State: v1alpha1.Failed, Retries: tc.retries, RetriedUID: tc.retriedUID},
+					{Name: "jobflow-b", State: v1alpha1.Running},
+				},
+			}
+			if err := fakeController.handleFailedJobs(jobFlow, status); err != nil {
+				t.Fatalf("handleFailedJobs failed: %v", err)
+			}
+
+			if !reflect.DeepEqual(status.FailedJobs, tc.expectedFailed) {
+				t.Errorf("expected failed jobs %v, got %v", tc.expectedFailed, status.FailedJobs)
+			}
+			if !reflect.DeepEqual(status.SkippedJobs, tc.expectedSkipped) {
+				t.Errorf("expected skipped jobs %v, got %v", tc.expectedSkipped, status.SkippedJobs)
+			}
+			if status.JobStatusList[0].Retries != tc.expectedRetries {
+				t.Errorf("expected retries %d, got %d", tc.expectedRetries, status.JobStatusList[0].Retries)
+			}
+
+			// the retry is persisted before the failed job is deleted
+			persisted, err := fakeController.vcClient.FlowV1alpha1().JobFlows("default").Get(ctx, "jobflow", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get jobflow: %v", err)
+			}
+			if tc.expectDeleted && tc.retriedUID == "" {
+				if retries := getJobRetries(&persisted.Status, "jobflow-a"); retries != tc.expectedRetries {
+					t.Errorf("expected persisted retries %d, got %d", tc.expectedRetries, retries)
+				}
+				if jobStatus := getJobStatus(&persisted.Status, "jobflow-a"); jobStatus == nil || jobStatus.RetriedUID != "uid-a" {
+					t.Errorf("expected persisted retried uid uid-a, got %v", jobStatus)
+				}
+			}
+
+			_, err = fakeController.vcClient.BatchV1alpha1().Jobs("default").Get(ctx, "jobflow-a", metav1.GetOptions{})
+			if deleted := apierrors.IsNotFound(err); deleted != tc.expectDeleted {
+				t.Errorf("expected failed job deleted %v, got %v", tc.expectDeleted, err)
+			}
+
+			commands, err := fakeController.vcClient.BusV1alpha1().Commands("default").List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("failed to list commands: %v", err)
+			}
+			if terminated := len(commands.Items) > 0; terminated != tc.expectTerminate {
+				t.Fatalf("expected running jobs terminated %v, got commands %v", tc.expectTerminate, commands.Items)
+			}
+			if tc.expectTerminate {
+				cmd := commands.Items[0]
+				if len(commands.Items) != 1 || cmd.TargetObject.Name != "jobflow-b" || cmd.Action != string(busv1alpha1.TerminateJobAction) {
+					t.Errorf("expected job jobflow-b to be terminated, got commands %v", commands.Items)
+				}
+			}
+		})
+	}
+}
+
+func TestTerminateJobsIdempotent(t *testing.T) {
+	fakeController := newFakeController()
+	ctx := context.Background()
+
+	jobFlow := &jobflowv1alpha1.JobFlow{ObjectMeta: metav1.ObjectMeta{Name: "jobflow", Namespace: "default"}}
+	for _, job := range []*v1alpha1.Job{
+		buildFlowJob("jobflow", "a", v1alpha1.Running, time.Now()),
+		buildFlowJob("jobflow", "b", v1alpha1.Terminating, time.Now()),
+	} {
+		fakeController.jobInformer.Informer().GetIndexer().Add(job)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := fakeController.terminateJobs(jobFlow, []string{"jobflow-a", "jobflow-b"}); err != nil {
+			t.Fatalf("terminateJobs failed: %v", err)
+		}
+	}
+
+	commands, err := fakeController.vcClient.BusV1alpha1().Commands("default").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("failed to list commands: %v", err)
+	}
+	if len(commands.Items) != 1 || commands.Items[0].TargetObject.Name != "jobflow-a" {
+		t.Errorf("expected one command to terminate jobflow-a, got %v", commands.Items)
+	}
+}
+
+func TestJudgeWithSkippedTarget(t *testing.T) {
+	testCases := []struct {
+		name          string
+		failurePolicy *jobflowv1alpha1.FailurePolicy
+		retries       int32
+		expected      bool
+	}{
+		{
+			name:     "failed target without failure policy",
+			expected: false,
+		},
+		{
+			name:          "failed target with retries left",
+			failurePolicy: &jobflowv1alpha1.FailurePolicy{MaxRetries: 1, Action: jobflowv1alpha1.SkipFailureAction},
+			expected:      false,
+		},
+		{
+			name:          "failed target skipped",
+			failurePolicy: &jobflowv1alpha1.FailurePolicy{MaxRetries: 1, Action: jobflowv1alpha1.SkipFailureAction},
+			retries:       1,
+			expected:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeController := newFakeController()
+			fakeController.jobInformer.Informer().GetIndexer().Add(buildFlowJob("jobflow", "a", v1alpha1.Failed, time.Now()))
+
+			jobFlow := &jobflowv1alpha1.JobFlow{
+				ObjectMeta: metav1.ObjectMeta{Name: "jobflow", Namespace: "default"},
+				Spec: jobflowv1alpha1.JobFlowSpec{
+					Flows: []jobflowv1alpha1.Flow{
+						{Name: "a", FailurePolicy: tc.failurePolicy},
+						{Name: "b", DependsOn: &jobflowv1alpha1.DependsOn{Targets: []string{"a"}}},
+					},
+				},
+				Status: jobflowv1alpha1.JobFlowStatus{
+					JobStatusList: []jobflowv1alpha1.JobStatus{{Name: "jobflow-a", State: v1alpha1.Failed, Retries: tc.retries}},
+				},
+			}
+
+			met, err := fakeController.judge(jobFlow, jobFlow.Spec.Flows[1])
+			if err != nil {
+				t.Fatalf("judge failed: %v", err)
+			}
+			if met != tc.expected {
+				t.Errorf("expected dependency met %v, got %v", tc.expected, met)
+			}
+		})
+	}
+}
+
+func TestFailureBackoff(t *testing.T) {
+	policy := getFailurePolicy(&jobflowv1alpha1.Flow{Name: "a", FailurePolicy: &jobflowv1alpha1.FailurePolicy{}})
+	if policy.Action != jobflowv1alpha1.AbortFailureAction {
+		t.Errorf("expected default action %s, got %s", jobflowv1alpha1.AbortFailureAction, policy.Action)
+	}
+
+	for retries, expected := range map[int32]time.Duration{
+		0:  defaultFailureBackoff,
+		1:  2 * defaultFailureBackoff,
+		3:  8 * defaultFailureBackoff,
+		10: maxFailureBackoff,
+	} {
+		if backoff := failureBackoff(policy, retries); backoff != expected {
+			t.Errorf("expected backoff %v after %d retries, got %v", expected, retries, backoff)
+		}
+	}
+}
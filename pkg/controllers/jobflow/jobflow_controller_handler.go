@@ -17,6 +17,7 @@ limitations under the License.
 package jobflow
 
 import (
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
 	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
@@ -117,3 +118,39 @@ func (jf *jobflowcontroller) updateJob(oldObj, newObj interface{}) {
 
 	jf.enqueueJobFlow(req)
 }
+
+// deleteJob syncs the JobFlow of the deleted job, so that the job retried by the failure policy is recreated.
+func (jf *jobflowcontroller) deleteJob(obj interface{}) {
+	job, ok := obj.(*batch.Job)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Couldn't get object from tombstone %#v", obj)
+			return
+		}
+		job, ok = tombstone.Obj.(*batch.Job)
+		if !ok {
+			klog.Errorf("Tombstone contained object that is not a vcjob %#v", obj)
+			return
+		}
+	}
+
+	// Filter out jobs that are not created from volcano jobflow
+	if !isControlledBy(job, helpers.JobFlowKind) {
+		return
+	}
+
+	jobFlowName := getJobFlowNameByJob(job)
+	if jobFlowName == "" {
+		return
+	}
+
+	req := apis.FlowRequest{
+		Namespace:   job.Namespace,
+		JobFlowName: jobFlowName,
+		Action:      jobflowv1alpha1.SyncJobFlowAction,
+		Event:       jobflowv1alpha1.OutOfSyncEvent,
+	}
+
+	jf.enqueueJobFlow(req)
+}
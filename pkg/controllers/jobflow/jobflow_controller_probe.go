@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobflow
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	v1alpha1flow "volcano.sh/apis/pkg/apis/flow/v1alpha1"
+)
+
+const (
+	// probeRetryInterval is the interval to run the probes again if they are not passed
+	probeRetryInterval = 5 * time.Second
+	// probeTimeout is the timeout of a single HttpGet or TcpSocket probe
+	probeTimeout = time.Second
+)
+
+// hasProbe checks whether the dependency declares any probe, otherwise the targets must be completed.
+func hasProbe(dependsOn *v1alpha1flow.DependsOn) bool {
+	if dependsOn == nil || dependsOn.Probe == nil {
+		return false
+	}
+	probe := dependsOn.Probe
+	return len(probe.HttpGetList) > 0 || len(probe.TcpSocketList) > 0 || len(probe.TaskStatusList) > 0
+}
+
+// runProbes runs the probes of the flow against the target job and returns their results.
+func (jf *jobflowcontroller) runProbes(flow v1alpha1flow.Flow, target string, job *v1alpha1.Job) ([]v1alpha1flow.ProbeStatus, error) {
+	pods, err := jf.podLister.Pods(job.Namespace).List(labels.SelectorFromSet(labels.Set{v1alpha1.JobNameKey: job.Name}))
+	if err != nil {
+		return nil, err
+	}
+
+	probe := flow.DependsOn.Probe
+	var statuses []v1alpha1flow.ProbeStatus
+	newStatus := func(probeType v1alpha1flow.ProbeType, taskName string, err error) v1alpha1flow.ProbeStatus {
+		status := v1alpha1flow.ProbeStatus{
+			FlowName: flow.Name,
+			Target:   target,
+			Type:     probeType,
+			TaskName: taskName,
+			Passed:   err == nil,
+		}
+		if err != nil {
+			status.Message = err.Error()
+		}
+		return status
+	}
+
+	for _, httpGet := range probe.HttpGetList {
+		err := probeTaskPods(pods, httpGet.TaskName, func(pod *corev1.Pod) error {
+			return probeHTTPGet(pod.Status.PodIP, httpGet)
+		})
+		statuses = append(statuses, newStatus(v1alpha1flow.HttpGetProbeType, httpGet.TaskName, err))
+	}
+	for _, tcpSocket := range probe.TcpSocketList {
+		err := probeTaskPods(pods, tcpSocket.TaskName, func(pod *corev1.Pod) error {
+			return probeTCPSocket(pod.Status.PodIP, tcpSocket.Port)
+		})
+		statuses = append(statuses, newStatus(v1alpha1flow.TcpSocketProbeType, tcpSocket.TaskName, err))
+	}
+	for _, taskStatus := range probe.TaskStatusList {
+		err := probeTaskStatus(job, taskStatus)
+		statuses = append(statuses, newStatus(v1alpha1flow.TaskStatusProbeType, taskStatus.TaskName, err))
+	}
+
+	return statuses, nil
+}
+
+// probeTaskPods runs the probe against all the running pods of the task, empty task name means all the tasks.
+func probeTaskPods(pods []*corev1.Pod, taskName string, probe func(pod *corev1.Pod) error) error {
+	probed := 0
+	for _, pod := range pods {
+		if taskName != "" && pod.Labels[v1alpha1.TaskSpecKey] != taskName {
+			continue
+		}
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" {
+			return fmt.Errorf("pod %s is not running", pod.Name)
+		}
+		if err := probe(pod); err != nil {
+			return fmt.Errorf("pod %s: %v", pod.Name, err)
+		}
+		probed++
+	}
+
+	if probed == 0 {
+		return fmt.Errorf("no running pod of task %q found", taskName)
+	}
+	return nil
+}
+
+// probeHTTPGet sends a GET request to the pod, status code in [200, 400) is considered as passed.
+func probeHTTPGet(podIP string, httpGet v1alpha1flow.HttpGet) error {
+	path := httpGet.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	url := fmt.Sprintf("http://%s%s", net.JoinHostPort(podIP, strconv.Itoa(httpGet.Port)), path)
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	if httpGet.HTTPHeader.Name != "" {
+		req.Header.Set(httpGet.HTTPHeader.Name, httpGet.HTTPHeader.Value)
+	}
+
+	client := &http.Client{Timeout: probeTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("HTTP probe to %s failed with statuscode: %d", url, resp.StatusCode)
+	}
+	return nil
+}
+
+// probeTCPSocket opens a tcp connection to the pod.
+func probeTCPSocket(podIP string, port int) error {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(podIP, strconv.Itoa(port)), probeTimeout)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// probeTaskStatus checks whether all the pods of the task reach the phase, empty task name means all the tasks.
+func probeTaskStatus(job *v1alpha1.Job, taskStatus v1alpha1flow.TaskStatus) error {
+	found := false
+	for _, task := range job.Spec.Tasks {
+		if taskStatus.TaskName != "" && task.Name != taskStatus.TaskName {
+			continue
+		}
+		found = true
+
+		count := job.Status.TaskStatusCount[task.Name].Phase[corev1.PodPhase(taskStatus.Phase)]
+		if count < task.Replicas {
+			return fmt.Errorf("%d/%d pods of task %s are %s", count, task.Replicas, task.Name, taskStatus.Phase)
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("task %q not found in job %s", taskStatus.TaskName, job.Name)
+	}
+	return nil
+}
+
+// setProbeStatuses replaces the probe results of the flow, the transition time is kept if the result is not changed.
+func setProbeStatuses(status *v1alpha1flow.JobFlowStatus, flowName string, statuses []v1alpha1flow.ProbeStatus) {
+	var oldStatuses, probeStatuses []v1alpha1flow.ProbeStatus
+	for _, probeStatus := range status.ProbeStatuses {
+		if probeStatus.FlowName == flowName {
+			oldStatuses = append(oldStatuses, probeStatus)
+		} else {
+			probeStatuses = append(probeStatuses, probeStatus)
+		}
+	}
+
+	now := metav1.Now()
+	for i := range statuses {
+		statuses[i].LastTransitionTime = now
+		if i >= len(oldStatuses) {
+			continue
+		}
+		old := oldStatuses[i]
+		if old.Target == statuses[i].Target && old.Type == statuses[i].Type &&
+			old.TaskName == statuses[i].TaskName && old.Passed == statuses[i].Passed {
+			statuses[i].LastTransitionTime = old.LastTransitionTime
+		}
+	}
+
+	status.ProbeStatuses = append(probeStatuses, statuses...)
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobflow
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	jobflowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
+)
+
+func buildProbedPod(name, jobName, taskName, podIP string, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				v1alpha1.JobNameKey:  jobName,
+				v1alpha1.TaskSpecKey: taskName,
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			PodIP: podIP,
+		},
+	}
+}
+
+func TestJudgeWithProbe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" || r.Header.Get("X-Probe") != "jobflow" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	_, httpPort, _ := net.SplitHostPort(server.Listener.Addr().String())
+	serverPort, _ := strconv.Atoi(httpPort)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	tcpPort := listener.Addr().(*net.TCPAddr).Port
+	closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	closedPort := closedListener.Addr().(*net.TCPAddr).Port
+	closedListener.Close()
+	defer listener.Close()
+
+	jobName := getJobName("jobflow", "ps")
+	targetJob := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: jobName, Namespace: "default"},
+		Spec: v1alpha1.JobSpec{
+			Tasks: []v1alpha1.TaskSpec{{Name: "ps", Replicas: 2}},
+		},
+		Status: v1alpha1.JobStatus{
+			State: v1alpha1.JobState{Phase: v1alpha1.Running},
+			TaskStatusCount: map[string]v1alpha1.TaskState{
+				"ps": {Phase: map[corev1.PodPhase]int32{corev1.PodRunning: 2}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		probe    *jobflowv1alpha1.Probe
+		pods     []*corev1.Pod
+		expected bool
+	}{
+		{
+			name:     "no probe waits for the target to complete",
+			expected: false,
+		},
+		{
+			name: "http get probe passed",
+			probe: &jobflowv1alpha1.Probe{
+				HttpGetList: []jobflowv1alpha1.HttpGet{{
+					TaskName:   "ps",
+					Path:       "healthz",
+					Port:       serverPort,
+					HTTPHeader: corev1.HTTPHeader{Name: "X-Probe", Value: "jobflow"},
+				}},
+			},
+			pods:     []*corev1.Pod{buildProbedPod("ps-0", jobName, "ps", "127.0.0.1", corev1.PodRunning)},
+			expected: true,
+		},
+		{
+			name: "http get probe failed with unexpected status code",
+			probe: &jobflowv1alpha1.Probe{
+				HttpGetList: []jobflowv1alpha1.HttpGet{{TaskName: "ps", Path: "/healthz", Port: serverPort}},
+			},
+			pods:     []*corev1.Pod{buildProbedPod("ps-0", jobName, "ps", "127.0.0.1", corev1.PodRunning)},
+			expected: false,
+		},
+		{
+			name: "http get probe failed without running pods",
+			probe: &jobflowv1alpha1.Probe{
+				HttpGetList: []jobflowv1alpha1.HttpGet{{TaskName: "ps", Path: "/healthz", Port: serverPort}},
+			},
+			pods:     []*corev1.Pod{buildProbedPod("ps-0", jobName, "ps", "", corev1.PodPending)},
+			expected: false,
+		},
+		{
+			name: "tcp socket probe passed",
+			probe: &jobflowv1alpha1.Probe{
+				TcpSocketList: []jobflowv1alpha1.TcpSocket{{TaskName: "ps", Port: tcpPort}},
+			},
+			pods:     []*corev1.Pod{buildProbedPod("ps-0", jobName, "ps", "127.0.0.1", corev1.PodRunning)},
+			expected: true,
+		},
+		{
+			name: "tcp socket probe failed",
+			probe: &jobflowv1alpha1.Probe{
+				TcpSocketList: []jobflowv1alpha1.TcpSocket{{TaskName: "ps", Port: closedPort}},
+			},
+			pods:     []*corev1.Pod{buildProbedPod("ps-0", jobName, "ps", "127.0.0.1", corev1.PodRunning)},
+			expected: false,
+		},
+		{
+			name: "task status probe passed",
+			probe: &jobflowv1alpha1.Probe{
+				TaskStatusList: []jobflowv1alpha1.TaskStatus{{TaskName: "ps", Phase: string(corev1.PodRunning)}},
+			},
+			expected: true,
+		},
+		{
+			name: "task status probe failed",
+			probe: &jobflowv1alpha1.Probe{
+				TaskStatusList: []jobflowv1alpha1.TaskStatus{{TaskName: "ps", Phase: string(corev1.PodSucceeded)}},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeController := newFakeController()
+			fakeController.jobInformer.Informer().GetIndexer().Add(targetJob)
+			for _, pod := range tc.pods {
+				fakeController.podInformer.Informer().GetIndexer().Add(pod)
+			}
+
+			jobFlow := &jobflowv1alpha1.JobFlow{ObjectMeta: metav1.ObjectMeta{Name: "jobflow", Namespace: "default"}}
+			flow := jobflowv1alpha1.Flow{
+				Name:      "trainer",
+				DependsOn: &jobflowv1alpha1.DependsOn{Targets: []string{"ps"}, Probe: tc.probe},
+			}
+
+			met, err := fakeController.judge(jobFlow, flow)
+			if err != nil {
+				t.Fatalf("judge failed: %v", err)
+			}
+			if met != tc.expected {
+				t.Errorf("expected dependency met %v, got %v, probe statuses %+v", tc.expected, met, jobFlow.Status.ProbeStatuses)
+			}
+
+			if tc.probe == nil {
+				return
+			}
+			if len(jobFlow.Status.ProbeStatuses) != 1 {
+				t.Fatalf("expected 1 probe status, got %+v", jobFlow.Status.ProbeStatuses)
+			}
+			status := jobFlow.Status.ProbeStatuses[0]
+			if status.FlowName != "trainer" || status.Target != "ps" || status.Passed != tc.expected {
+				t.Errorf("unexpected probe status %+v", status)
+			}
+		})
+	}
+}
+
+func TestSetProbeStatuses(t *testing.T) {
+	lastTransitionTime := metav1.Unix(100, 0)
+	status := &jobflowv1alpha1.JobFlowStatus{
+		ProbeStatuses: []jobflowv1alpha1.ProbeStatus{
+			{FlowName: "a", Target: "ps", Type: jobflowv1alpha1.TcpSocketProbeType, Passed: false, LastTransitionTime: lastTransitionTime},
+			{FlowName: "b", Target: "ps", Type: jobflowv1alpha1.HttpGetProbeType, Passed: false, LastTransitionTime: lastTransitionTime},
+		},
+	}
+
+	setProbeStatuses(status, "b", []jobflowv1alpha1.ProbeStatus{
+		{FlowName: "b", Target: "ps", Type: jobflowv1alpha1.HttpGetProbeType, Passed: false},
+	})
+	if len(status.ProbeStatuses) != 2 || !status.ProbeStatuses[1].LastTransitionTime.Equal(&lastTransitionTime) {
+		t.Errorf("expected transition time to be kept, got %+v", status.ProbeStatuses)
+	}
+
+	setProbeStatuses(status, "b", []jobflowv1alpha1.ProbeStatus{
+		{FlowName: "b", Target: "ps", Type: jobflowv1alpha1.HttpGetProbeType, Passed: true},
+	})
+	if len(status.ProbeStatuses) != 2 || status.ProbeStatuses[1].LastTransitionTime.Equal(&lastTransitionTime) {
+		t.Errorf("expected transition time to be updated, got %+v", status.ProbeStatuses)
+	}
+	if status.ProbeStatuses[0].FlowName != "a" || !status.ProbeStatuses[0].LastTransitionTime.Equal(&lastTransitionTime) {
+		t.Errorf("expected probe status of other flows to be kept, got %+v", status.ProbeStatuses)
+	}
+}
@@ -26,10 +26,11 @@ func (p *runningState) Execute(action v1alpha1.Action) error {
 	switch action {
 	case v1alpha1.SyncJobFlowAction:
 		return SyncJobFlow(p.jobFlow, func(status *v1alpha1.JobFlowStatus, allJobList int) {
-			if len(status.CompletedJobs) == allJobList {
+			// the skipped jobs are failed jobs of the optional flows or taken by the branch flows, they don't fail the JobFlow
+			if len(status.CompletedJobs)+len(status.SkippedJobs) == allJobList {
 				UpdateJobFlowSucceed(p.jobFlow.Namespace)
 				status.State.Phase = v1alpha1.Succeed
-			} else if len(status.FailedJobs) > 0 { // TODO(dongjiang199) Modify it when the if condition judgment is implemented
+			} else if len(status.FailedJobs) > len(status.SkippedJobs) {
 				status.State.Phase = v1alpha1.Failed
 			}
 		})
@@ -303,6 +303,7 @@ func (alloc *Action) allocateResources(actx *allocateContext) {
 		}
 
 		job := jobs.Pop().(*api.JobInfo)
+		jobSpan := ssn.TraceJob(job)
 		// Currently, both hard-mode network topology scheduling and subjob level scheduling use allocateForJob.
 		// TODO: In the future, we may need to unify the logic of network topology-aware scheduling and normal scheduling.
 		if job.ContainsHardTopology() || job.ContainsSubJobPolicy() {
@@ -341,6 +342,14 @@ func (alloc *Action) allocateResources(actx *allocateContext) {
 			}
 		}
 
+		jobSpan.End()
+
+		if queue.DequeueStrategy == scheduling.DequeueStrategyFIFO && !ssn.JobReady(job) {
+			// The jobs behind the head job can not leapfrog it in a fifo queue.
+			ssn.BlockQueue(queue, job, jobs, alloc.Name())
+			continue
+		}
+
 		// Put back the queue to priority queue after job's resource allocating finished,
 		// To ensure that the priority of the queue is calculated based on the latest resource allocation situation.
 		queues.Push(queue)
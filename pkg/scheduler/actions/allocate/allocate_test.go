@@ -22,12 +22,15 @@ limitations under the License.
 package allocate
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	v1 "k8s.io/api/core/v1"
 	resourcev1 "k8s.io/api/resource/v1"
 	storagev1 "k8s.io/api/storage/v1"
@@ -52,6 +55,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/plugins/nodeorder"
 	"volcano.sh/volcano/pkg/scheduler/plugins/predicates"
 	"volcano.sh/volcano/pkg/scheduler/plugins/proportion"
+	"volcano.sh/volcano/pkg/scheduler/tracing"
 	"volcano.sh/volcano/pkg/scheduler/uthelper"
 	"volcano.sh/volcano/pkg/scheduler/util"
 )
@@ -226,6 +230,50 @@ func TestAllocate(t *testing.T) {
 			},
 			ExpectBindsNum: 1,
 		},
+		{
+			Name: "later job is allocated when the head job is unschedulable in traverse queue",
+			PodGroups: []*schedulingv1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "c1", 2, nil, schedulingv1.PodGroupInqueue),
+				util.BuildPodGroup("pg2", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				// the head job pg1 can not be allocated as a gang
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("2", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("2", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p3", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg2", make(map[string]string), make(map[string]string)),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("3", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			},
+			Queues: []*schedulingv1.Queue{
+				util.BuildQueueWithDequeueStrategy("c1", 1, nil, schedulingv1.DequeueStrategyTraverse),
+			},
+			ExpectBindMap: map[string]string{
+				"c1/p3": "n1",
+			},
+			ExpectBindsNum: 1,
+		},
+		{
+			Name: "later job is blocked when the head job is unschedulable in fifo queue",
+			PodGroups: []*schedulingv1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "c1", 2, nil, schedulingv1.PodGroupInqueue),
+				util.BuildPodGroup("pg2", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				// the head job pg1 can not be allocated as a gang
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("2", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("2", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p3", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg2", make(map[string]string), make(map[string]string)),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("3", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			},
+			Queues: []*schedulingv1.Queue{
+				util.BuildQueueWithDequeueStrategy("c1", 1, nil, schedulingv1.DequeueStrategyFIFO),
+			},
+			ExpectBindMap:  map[string]string{},
+			ExpectBindsNum: 0,
+		},
 	}
 
 	trueValue := true
@@ -275,6 +323,141 @@ func TestAllocate(t *testing.T) {
 	}
 }
 
+func TestAllocateWithBackfillReservation(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		gang.PluginName:       gang.New,
+		proportion.PluginName: proportion.New,
+		predicates.PluginName: predicates.New,
+	}
+	estimate := func(d string) map[string]string {
+		return map[string]string{schedulingv1.JobRunningEstimate: d}
+	}
+
+	// n1 is occupied by pg-long until 2h later, n2 is half occupied by pg-short until 30m later,
+	// so the blocked gang job pg-big is reserved to start on n2 30m later.
+	podGroups := func(small *schedulingv1.PodGroup) []*schedulingv1.PodGroup {
+		return []*schedulingv1.PodGroup{
+			small,
+			util.BuildPodGroupWithAnno("pg-long", "c1", "c1", 1, nil, schedulingv1.PodGroupRunning, estimate("2h")),
+			util.BuildPodGroupWithAnno("pg-short", "c1", "c1", 1, nil, schedulingv1.PodGroupRunning, estimate("30m")),
+			util.BuildPodGroup("pg-big", "c1", "c1", 2, nil, schedulingv1.PodGroupInqueue),
+		}
+	}
+	pods := func(bigSelector map[string]string) []*v1.Pod {
+		return []*v1.Pod{
+			util.BuildPod("c1", "small", "", v1.PodPending, api.BuildResourceList("2", "2G"), "pg-small", make(map[string]string), make(map[string]string)),
+			util.BuildPod("c1", "long", "n1", v1.PodRunning, api.BuildResourceList("4", "4G"), "pg-long", make(map[string]string), make(map[string]string)),
+			util.BuildPod("c1", "short", "n2", v1.PodRunning, api.BuildResourceList("2", "2G"), "pg-short", make(map[string]string), make(map[string]string)),
+			util.BuildPod("c1", "big-1", "", v1.PodPending, api.BuildResourceList("2", "2G"), "pg-big", make(map[string]string), bigSelector),
+			util.BuildPod("c1", "big-2", "", v1.PodPending, api.BuildResourceList("2", "2G"), "pg-big", make(map[string]string), bigSelector),
+		}
+	}
+	nodes := []*v1.Node{
+		util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), map[string]string{"zone": "a"}),
+		util.BuildNode("n2", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+	}
+	reservationEnabled := []conf.Configuration{{Name: "backfill", Arguments: map[string]interface{}{conf.EnableBackfillReservationKey: true}}}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		configurations []conf.Configuration
+		reservedNodes  sets.Set[string]
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "small job ending before the reservation is backfilled on the reserved node",
+				PodGroups:      podGroups(util.BuildPodGroupWithAnno("pg-small", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue, estimate("10m"))),
+				Pods:           pods(make(map[string]string)),
+				Nodes:          nodes,
+				Queues:         []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)},
+				ExpectBindMap:  map[string]string{"c1/small": "n2"},
+				ExpectBindsNum: 1,
+			},
+			configurations: reservationEnabled,
+			reservedNodes:  sets.New("n2"),
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "small job ending after the reservation is not backfilled on the reserved node",
+				PodGroups:      podGroups(util.BuildPodGroupWithAnno("pg-small", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue, estimate("1h"))),
+				Pods:           pods(make(map[string]string)),
+				Nodes:          nodes,
+				Queues:         []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)},
+				ExpectBindMap:  map[string]string{},
+				ExpectBindsNum: 0,
+			},
+			configurations: reservationEnabled,
+			reservedNodes:  sets.New("n2"),
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "small job without running estimate is allocated when reservation is disabled",
+				PodGroups:      podGroups(util.BuildPodGroup("pg-small", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue)),
+				Pods:           pods(make(map[string]string)),
+				Nodes:          nodes,
+				Queues:         []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)},
+				ExpectBindMap:  map[string]string{"c1/small": "n2"},
+				ExpectBindsNum: 1,
+			},
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "nodes not matching the node selector of the blocked job are not reserved",
+				PodGroups:      podGroups(util.BuildPodGroupWithAnno("pg-small", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue, estimate("1h"))),
+				Pods:           pods(map[string]string{"zone": "a"}),
+				Nodes:          nodes,
+				Queues:         []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)},
+				ExpectBindMap:  map[string]string{"c1/small": "n2"},
+				ExpectBindsNum: 1,
+			},
+			configurations: reservationEnabled,
+			reservedNodes:  sets.New("n1"),
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:                gang.PluginName,
+					EnabledJobOrder:     &trueValue,
+					EnabledJobReady:     &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobStarving:  &trueValue,
+				},
+				{
+					Name:               proportion.PluginName,
+					EnabledQueueOrder:  &trueValue,
+					EnabledAllocatable: &trueValue,
+				},
+				{
+					Name:             predicates.PluginName,
+					EnabledPredicate: &trueValue,
+				},
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.Plugins = plugins
+			ssn := test.RegisterSession(tiers, test.configurations)
+			defer test.Close()
+			if test.reservedNodes != nil {
+				reservation := ssn.BackfillReservation()
+				if reservation == nil || !reservation.Nodes.Equal(test.reservedNodes) {
+					t.Fatalf("expected %v to be reserved for the blocked job, got %+v", sets.List(test.reservedNodes), reservation)
+				}
+			}
+			test.Run([]framework.Action{New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
 // BenchmarkAllocate can help analyze the performance differences before and after changes to the scheduling framework. Currently, it is hardcoded to schedule 1000 pods
 func BenchmarkAllocate(b *testing.B) {
 	plugins := map[string]framework.PluginBuilder{
@@ -5735,3 +5918,94 @@ func BenchmarkHyperNodeGradientFnPerformance(b *testing.B) {
 		testStruct.Close()
 	}
 }
+
+func TestAllocateWithTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracing.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer tracing.SetTracerProvider(nil)
+
+	test := uthelper.TestCommonStruct{
+		Name: "jobs attempted in allocate are traced with the time of the plugins",
+		Plugins: map[string]framework.PluginBuilder{
+			gang.PluginName:       gang.New,
+			predicates.PluginName: predicates.New,
+			nodeorder.PluginName:  nodeorder.New,
+		},
+		PodGroups: []*schedulingv1.PodGroup{
+			util.BuildPodGroup("pg-small", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue),
+			util.BuildPodGroup("pg-big", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue),
+		},
+		Pods: []*v1.Pod{
+			util.BuildPod("c1", "small", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg-small", make(map[string]string), make(map[string]string)),
+			util.BuildPod("c1", "big", "", v1.PodPending, api.BuildResourceList("8", "8G"), "pg-big", make(map[string]string), make(map[string]string)),
+		},
+		Nodes: []*v1.Node{
+			util.BuildNode("n1", api.BuildResourceList("2", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			util.BuildNode("n2", api.BuildResourceList("2", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+		},
+		Queues:           []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)},
+		ExpectBindsNum:   1,
+		MinimalBindCheck: true,
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:                gang.PluginName,
+					EnabledJobReady:     &trueValue,
+					EnabledJobPipelined: &trueValue,
+				},
+				{
+					Name:             predicates.PluginName,
+					EnabledPredicate: &trueValue,
+				},
+				{
+					Name:             nodeorder.PluginName,
+					EnabledNodeOrder: &trueValue,
+				},
+			},
+		},
+	}
+
+	ssn := test.RegisterSession(tiers, nil)
+	defer test.Close()
+	ctx, span := tracing.Tracer().Start(context.Background(), "action/allocate")
+	ssn.SetTraceContext(ctx)
+	test.Run([]framework.Action{New()})
+	span.End()
+	if err := test.CheckAll(0); err != nil {
+		t.Fatal(err)
+	}
+
+	outcomes := map[string]string{}
+	jobSpans := map[string]string{}
+	children := map[string][]string{}
+	for _, s := range recorder.Ended() {
+		attrs := map[string]string{}
+		for _, attr := range s.Attributes() {
+			attrs[string(attr.Key)] = attr.Value.Emit()
+		}
+		switch s.Name() {
+		case "job":
+			outcomes[attrs["job.name"]] = attrs["job.outcome"]
+			jobSpans[s.SpanContext().SpanID().String()] = attrs["job.name"]
+			if s.Parent().SpanID() != span.SpanContext().SpanID() {
+				t.Errorf("expected the span of job %s to be a child of the span of the action", attrs["job.name"])
+			}
+		case "predicate/predicates", "score/nodeorder":
+			if attrs["calls"] == "0" {
+				t.Errorf("expected span %s to record the calls of the plugin", s.Name())
+			}
+			children[s.Parent().SpanID().String()] = append(children[s.Parent().SpanID().String()], s.Name())
+		}
+	}
+
+	assert.Equal(t, map[string]string{"pg-small": "ready", "pg-big": "pending"}, outcomes)
+	for spanID, job := range jobSpans {
+		if job == "pg-small" {
+			assert.ElementsMatch(t, []string{"predicate/predicates", "score/nodeorder"}, children[spanID])
+		}
+	}
+}
@@ -110,9 +110,113 @@ func (backfill *Action) Execute(ssn *framework.Session) {
 
 		metrics.UpdateE2eSchedulingDurationByJob(job.Name, string(job.Queue), job.Namespace, metrics.Duration(job.CreationTimestamp.Time))
 		metrics.UpdateE2eSchedulingLastTimeByJob(job.Name, string(job.Queue), job.Namespace, time.Now())
+	}
+
+	// The BestEffort tasks above take no resources, so they never delay the reservation. The other jobs are
+	// backfilled only while a reservation is planned for the blocked gang job, on the nodes it permits.
+	if reservation := ssn.BackfillReservation(); reservation != nil {
+		backfill.backfillJobs(ssn, reservation)
+	}
+}
+
+// backfillJobs places the pending tasks of the jobs other than the blocked gang job around its reservation: a task is
+// placed on a reserved node only if its job is estimated to end before the reservation starts, which is checked by
+// PredicateForAllocateAction. The tasks of a job are committed only if the job gets ready as a whole.
+func (backfill *Action) backfillJobs(ssn *framework.Session, reservation *api.BackfillReservation) {
+	predicateFunc := func(task *api.TaskInfo, node *api.NodeInfo) error {
+		if ok, resources := task.InitResreq.LessEqualWithResourcesName(node.Idle, api.Zero); !ok {
+			return api.NewFitErrWithStatus(task, node, &api.Status{Code: api.Unschedulable, Reason: api.WrapInsufficientResourceReason(resources)})
+		}
+		return ssn.PredicateForAllocateAction(task, node)
+	}
+
+	for _, job := range backfill.pickUpBackfillJobs(ssn, reservation) {
+		queue := ssn.Queues[job.Queue]
+		tasks := util.NewPriorityQueue(ssn.TaskOrderFn)
+		for _, task := range job.TaskStatusIndex[api.Pending] {
+			if !task.BestEffort && !task.SchGated {
+				tasks.Push(task)
+			}
+		}
+
+		stmt := framework.NewStatement(ssn)
+		for !tasks.Empty() && !ssn.JobReady(job) {
+			task := tasks.Pop().(*api.TaskInfo)
+			if !ssn.Allocatable(queue, task) {
+				klog.V(3).Infof("Queue <%s> is overused when backfilling task <%s/%s>, ignore it.", queue.Name, task.Namespace, task.Name)
+				continue
+			}
+			if err := ssn.PrePredicateFn(task); err != nil {
+				klog.V(3).Infof("PrePredicate for task %s/%s failed in backfill for: %v", task.Namespace, task.Name, err)
+				continue
+			}
+
+			ph := util.NewPredicateHelper()
+			predicateNodes, fitErrors := ph.PredicateNodes(task, ssn.NodeList, predicateFunc, backfill.enablePredicateErrorCache, ssn.NodesInShard)
+			if len(predicateNodes) == 0 {
+				job.NodesFitErrors[task.UID] = fitErrors
+				continue
+			}
+
+			nodeScores := util.PrioritizeNodes(task, predicateNodes, ssn.BatchNodeOrderFn, ssn.NodeOrderMapFn, ssn.NodeOrderReduceFn)
+			node := ssn.BestNodeFn(task, nodeScores)
+			if node == nil {
+				node, _ = util.SelectBestNodeAndScore(nodeScores)
+			}
+			if node == nil {
+				continue
+			}
+
+			klog.V(3).Infof("Backfilling Task <%v/%v> to node <%v>", task.Namespace, task.Name, node.Name)
+			if err := stmt.Allocate(task, node); err != nil {
+				klog.Errorf("Failed to backfill Task %v on %v in Session %v: %v", task.UID, node.Name, ssn.UID, err)
+				if rollbackErr := stmt.UnAllocate(task); rollbackErr != nil {
+					klog.Errorf("Failed to unallocate Task %v on %v in Session %v for %v.", task.UID, node.Name, ssn.UID, rollbackErr)
+				}
+			}
+		}
 
-		// TODO (k82cn): backfill for other case.
+		if !ssn.JobReady(job) {
+			stmt.Discard()
+			continue
+		}
+		stmt.Commit()
+		metrics.UpdateE2eSchedulingDurationByJob(job.Name, string(job.Queue), job.Namespace, metrics.Duration(job.CreationTimestamp.Time))
+		metrics.UpdateE2eSchedulingLastTimeByJob(job.Name, string(job.Queue), job.Namespace, time.Now())
+	}
+}
+
+// pickUpBackfillJobs returns the jobs with pending tasks to backfill around the reservation, in the order of their
+// queues and of the jobs in each queue.
+func (backfill *Action) pickUpBackfillJobs(ssn *framework.Session, reservation *api.BackfillReservation) []*api.JobInfo {
+	queues := util.NewPriorityQueue(ssn.QueueOrderFn)
+	jobs := map[api.QueueID]*util.PriorityQueue{}
+	for _, job := range ssn.Jobs {
+		if job.UID == reservation.Job || job.IsPending() || !job.HasPendingTasks() {
+			continue
+		}
+		if vr := ssn.JobValid(job); vr != nil && !vr.Pass {
+			continue
+		}
+		queue, found := ssn.Queues[job.Queue]
+		if !found {
+			continue
+		}
+		if _, existed := jobs[queue.UID]; !existed {
+			queues.Push(queue)
+			jobs[queue.UID] = util.NewPriorityQueue(ssn.JobOrderFn)
+		}
+		jobs[queue.UID].Push(job)
+	}
+
+	var backfillJobs []*api.JobInfo
+	for !queues.Empty() {
+		queue := queues.Pop().(*api.QueueInfo)
+		for !jobs[queue.UID].Empty() {
+			backfillJobs = append(backfillJobs, jobs[queue.UID].Pop().(*api.JobInfo))
+		}
 	}
+	return backfillJobs
 }
 
 func (backfill *Action) UnInitialize() {}
@@ -32,7 +32,11 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/plugins/drf"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/predicates"
 	"volcano.sh/volcano/pkg/scheduler/plugins/priority"
+	"volcano.sh/volcano/pkg/scheduler/plugins/proportion"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
 	"volcano.sh/volcano/pkg/scheduler/util"
 )
 
@@ -176,3 +180,107 @@ func TestPickUpPendingTasks(t *testing.T) {
 		}
 	}
 }
+
+func TestBackfillWithReservation(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		gang.PluginName:       gang.New,
+		proportion.PluginName: proportion.New,
+		predicates.PluginName: predicates.New,
+	}
+	estimate := func(d string) map[string]string {
+		return map[string]string{schedulingv1beta1.JobRunningEstimate: d}
+	}
+
+	// n1 is occupied by pg-long until 2h later, n2 is half occupied by pg-short until 30m later,
+	// so the blocked gang job pg-big is reserved to start on n2 30m later.
+	podGroups := func(small *schedulingv1beta1.PodGroup) []*schedulingv1beta1.PodGroup {
+		return []*schedulingv1beta1.PodGroup{
+			small,
+			util.BuildPodGroupWithAnno("pg-long", "c1", "c1", 1, nil, schedulingv1beta1.PodGroupRunning, estimate("2h")),
+			util.BuildPodGroupWithAnno("pg-short", "c1", "c1", 1, nil, schedulingv1beta1.PodGroupRunning, estimate("30m")),
+			util.BuildPodGroup("pg-big", "c1", "c1", 2, nil, schedulingv1beta1.PodGroupInqueue),
+		}
+	}
+	pods := func(small ...*v1.Pod) []*v1.Pod {
+		return append([]*v1.Pod{
+			util.BuildPod("c1", "long", "n1", v1.PodRunning, api.BuildResourceList("4", "4G"), "pg-long", make(map[string]string), make(map[string]string)),
+			util.BuildPod("c1", "short", "n2", v1.PodRunning, api.BuildResourceList("2", "2G"), "pg-short", make(map[string]string), make(map[string]string)),
+			util.BuildPod("c1", "big-1", "", v1.PodPending, api.BuildResourceList("2", "2G"), "pg-big", make(map[string]string), make(map[string]string)),
+			util.BuildPod("c1", "big-2", "", v1.PodPending, api.BuildResourceList("2", "2G"), "pg-big", make(map[string]string), make(map[string]string)),
+		}, small...)
+	}
+	smallPod := func(name string) *v1.Pod {
+		return util.BuildPod("c1", name, "", v1.PodPending, api.BuildResourceList("2", "2G"), "pg-small", make(map[string]string), make(map[string]string))
+	}
+	nodes := []*v1.Node{
+		util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+		util.BuildNode("n2", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+	}
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:           "small job ending before the reservation is backfilled on the reserved node",
+			PodGroups:      podGroups(util.BuildPodGroupWithAnno("pg-small", "c1", "c1", 1, nil, schedulingv1beta1.PodGroupInqueue, estimate("10m"))),
+			Pods:           pods(smallPod("small")),
+			Nodes:          nodes,
+			Queues:         []*schedulingv1beta1.Queue{util.BuildQueue("c1", 1, nil)},
+			ExpectBindMap:  map[string]string{"c1/small": "n2"},
+			ExpectBindsNum: 1,
+		},
+		{
+			Name:           "small job ending after the reservation is not backfilled",
+			PodGroups:      podGroups(util.BuildPodGroupWithAnno("pg-small", "c1", "c1", 1, nil, schedulingv1beta1.PodGroupInqueue, estimate("1h"))),
+			Pods:           pods(smallPod("small")),
+			Nodes:          nodes,
+			Queues:         []*schedulingv1beta1.Queue{util.BuildQueue("c1", 1, nil)},
+			ExpectBindMap:  map[string]string{},
+			ExpectBindsNum: 0,
+		},
+		{
+			Name:           "gang job not fitting as a whole is not backfilled",
+			PodGroups:      podGroups(util.BuildPodGroupWithAnno("pg-small", "c1", "c1", 2, nil, schedulingv1beta1.PodGroupInqueue, estimate("10m"))),
+			Pods:           pods(smallPod("small-1"), smallPod("small-2")),
+			Nodes:          nodes,
+			Queues:         []*schedulingv1beta1.Queue{util.BuildQueue("c1", 1, nil)},
+			ExpectBindMap:  map[string]string{},
+			ExpectBindsNum: 0,
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:                gang.PluginName,
+					EnabledJobOrder:     &trueValue,
+					EnabledJobReady:     &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobStarving:  &trueValue,
+				},
+				{
+					Name:               proportion.PluginName,
+					EnabledQueueOrder:  &trueValue,
+					EnabledAllocatable: &trueValue,
+				},
+				{
+					Name:             predicates.PluginName,
+					EnabledPredicate: &trueValue,
+				},
+			},
+		},
+	}
+	configurations := []conf.Configuration{{Name: "backfill", Arguments: map[string]interface{}{conf.EnableBackfillReservationKey: true}}}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.Plugins = plugins
+			test.RegisterSession(tiers, configurations)
+			defer test.Close()
+			test.Run([]framework.Action{New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -96,6 +96,10 @@ func (enqueue *Action) Execute(ssn *framework.Session) {
 			ssn.JobEnqueued(job)
 			job.PodGroup.Status.Phase = scheduling.PodGroupInqueue
 			ssn.Jobs[job.UID] = job
+		} else if queue.DequeueStrategy == scheduling.DequeueStrategyFIFO {
+			// The jobs behind the head job can not leapfrog it in a fifo queue.
+			ssn.BlockQueue(queue, job, jobs, enqueue.Name())
+			continue
 		}
 
 		// Added Queue back until no job in Queue.
@@ -121,6 +121,44 @@ func TestEnqueue(t *testing.T) {
 				"c1/pg1": scheduling.PodGroupPending,
 			},
 		},
+		{
+			Name: "later podgroup can enqueue when the head podgroup is blocked in traverse queue",
+			PodGroups: []*schedulingv1.PodGroup{
+				util.BuildPodGroupWithMinResources("pg1", "c1", "c1", 1,
+					nil, api.BuildResourceList("8", "8G"), schedulingv1.PodGroupPending),
+				util.BuildPodGroupWithMinResources("pg2", "c1", "c1", 1,
+					nil, api.BuildResourceList("1", "1G"), schedulingv1.PodGroupPending),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			},
+			Queues: []*schedulingv1.Queue{
+				util.BuildQueueWithDequeueStrategy("c1", 1, api.BuildResourceList("4", "4G"), schedulingv1.DequeueStrategyTraverse),
+			},
+			ExpectStatus: map[api.JobID]scheduling.PodGroupPhase{
+				"c1/pg1": scheduling.PodGroupPending,
+				"c1/pg2": scheduling.PodGroupInqueue,
+			},
+		},
+		{
+			Name: "later podgroup can not enqueue when the head podgroup is blocked in fifo queue",
+			PodGroups: []*schedulingv1.PodGroup{
+				util.BuildPodGroupWithMinResources("pg1", "c1", "c1", 1,
+					nil, api.BuildResourceList("8", "8G"), schedulingv1.PodGroupPending),
+				util.BuildPodGroupWithMinResources("pg2", "c1", "c1", 1,
+					nil, api.BuildResourceList("1", "1G"), schedulingv1.PodGroupPending),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			},
+			Queues: []*schedulingv1.Queue{
+				util.BuildQueueWithDequeueStrategy("c1", 1, api.BuildResourceList("4", "4G"), schedulingv1.DequeueStrategyFIFO),
+			},
+			ExpectStatus: map[api.JobID]scheduling.PodGroupPhase{
+				"c1/pg1": scheduling.PodGroupPending,
+				"c1/pg2": scheduling.PodGroupPending,
+			},
+		},
 	}
 
 	trueValue := true
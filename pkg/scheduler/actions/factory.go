@@ -26,6 +26,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/actions/enqueue"
 	"volcano.sh/volcano/pkg/scheduler/actions/preempt"
 	"volcano.sh/volcano/pkg/scheduler/actions/reclaim"
+	"volcano.sh/volcano/pkg/scheduler/actions/reserve"
 	"volcano.sh/volcano/pkg/scheduler/actions/shuffle"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 )
@@ -37,4 +38,5 @@ func init() {
 	framework.RegisterAction(preempt.New())
 	framework.RegisterAction(enqueue.New())
 	framework.RegisterAction(shuffle.New())
+	framework.RegisterAction(reserve.New())
 }
@@ -66,6 +66,8 @@ type Action struct {
 
 	enableTopologyAwarePreemption bool
 
+	enableGangPreemption bool
+
 	topologyAwarePreemptWorkerNum int
 	minCandidateNodesPercentage   int
 	minCandidateNodesAbsolute     int
@@ -93,6 +95,7 @@ func (pmpt *Action) parseArguments(ssn *framework.Session) {
 	arguments := framework.GetArgOfActionFromConf(ssn.Configurations, pmpt.Name())
 	arguments.GetBool(&pmpt.enablePredicateErrorCache, conf.EnablePredicateErrCacheKey)
 	arguments.GetBool(&pmpt.enableTopologyAwarePreemption, EnableTopologyAwarePreemptionKey)
+	arguments.GetBool(&pmpt.enableGangPreemption, conf.EnableGangPreemptionKey)
 	arguments.GetInt(&pmpt.topologyAwarePreemptWorkerNum, TopologyAwarePreemptWorkerNumKey)
 	arguments.GetInt(&pmpt.minCandidateNodesPercentage, MinCandidateNodesPercentageKey)
 	arguments.GetInt(&pmpt.minCandidateNodesAbsolute, MinCandidateNodesAbsoluteKey)
@@ -105,6 +108,10 @@ func (pmpt *Action) Execute(ssn *framework.Session) {
 	defer klog.V(5).Infof("Leaving Preempt ...")
 
 	pmpt.parseArguments(ssn)
+	ssn.SetEvictionPolicy(framework.NewEvictionPolicy(pmpt.Name(), ssn.Configurations))
+	defer ssn.SetEvictionPolicy(nil)
+	ssn.SetGangPreemption(pmpt.enableGangPreemption)
+	defer ssn.SetGangPreemption(false)
 
 	preemptorsMap := map[api.QueueID]*util.PriorityQueue{}
 	preemptorTasks := map[api.JobID]*util.PriorityQueue{}
@@ -178,6 +185,7 @@ func (pmpt *Action) Execute(ssn *framework.Session) {
 			}
 
 			preemptorJob := preemptors.Pop().(*api.JobInfo)
+			jobSpan := ssn.TraceJob(preemptorJob)
 
 			stmt := framework.NewStatement(ssn)
 			var assigned bool
@@ -221,6 +229,8 @@ func (pmpt *Action) Execute(ssn *framework.Session) {
 				}
 			}
 
+			jobSpan.End()
+
 			// Commit changes only if job is pipelined, otherwise try next job.
 			if ssn.JobPipelined(preemptorJob) {
 				stmt.Commit()
@@ -352,51 +362,72 @@ func (pmpt *Action) normalPreempt(
 		klog.V(3).Infof("Considering Task <%s/%s> on Node <%s>.",
 			preemptor.Namespace, preemptor.Name, node.Name)
 
-		var preemptees []*api.TaskInfo
-		for _, task := range node.Tasks {
-			if filter == nil {
-				preemptees = append(preemptees, task.Clone())
-			} else if filter(task) {
-				preemptees = append(preemptees, task.Clone())
-			}
-		}
-		victims := ssn.Preemptable(preemptor, preemptees)
-		metrics.UpdatePreemptionVictimsCount(len(victims))
-
-		if err := util.ValidateVictims(preemptor, node, victims); err != nil {
-			klog.V(3).Infof("No validated victims on Node <%s>: %v", node.Name, err)
-			continue
-		}
-
 		// Use a temporary statement per node attempt so that eviction operations
 		// are isolated. On success the operations are merged into the caller's
 		// statement; on failure they are discarded, so evictions are only committed
 		// when preemption succeeds.
 		nodeStmt := framework.NewStatement(ssn)
-
-		victimsQueue := ssn.BuildVictimsPriorityQueue(victims, preemptor)
-		// Preempt victims for tasks, pick lowest priority task first.
 		preempted := api.EmptyResource()
 
-		for !victimsQueue.Empty() {
-			// If reclaimed enough resources, break loop to avoid Sub panic.
-			// Preempt action is about preempt in same queue, which job is not allocatable in allocate action, due to:
-			// 1. cluster has free resource, but queue not allocatable
-			// 2. cluster has no free resource, but queue not allocatable
-			// 3. cluster has no free resource, but queue allocatable
-			// for case 1 and 2, high priority job/task can preempt low priority job/task in same queue;
-			// for case 3, it need to do reclaim resource from other queue, in reclaim action;
-			// so if current queue is not allocatable(the queue will be overused when consider current preemptor's requests)
-			// or current idle resource is not enough for preemptor, it need to continue preempting
-			// otherwise, break out
-			if ssn.Allocatable(currentQueue, preemptor) && preemptor.InitResreq.LessEqual(node.FutureIdle(), api.Zero) {
-				break
+		if pmpt.enableGangPreemption {
+			// Evict the victim set of the jobs chosen at job level all together, or none of them.
+			victims, err := ssn.GangVictims(preemptor, node, filter, ssn.Preemptable)
+			if err != nil {
+				klog.V(3).Infof("No gang victims on Node <%s>: %v", node.Name, err)
+				continue
+			}
+			metrics.UpdatePreemptionVictimsCount(len(victims))
+			if !ssn.EvictionAllowed(victims...) {
+				continue
+			}
+			for _, preemptee := range victims {
+				klog.V(3).Infof("Try to preempt Task <%s/%s> for Task <%s/%s>",
+					preemptee.Namespace, preemptee.Name, preemptor.Namespace, preemptor.Name)
+				nodeStmt.Evict(preemptee, "preempt")
+				preempted.Add(preemptee.Resreq)
+			}
+		} else {
+			var preemptees []*api.TaskInfo
+			for _, task := range node.Tasks {
+				if filter == nil {
+					preemptees = append(preemptees, task.Clone())
+				} else if filter(task) {
+					preemptees = append(preemptees, task.Clone())
+				}
+			}
+			victims := ssn.Preemptable(preemptor, preemptees)
+			metrics.UpdatePreemptionVictimsCount(len(victims))
+
+			if err := util.ValidateVictims(preemptor, node, victims); err != nil {
+				klog.V(3).Infof("No validated victims on Node <%s>: %v", node.Name, err)
+				continue
+			}
+
+			victimsQueue := ssn.BuildVictimsPriorityQueue(victims, preemptor)
+			// Preempt victims for tasks, pick lowest priority task first.
+			for !victimsQueue.Empty() {
+				// If reclaimed enough resources, break loop to avoid Sub panic.
+				// Preempt action is about preempt in same queue, which job is not allocatable in allocate action, due to:
+				// 1. cluster has free resource, but queue not allocatable
+				// 2. cluster has no free resource, but queue not allocatable
+				// 3. cluster has no free resource, but queue allocatable
+				// for case 1 and 2, high priority job/task can preempt low priority job/task in same queue;
+				// for case 3, it need to do reclaim resource from other queue, in reclaim action;
+				// so if current queue is not allocatable(the queue will be overused when consider current preemptor's requests)
+				// or current idle resource is not enough for preemptor, it need to continue preempting
+				// otherwise, break out
+				if ssn.Allocatable(currentQueue, preemptor) && preemptor.InitResreq.LessEqual(node.FutureIdle(), api.Zero) {
+					break
+				}
+				preemptee := victimsQueue.Pop().(*api.TaskInfo)
+				if !ssn.EvictionAllowed(preemptee) {
+					continue
+				}
+				klog.V(3).Infof("Try to preempt Task <%s/%s> for Task <%s/%s>",
+					preemptee.Namespace, preemptee.Name, preemptor.Namespace, preemptor.Name)
+				nodeStmt.Evict(preemptee, "preempt")
+				preempted.Add(preemptee.Resreq)
 			}
-			preemptee := victimsQueue.Pop().(*api.TaskInfo)
-			klog.V(3).Infof("Try to preempt Task <%s/%s> for Task <%s/%s>",
-				preemptee.Namespace, preemptee.Name, preemptor.Namespace, preemptor.Name)
-			nodeStmt.Evict(preemptee, "preempt")
-			preempted.Add(preemptee.Resreq)
 		}
 
 		evictionOccurred := false
@@ -499,6 +530,10 @@ func (pmpt *Action) topologyAwarePreempt(
 		return false, fmt.Errorf("no candidate node for preemption")
 	}
 
+	if !ssn.EvictionAllowed(bestCandidate.Victims()...) {
+		return false, fmt.Errorf("eviction budget is exhausted for the victims on node %s", bestCandidate.Name())
+	}
+
 	// Use a temporary statement so that eviction side effects are only applied
 	// after the entire preemption attempt (evictions + pipeline) succeeds.
 	tmpStmt := framework.NewStatement(ssn)
@@ -740,6 +775,30 @@ func SelectVictimsOnNode(
 		return nil
 	}
 
+	// In gang preemption, the victim set is chosen at job level and can not be reprieved task by task.
+	if ssn.GangPreemption() {
+		victims, err := ssn.GangVictims(preemptor, nodeInfo, filter, ssn.Preemptable)
+		if err != nil {
+			return nil, api.AsStatus(err)
+		}
+		metrics.UpdatePreemptionVictimsCount(len(victims))
+		for _, victim := range victims {
+			if victim.NodeName != nodeInfo.Name {
+				continue
+			}
+			if err := removeTask(victim); err != nil {
+				return nil, api.AsStatus(err)
+			}
+		}
+		if !ssn.SimulateAllocatableFn(ctx, state, currentQueue, preemptor) {
+			return nil, api.AsStatus(fmt.Errorf("queue <%s> is not allocatable for pod %s/%s after preemption", currentQueue.Name, preemptor.Namespace, preemptor.Name))
+		}
+		if err := ssn.SimulatePredicateFn(ctx, state, preemptor, nodeInfo); err != nil {
+			return nil, api.AsStatus(fmt.Errorf("failed to predicate pod %s/%s on node %s: %v", preemptor.Namespace, preemptor.Name, nodeInfo.Name, err))
+		}
+		return victims, &api.Status{}
+	}
+
 	var preemptees []*api.TaskInfo
 	for _, task := range nodeInfo.Tasks {
 		if filter == nil {
@@ -24,6 +24,7 @@ package preempt
 import (
 	"flag"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
@@ -764,3 +765,149 @@ func buildPodWithPodAntiAffinity(name, namespace, node string, phase v1.PodPhase
 
 	return pod
 }
+
+func TestGangPreempt(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		conformance.PluginName: conformance.New,
+		gang.PluginName:        gang.New,
+		priority.PluginName:    priority.New,
+		proportion.PluginName:  proportion.New,
+	}
+	highPrio := util.BuildPriorityClass("high-priority", 100000)
+	lowPrio := util.BuildPriorityClass("low-priority", 10)
+
+	buildPod := func(name, nodeName, cpu, pg string, runtime time.Duration) *v1.Pod {
+		phase := v1.PodRunning
+		if nodeName == "" {
+			phase = v1.PodPending
+		}
+		pod := util.BuildPod("c1", name, nodeName, phase, api.BuildResourceList(cpu, "1G"), pg, make(map[string]string), make(map[string]string))
+		if nodeName != "" {
+			pod.Status.StartTime = &metav1.Time{Time: time.Now().Add(-runtime)}
+		}
+		return pod
+	}
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name: "evict the whole victim job rather than breaking another one below its gang minimum",
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroupWithPrio("pg-gang", "c1", "q1", 2, nil, schedulingv1beta1.PodGroupRunning, "low-priority"),
+				util.BuildPodGroupWithPrio("pg-elastic", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning, "low-priority"),
+				util.BuildPodGroupWithPrio("pg-other", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning, "high-priority"),
+				util.BuildPodGroupWithPrio("pg-high", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue, "high-priority"),
+			},
+			Pods: []*v1.Pod{
+				buildPod("gang-1", "n1", "1", "pg-gang", time.Hour),
+				buildPod("gang-2", "n2", "1", "pg-gang", time.Hour),
+				buildPod("elastic-1", "n1", "1", "pg-elastic", time.Hour),
+				buildPod("elastic-2", "n1", "1", "pg-elastic", time.Hour),
+				buildPod("other", "n1", "1", "pg-other", time.Hour),
+				buildPod("preemptor", "", "2", "pg-high", 0),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("4", "8G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+				util.BuildNode("n2", api.BuildResourceList("1", "8G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("q1", 1, nil),
+			},
+			ExpectEvictNum: 2,
+			ExpectEvicted:  []string{"c1/elastic-1", "c1/elastic-2"},
+		},
+		{
+			Name: "evict the tasks of the victim job on the other nodes as well",
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroupWithPrio("pg-gang", "c1", "q1", 2, nil, schedulingv1beta1.PodGroupRunning, "low-priority"),
+				util.BuildPodGroupWithPrio("pg-elastic", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning, "low-priority"),
+				util.BuildPodGroupWithPrio("pg-other", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupRunning, "high-priority"),
+				util.BuildPodGroupWithPrio("pg-high", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue, "high-priority"),
+			},
+			Pods: []*v1.Pod{
+				buildPod("gang-1", "n1", "2", "pg-gang", time.Hour),
+				buildPod("gang-2", "n2", "2", "pg-gang", time.Hour),
+				buildPod("elastic-1", "n1", "1", "pg-elastic", time.Hour),
+				buildPod("other", "n1", "1", "pg-other", time.Hour),
+				buildPod("preemptor", "", "2", "pg-high", 0),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("4", "8G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+				util.BuildNode("n2", api.BuildResourceList("2", "8G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("q1", 1, nil),
+			},
+			ExpectEvictNum: 2,
+			ExpectEvicted:  []string{"c1/gang-1", "c1/gang-2"},
+		},
+		{
+			Name: "evict the victim job losing the least runtime",
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				util.BuildPodGroupWithPrio("pg-old", "c1", "q1", 2, nil, schedulingv1beta1.PodGroupRunning, "low-priority"),
+				util.BuildPodGroupWithPrio("pg-new", "c1", "q1", 2, nil, schedulingv1beta1.PodGroupRunning, "low-priority"),
+				util.BuildPodGroupWithPrio("pg-high", "c1", "q1", 1, nil, schedulingv1beta1.PodGroupInqueue, "high-priority"),
+			},
+			Pods: []*v1.Pod{
+				buildPod("old-1", "n1", "1", "pg-old", 2*time.Hour),
+				buildPod("old-2", "n1", "1", "pg-old", 2*time.Hour),
+				buildPod("new-1", "n1", "1", "pg-new", time.Minute),
+				buildPod("new-2", "n1", "1", "pg-new", time.Minute),
+				buildPod("preemptor", "", "2", "pg-high", 0),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("4", "8G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("q1", 1, nil),
+			},
+			ExpectEvictNum: 2,
+			ExpectEvicted:  []string{"c1/new-1", "c1/new-2"},
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:               conformance.PluginName,
+					EnabledPreemptable: &trueValue,
+				},
+				{
+					Name:                gang.PluginName,
+					EnabledPreemptable:  &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobStarving:  &trueValue,
+				},
+				{
+					Name:                priority.PluginName,
+					EnabledTaskOrder:    &trueValue,
+					EnabledJobOrder:     &trueValue,
+					EnabledPreemptable:  &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobStarving:  &trueValue,
+				},
+				{
+					Name:               proportion.PluginName,
+					EnabledOverused:    &trueValue,
+					EnabledAllocatable: &trueValue,
+					EnabledQueueOrder:  &trueValue,
+				},
+			},
+		}}
+
+	actions := []framework.Action{New()}
+	for i, test := range tests {
+		test.Plugins = plugins
+		test.PriClass = []*schedulingv1.PriorityClass{highPrio, lowPrio}
+		t.Run(test.Name, func(t *testing.T) {
+			test.RegisterSession(tiers, []conf.Configuration{{Name: actions[0].Name(),
+				Arguments: map[string]interface{}{conf.EnableGangPreemptionKey: true}}})
+			defer test.Close()
+			test.Run(actions)
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
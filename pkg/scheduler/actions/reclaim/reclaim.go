@@ -34,6 +34,8 @@ import (
 
 type Action struct {
 	enablePredicateErrorCache bool
+
+	enableGangPreemption bool
 }
 
 func New() *Action {
@@ -51,6 +53,7 @@ func (ra *Action) Initialize() {}
 func (ra *Action) parseArguments(ssn *framework.Session) {
 	arguments := framework.GetArgOfActionFromConf(ssn.Configurations, ra.Name())
 	arguments.GetBool(&ra.enablePredicateErrorCache, conf.EnablePredicateErrCacheKey)
+	arguments.GetBool(&ra.enableGangPreemption, conf.EnableGangPreemptionKey)
 }
 
 func (ra *Action) Execute(ssn *framework.Session) {
@@ -58,6 +61,10 @@ func (ra *Action) Execute(ssn *framework.Session) {
 	defer klog.V(5).Infof("Leaving Reclaim ...")
 
 	ra.parseArguments(ssn)
+	ssn.SetEvictionPolicy(framework.NewEvictionPolicy(ra.Name(), ssn.Configurations))
+	defer ssn.SetEvictionPolicy(nil)
+	ssn.SetGangPreemption(ra.enableGangPreemption)
+	defer ssn.SetGangPreemption(false)
 
 	queues := util.NewPriorityQueue(ssn.QueueOrderFn)
 	queueMap := map[api.QueueID]*api.QueueInfo{}
@@ -121,6 +128,7 @@ func (ra *Action) Execute(ssn *framework.Session) {
 				break
 			}
 			job := jobsQ.Pop().(*api.JobInfo)
+			jobSpan := ssn.TraceJob(job)
 			stmt := framework.NewStatement(ssn)
 
 			for {
@@ -158,6 +166,7 @@ func (ra *Action) Execute(ssn *framework.Session) {
 
 				ra.reclaimForTask(ssn, stmt, task, job)
 			}
+			jobSpan.End()
 
 			if ssn.JobPipelined(job) {
 				stmt.Commit()
@@ -181,22 +190,30 @@ func (ra *Action) reclaimForTask(ssn *framework.Session, stmt *framework.Stateme
 	for _, nodes := range predicateNodesByShard {
 		predicateNodesByShardFlattened = append(predicateNodesByShardFlattened, nodes...)
 	}
+	// filter picks the running tasks of the other reclaimable queues as reclaimees.
+	filter := func(taskOnNode *api.TaskInfo) bool {
+		if taskOnNode.Status != api.Running || !taskOnNode.Preemptable {
+			return false
+		}
+		j, found := ssn.Jobs[taskOnNode.Job]
+		if !found || j.Queue == job.Queue {
+			return false
+		}
+		return ssn.Queues[j.Queue].Reclaimable()
+	}
 	for _, n := range predicateNodesByShardFlattened {
 		klog.V(3).Infof("Considering Task <%s/%s> on Node <%s>.", task.Namespace, task.Name, n.Name)
 
-		var reclaimees []*api.TaskInfo
-		for _, taskOnNode := range n.Tasks {
-			if taskOnNode.Status != api.Running || !taskOnNode.Preemptable {
-				continue
+		if ra.enableGangPreemption {
+			if ra.reclaimGangVictims(ssn, stmt, task, n, filter) {
+				break
 			}
+			continue
+		}
 
-			if j, found := ssn.Jobs[taskOnNode.Job]; !found {
-				continue
-			} else if j.Queue != job.Queue {
-				q := ssn.Queues[j.Queue]
-				if !q.Reclaimable() {
-					continue
-				}
+		var reclaimees []*api.TaskInfo
+		for _, taskOnNode := range n.Tasks {
+			if filter(taskOnNode) {
 				reclaimees = append(reclaimees, taskOnNode.Clone())
 			}
 		}
@@ -229,6 +246,9 @@ func (ra *Action) reclaimForTask(ssn *framework.Session, stmt *framework.Stateme
 				break
 			}
 			reclaimee := victimsQueue.Pop().(*api.TaskInfo)
+			if !ssn.EvictionAllowed(reclaimee) {
+				continue
+			}
 			klog.V(3).Infof("Try to reclaim Task <%s/%s> for Tasks <%s/%s>",
 				reclaimee.Namespace, reclaimee.Name, task.Namespace, task.Name)
 			nodeStmt.Evict(reclaimee, "reclaim")
@@ -257,5 +277,42 @@ func (ra *Action) reclaimForTask(ssn *framework.Session, stmt *framework.Stateme
 	}
 }
 
+// reclaimGangVictims reclaims the victims chosen at job level on the node for the task, the victims are evicted only if
+// the task is pipelined onto the node then.
+func (ra *Action) reclaimGangVictims(ssn *framework.Session, stmt *framework.Statement, task *api.TaskInfo, n *api.NodeInfo, filter func(*api.TaskInfo) bool) bool {
+	victims, err := ssn.GangVictims(task, n, filter, ssn.Reclaimable)
+	if err != nil {
+		klog.V(3).Infof("No gang victims on Node <%s>: %v", n.Name, err)
+		return false
+	}
+	if !ssn.EvictionAllowed(victims...) {
+		return false
+	}
+
+	nodeStmt := framework.NewStatement(ssn)
+	for _, reclaimee := range victims {
+		klog.V(3).Infof("Try to reclaim Task <%s/%s> for Tasks <%s/%s>",
+			reclaimee.Namespace, reclaimee.Name, task.Namespace, task.Name)
+		nodeStmt.Evict(reclaimee, "reclaim")
+	}
+
+	if !task.InitResreq.LessEqual(n.FutureIdle(), api.Zero) {
+		nodeStmt.Discard()
+		return false
+	}
+	if err := nodeStmt.Pipeline(task, n.Name, len(victims) > 0); err != nil {
+		klog.Errorf("Failed to pipeline Task <%s/%s> on Node <%s>",
+			task.Namespace, task.Name, n.Name)
+		if rollbackErr := nodeStmt.UnPipeline(task); rollbackErr != nil {
+			klog.Errorf("Failed to unpipeline Task %v on %v in Session %v for %v.",
+				task.UID, n.Name, ssn.UID, rollbackErr)
+		}
+		nodeStmt.Discard()
+		return false
+	}
+	stmt.Merge(nodeStmt)
+	return true
+}
+
 func (ra *Action) UnInitialize() {
 }
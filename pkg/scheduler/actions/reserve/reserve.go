@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reserve
+
+import (
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+// Action elects the target job among the pending jobs and locks nodes for it by the plugins, the locked nodes can
+// only be allocated to the target job until it is ready or the reservation times out.
+type Action struct{}
+
+func New() *Action {
+	return &Action{}
+}
+
+func (reserve *Action) Name() string {
+	return "reserve"
+}
+
+func (reserve *Action) Initialize() {}
+
+func (reserve *Action) Execute(ssn *framework.Session) {
+	klog.V(5).Infof("Enter Reserve ...")
+	defer klog.V(5).Infof("Leaving Reserve ...")
+
+	now := time.Now()
+	if targetJob := util.Reservation.TargetJob(); targetJob != "" {
+		job, found := ssn.Jobs[targetJob]
+		if !found {
+			klog.V(3).Infof("Target job <%s> has been deleted, release the locked nodes.", targetJob)
+			util.Reservation.Release(false, now)
+		} else if ssn.JobReady(job) || !job.HasPendingTasks() {
+			klog.V(3).Infof("Target job <%s/%s> has been scheduled, release the locked nodes.", job.Namespace, job.Name)
+			util.Reservation.Release(false, now)
+		}
+	}
+
+	// the plugins may release the reservation of the current target job on timeout, elect another one then
+	if util.Reservation.TargetJob() != "" {
+		ssn.ReservedNodes()
+	}
+	if util.Reservation.TargetJob() == "" {
+		if job := ssn.TargetJob(reserve.pendingJobs(ssn)); job != nil {
+			klog.V(3).Infof("Elect job <%s/%s> as the target job of reservation.", job.Namespace, job.Name)
+			util.Reservation.SetTargetJob(job, now)
+			ssn.ReservedNodes()
+		}
+	}
+
+	ssn.UpdateReservationAnnotations()
+}
+
+func (reserve *Action) UnInitialize() {}
+
+// pendingJobs returns the enqueued jobs which are not ready and wait for resources, the jobs not enqueued yet are
+// held by their queues rather than by the lack of idle resources, so no node is locked for them.
+func (reserve *Action) pendingJobs(ssn *framework.Session) []*api.JobInfo {
+	var jobs []*api.JobInfo
+	for _, job := range ssn.Jobs {
+		if job.PodGroup == nil || job.PodGroup.Status.Phase != scheduling.PodGroupInqueue {
+			continue
+		}
+		if !job.HasPendingTasks() || ssn.JobReady(job) {
+			continue
+		}
+		if _, found := ssn.Queues[job.Queue]; !found {
+			continue
+		}
+		if vr := ssn.JobValid(job); vr != nil && !vr.Pass {
+			klog.V(4).Infof("Job <%s/%s> Queue <%s> skip reservation, reason: %v, message %v", job.Namespace, job.Name, job.Queue, vr.Reason, vr.Message)
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
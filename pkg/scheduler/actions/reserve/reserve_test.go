@@ -0,0 +1,294 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reserve
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/predicates"
+	"volcano.sh/volcano/pkg/scheduler/plugins/proportion"
+	"volcano.sh/volcano/pkg/scheduler/plugins/reservation"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestMain(m *testing.M) {
+	options.Default()
+	os.Exit(m.Run())
+}
+
+func TestReserve(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		gang.PluginName:        gang.New,
+		proportion.PluginName:  proportion.New,
+		predicates.PluginName:  predicates.New,
+		reservation.PluginName: reservation.New,
+	}
+
+	buildPodGroup := func(name string, minMember int32, created time.Time, phase schedulingv1.PodGroupPhase) *schedulingv1.PodGroup {
+		pg := util.BuildPodGroup(name, "c1", "c1", minMember, nil, phase)
+		pg.CreationTimestamp = metav1.NewTime(created)
+		return pg
+	}
+	now := time.Now()
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		// targetJob is the target job elected in the previous sessions
+		targetJob         *api.JobInfo
+		electedTime       time.Time
+		expectedTargetJob api.JobID
+		expectedLocked    sets.Set[string]
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "lock the free node for the longest waiting job",
+				PodGroups: []*schedulingv1.PodGroup{
+					buildPodGroup("pg-big", 2, now.Add(-time.Hour), schedulingv1.PodGroupInqueue),
+					buildPodGroup("pg-small", 1, now.Add(-30*time.Minute), schedulingv1.PodGroupInqueue),
+				},
+				ExpectBindMap:  map[string]string{},
+				ExpectBindsNum: 0,
+			},
+			expectedTargetJob: "c1/pg-big",
+			expectedLocked:    sets.New("n2"),
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "release the locked nodes on timeout and elect another job",
+				PodGroups: []*schedulingv1.PodGroup{
+					buildPodGroup("pg-big", 2, now.Add(-time.Hour), schedulingv1.PodGroupInqueue),
+					buildPodGroup("pg-small", 1, now.Add(-30*time.Minute), schedulingv1.PodGroupInqueue),
+				},
+				ExpectBindMap:  map[string]string{"c1/small": "n2"},
+				ExpectBindsNum: 1,
+			},
+			targetJob:         &api.JobInfo{UID: "c1/pg-big", Namespace: "c1", Name: "pg-big"},
+			electedTime:       now.Add(-2 * time.Hour),
+			expectedTargetJob: "c1/pg-small",
+			expectedLocked:    sets.New("n2"),
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "release the locked nodes when the target job is deleted",
+				PodGroups: []*schedulingv1.PodGroup{
+					buildPodGroup("pg-small", 1, now.Add(-30*time.Minute), schedulingv1.PodGroupInqueue),
+				},
+				ExpectBindMap:  map[string]string{"c1/small": "n2"},
+				ExpectBindsNum: 1,
+			},
+			targetJob:         &api.JobInfo{UID: "c1/pg-deleted", Namespace: "c1", Name: "pg-deleted"},
+			electedTime:       now,
+			expectedTargetJob: "c1/pg-small",
+			expectedLocked:    sets.New("n2"),
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "lock no node for the job not starving yet",
+				PodGroups: []*schedulingv1.PodGroup{
+					buildPodGroup("pg-small", 1, now, schedulingv1.PodGroupInqueue),
+				},
+				ExpectBindMap:  map[string]string{"c1/small": "n2"},
+				ExpectBindsNum: 1,
+			},
+			expectedTargetJob: "",
+			expectedLocked:    sets.New[string](),
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name: "lock no node for the job not enqueued",
+				PodGroups: []*schedulingv1.PodGroup{
+					buildPodGroup("pg-big", 2, now.Add(-time.Hour), schedulingv1.PodGroupPending),
+				},
+				ExpectBindMap:  map[string]string{},
+				ExpectBindsNum: 0,
+			},
+			expectedTargetJob: "",
+			expectedLocked:    sets.New[string](),
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:                gang.PluginName,
+					EnabledJobOrder:     &trueValue,
+					EnabledJobReady:     &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobStarving:  &trueValue,
+				},
+				{
+					Name:               proportion.PluginName,
+					EnabledQueueOrder:  &trueValue,
+					EnabledAllocatable: &trueValue,
+				},
+				{
+					Name:             predicates.PluginName,
+					EnabledPredicate: &trueValue,
+				},
+				{
+					Name:                 reservation.PluginName,
+					EnabledTargetJob:     &trueValue,
+					EnabledReservedNodes: &trueValue,
+					Arguments: map[string]interface{}{
+						reservation.ReservationTimeout: "1h",
+						reservation.StarvationTime:     "10m",
+					},
+				},
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			util.Reservation = util.NewResourceReservation()
+			if test.targetJob != nil {
+				util.Reservation.SetTargetJob(test.targetJob, test.electedTime)
+				util.Reservation.LockNode("n1")
+			}
+
+			// n1 has 2 cpu left, and n2 is free, so only n2 can hold the 4 cpu tasks
+			test.Nodes = []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+				util.BuildNode("n2", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			}
+			test.Pods = []*v1.Pod{
+				util.BuildPod("c1", "running", "n1", v1.PodRunning, api.BuildResourceList("2", "2G"), "pg-running", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "big-1", "", v1.PodPending, api.BuildResourceList("4", "4G"), "pg-big", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "big-2", "", v1.PodPending, api.BuildResourceList("4", "4G"), "pg-big", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "small", "", v1.PodPending, api.BuildResourceList("4", "4G"), "pg-small", make(map[string]string), make(map[string]string)),
+			}
+			test.PodGroups = append(test.PodGroups, util.BuildPodGroup("pg-running", "c1", "c1", 1, nil, schedulingv1.PodGroupRunning))
+			test.Queues = []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)}
+			test.Plugins = plugins
+
+			ssn := test.RegisterSession(tiers, nil)
+			defer test.Close()
+			for _, node := range test.Nodes {
+				if _, err := ssn.KubeClient().CoreV1().Nodes().Create(context.TODO(), node, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("failed to create node %s: %v", node.Name, err)
+				}
+			}
+
+			test.Run([]framework.Action{New(), allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+
+			if targetJob := util.Reservation.TargetJob(); targetJob != test.expectedTargetJob {
+				t.Errorf("expected target job %s, got %s", test.expectedTargetJob, targetJob)
+			}
+			if lockedNodes := util.Reservation.LockedNodes(); !lockedNodes.Equal(test.expectedLocked) {
+				t.Errorf("expected locked nodes %v, got %v", sets.List(test.expectedLocked), sets.List(lockedNodes))
+			}
+			for _, node := range test.Nodes {
+				node, err := ssn.KubeClient().CoreV1().Nodes().Get(context.TODO(), node.Name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("failed to get node: %v", err)
+				}
+				expected := ""
+				if test.expectedLocked.Has(node.Name) {
+					expected = string(test.expectedTargetJob)
+				}
+				if value := node.Annotations[schedulingv1.ReservedForJobAnnotationKey]; value != expected {
+					t.Errorf("expected reservation annotation %q of node %s, got %q", expected, node.Name, value)
+				}
+			}
+		})
+	}
+	util.Reservation = util.NewResourceReservation()
+}
+
+func TestReleaseReservation(t *testing.T) {
+	defer func() { util.Reservation = util.NewResourceReservation() }()
+	util.Reservation = util.NewResourceReservation()
+	util.Reservation.SetTargetJob(&api.JobInfo{UID: "c1/pg-big", Namespace: "c1", Name: "pg-big"}, time.Now())
+	util.Reservation.LockNode("n1")
+
+	// n1 is locked for the target job by the reserve action which is removed from the configuration later
+	locked := util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string))
+	locked.Annotations = map[string]string{schedulingv1.ReservedForJobAnnotationKey: "c1/pg-big"}
+	test := uthelper.TestCommonStruct{
+		Name:    "release the reservation left over when the reserve action is disabled",
+		Plugins: map[string]framework.PluginBuilder{gang.PluginName: gang.New, predicates.PluginName: predicates.New},
+		PodGroups: []*schedulingv1.PodGroup{
+			util.BuildPodGroup("pg-small", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue),
+		},
+		Pods: []*v1.Pod{
+			util.BuildPod("c1", "small", "", v1.PodPending, api.BuildResourceList("4", "4G"), "pg-small", make(map[string]string), make(map[string]string)),
+		},
+		Nodes:          []*v1.Node{locked},
+		Queues:         []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)},
+		ExpectBindMap:  map[string]string{"c1/small": "n1"},
+		ExpectBindsNum: 1,
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:            gang.PluginName,
+					EnabledJobOrder: &trueValue,
+					EnabledJobReady: &trueValue,
+				},
+				{
+					Name:             predicates.PluginName,
+					EnabledPredicate: &trueValue,
+				},
+			},
+		},
+	}
+	ssn := test.RegisterSession(tiers, nil)
+	defer test.Close()
+	if _, err := ssn.KubeClient().CoreV1().Nodes().Create(context.TODO(), locked, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("failed to create node %s: %v", locked.Name, err)
+	}
+
+	ssn.ReleaseReservation()
+	test.Run([]framework.Action{allocate.New()})
+	if err := test.CheckAll(0); err != nil {
+		t.Fatal(err)
+	}
+
+	if targetJob := util.Reservation.TargetJob(); targetJob != "" {
+		t.Errorf("expected no target job, got %s", targetJob)
+	}
+	node, err := ssn.KubeClient().CoreV1().Nodes().Get(context.TODO(), locked.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get node: %v", err)
+	}
+	if value, found := node.Annotations[schedulingv1.ReservedForJobAnnotationKey]; found {
+		t.Errorf("expected the reservation annotation of node %s to be removed, got %q", node.Name, value)
+	}
+}
@@ -49,6 +49,9 @@ func (shuffle *Action) Execute(ssn *framework.Session) {
 	klog.V(5).Infoln("Enter Shuffle ...")
 	defer klog.V(5).Infoln("Leaving Shuffle ...")
 
+	ssn.SetEvictionPolicy(framework.NewEvictionPolicy(shuffle.Name(), ssn.Configurations))
+	defer ssn.SetEvictionPolicy(nil)
+
 	// select pods that may be evicted
 	tasks := make([]*api.TaskInfo, 0)
 	for _, jobInfo := range ssn.Jobs {
@@ -62,6 +65,9 @@ func (shuffle *Action) Execute(ssn *framework.Session) {
 	// Evict target workloads
 	victims := ssn.VictimTasks(tasks)
 	for victim := range victims {
+		if !ssn.EvictionAllowed(victim) {
+			continue
+		}
 		klog.V(3).Infof("pod %s from namespace %s and job %s will be evicted.\n", victim.Name, victim.Namespace, string(victim.Job))
 		if err := ssn.Evict(victim, "shuffle"); err != nil {
 			klog.Errorf("Failed to evict Task <%s/%s>: %v\n", victim.Namespace, victim.Name, err)
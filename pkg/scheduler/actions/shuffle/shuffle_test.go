@@ -18,9 +18,11 @@ package shuffle
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	"volcano.sh/volcano/pkg/scheduler/api"
@@ -119,3 +121,115 @@ func TestShuffle(t *testing.T) {
 		})
 	}
 }
+
+func TestShuffleWithEvictionPolicy(t *testing.T) {
+	var lowPriority int32 = 10
+
+	ctl := gomock.NewController(t)
+	fakePlugin := mock_framework.NewMockPlugin(ctl)
+	fakePlugin.EXPECT().Name().AnyTimes().Return("fake")
+	fakePlugin.EXPECT().OnSessionOpen(gomock.Any()).AnyTimes().Return()
+	fakePlugin.EXPECT().OnSessionClose(gomock.Any()).AnyTimes().Return()
+	plugins := map[string]framework.PluginBuilder{"fake": func(arguments framework.Arguments) framework.Plugin {
+		return fakePlugin
+	}}
+
+	// pg1 is protected by its min runtime, as its pod started just now
+	buildTest := func(name string) uthelper.TestCommonStruct {
+		pg1 := util.BuildPodGroup("pg1", "test", "default", 0, nil, schedulingv1beta1.PodGroupRunning)
+		pg1.Annotations = map[string]string{schedulingv1beta1.JobMinRuntime: "1h"}
+		pod1 := util.BuildPodWithPriority("test", "pod1-1", "node1", v1.PodRunning, api.BuildResourceList("1", "2G"), "pg1", make(map[string]string), make(map[string]string), &lowPriority)
+		pod1.Status.StartTime = &metav1.Time{Time: time.Now()}
+		pod2 := util.BuildPodWithPriority("test", "pod2-1", "node1", v1.PodRunning, api.BuildResourceList("1", "2G"), "pg2", make(map[string]string), make(map[string]string), &lowPriority)
+		pod2.Status.StartTime = &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+
+		return uthelper.TestCommonStruct{
+			Name:    name,
+			Plugins: plugins,
+			Nodes: []*v1.Node{
+				util.BuildNode("node1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			},
+			Queues: []*schedulingv1beta1.Queue{
+				util.BuildQueue("default", 1, nil),
+			},
+			PodGroups: []*schedulingv1beta1.PodGroup{
+				pg1,
+				util.BuildPodGroup("pg2", "test", "default", 0, nil, schedulingv1beta1.PodGroupRunning),
+			},
+			Pods: []*v1.Pod{pod1, pod2},
+		}
+	}
+
+	protected := map[string]interface{}{conf.EvictionMaxMinRuntimeKey: "2h"}
+	tests := []struct {
+		uthelper.TestCommonStruct
+		arguments map[string]interface{}
+	}{
+		{
+			TestCommonStruct: func() uthelper.TestCommonStruct {
+				test := buildTest("skip the pods protected by the min runtime of their jobs")
+				test.ExpectEvictNum = 1
+				test.ExpectEvicted = []string{"test/pod2-1"}
+				return test
+			}(),
+			arguments: protected,
+		},
+		{
+			TestCommonStruct: func() uthelper.TestCommonStruct {
+				test := buildTest("skip the pods protected by the min runtime capped by the action")
+				test.ExpectEvictNum = 1
+				test.ExpectEvicted = []string{"test/pod2-1"}
+				return test
+			}(),
+			arguments: map[string]interface{}{conf.EvictionMaxMinRuntimeKey: "30m"},
+		},
+		{
+			TestCommonStruct: func() uthelper.TestCommonStruct {
+				test := buildTest("ignore the min runtime of the jobs without a cap")
+				test.ExpectEvictNum = 2
+				test.ExpectEvicted = []string{"test/pod1-1", "test/pod2-1"}
+				return test
+			}(),
+		},
+		{
+			TestCommonStruct: buildTest("only record the evictions in dry-run mode"),
+			arguments:        map[string]interface{}{conf.EvictionDryRunKey: true, conf.EvictionMaxMinRuntimeKey: "2h"},
+		},
+	}
+
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:          "fake",
+					EnabledVictim: &trueValue,
+				},
+			},
+		},
+	}
+	victimTasksFn := func(candidates []*api.TaskInfo) []*api.TaskInfo {
+		evicts := make([]*api.TaskInfo, 0)
+		for _, task := range candidates {
+			if task.Priority == lowPriority {
+				evicts = append(evicts, task)
+			}
+		}
+		return evicts
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			framework.ResetEvictionHistory()
+			defer framework.ResetEvictionHistory()
+			configurations := []conf.Configuration{{Name: Shuffle, Arguments: test.arguments}}
+			ssn := test.RegisterSession(tiers, configurations)
+			defer test.Close()
+			ssn.AddVictimTasksFns("fake", []api.VictimTasksFn{victimTasksFn})
+			test.Run([]framework.Action{New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
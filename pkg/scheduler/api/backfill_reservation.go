@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// BackfillReservation is the reservation planned for the highest-priority blocked gang job,
+// the job is expected to start on the reserved nodes at the start time when the running
+// tasks end as estimated.
+type BackfillReservation struct {
+	Job       JobID
+	StartTime time.Time
+	Nodes     sets.Set[string]
+}
+
+// Permits checks whether the task of the job can be placed on the node without delaying the reservation,
+// that is the node is not reserved, or the job is estimated to end before the reservation starts.
+func (r *BackfillReservation) Permits(job *JobInfo, nodeName string, now time.Time) bool {
+	if r == nil || job == nil || job.UID == r.Job || !r.Nodes.Has(nodeName) {
+		return true
+	}
+
+	return job.RunningEstimate != nil && !now.Add(*job.RunningEstimate).After(r.StartTime)
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+func TestBackfillReservationPermits(t *testing.T) {
+	now := time.Now()
+	reservation := &BackfillReservation{Job: "big", StartTime: now.Add(30 * time.Minute), Nodes: sets.New("n1")}
+
+	buildJob := func(uid JobID, estimate string) *JobInfo {
+		job := NewJobInfo(uid)
+		pg := &PodGroup{PodGroup: scheduling.PodGroup{ObjectMeta: metav1.ObjectMeta{Name: string(uid), Namespace: "ns"}}}
+		if estimate != "" {
+			pg.Annotations = map[string]string{v1beta1.JobRunningEstimate: estimate}
+		}
+		job.SetPodGroup(pg)
+		return job
+	}
+
+	testCases := []struct {
+		name        string
+		reservation *BackfillReservation
+		job         *JobInfo
+		node        string
+		expected    bool
+	}{
+		{name: "no reservation", job: buildJob("small", ""), node: "n1", expected: true},
+		{name: "reserved job", reservation: reservation, job: buildJob("big", ""), node: "n1", expected: true},
+		{name: "node not reserved", reservation: reservation, job: buildJob("small", ""), node: "n2", expected: true},
+		{name: "job ending before reservation", reservation: reservation, job: buildJob("small", "10m"), node: "n1", expected: true},
+		{name: "job ending after reservation", reservation: reservation, job: buildJob("small", "1h"), node: "n1", expected: false},
+		{name: "job without running estimate", reservation: reservation, job: buildJob("small", ""), node: "n1", expected: false},
+		{name: "job with invalid running estimate", reservation: reservation, job: buildJob("small", "-10m"), node: "n1", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if permitted := tc.reservation.Permits(tc.job, tc.node, now); permitted != tc.expected {
+				t.Errorf("expected permitted %v, got %v", tc.expected, permitted)
+			}
+		})
+	}
+}
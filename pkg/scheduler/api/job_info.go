@@ -352,6 +352,17 @@ type JobInfo struct {
 	MinAvailable int32
 
 	WaitingTime *time.Duration
+	// RunningEstimate is the estimated running duration of the job, it is used by backfill to plan reservations
+	RunningEstimate *time.Duration
+	// MinRuntime is the minimum running duration of the tasks of the job before they can be evicted
+	MinRuntime *time.Duration
+	// CheckpointTimeout is the maximum duration to wait for the victims of the job to checkpoint before eviction,
+	// nil means the victims are evicted immediately
+	CheckpointTimeout *time.Duration
+	// Suspended is true if the job is suspended, its PodGroup keeps its place in the queue but is not scheduled
+	Suspended bool
+	// SuspendOnReclaim is true if the job is suspended as a whole instead of having its tasks reclaimed
+	SuspendOnReclaim bool
 
 	JobFitErrors   string
 	NodesFitErrors map[TaskID]*FitErrors
@@ -442,6 +453,30 @@ func (ji *JobInfo) SetPodGroup(pg *PodGroup) {
 		}
 	}
 
+	ji.RunningEstimate, err = extractDuration(pg, v1beta1.JobRunningEstimate)
+	if err != nil {
+		klog.Warningf("Error occurs in parsing running estimate for job <%s/%s>, err: %s.",
+			pg.Namespace, pg.Name, err.Error())
+		ji.RunningEstimate = nil
+	}
+
+	ji.MinRuntime, err = extractDuration(pg, v1beta1.JobMinRuntime)
+	if err != nil {
+		klog.Warningf("Error occurs in parsing min runtime for job <%s/%s>, err: %s.",
+			pg.Namespace, pg.Name, err.Error())
+		ji.MinRuntime = nil
+	}
+
+	ji.CheckpointTimeout, err = extractDuration(pg, v1beta1.CheckpointTimeoutAnnotationKey)
+	if err != nil {
+		klog.Warningf("Error occurs in parsing checkpoint timeout for job <%s/%s>, err: %s.",
+			pg.Namespace, pg.Name, err.Error())
+		ji.CheckpointTimeout = nil
+	}
+
+	ji.Suspended = extractBool(pg, v1beta1.SuspendedAnnotationKey)
+	ji.SuspendOnReclaim = extractBool(pg, v1beta1.SuspendOnReclaimAnnotationKey)
+
 	ji.Preemptable = ji.extractPreemptable(pg)
 	ji.RevocableZone = ji.extractRevocableZone(pg)
 	ji.Budget = ji.extractBudget(pg)
@@ -488,6 +523,50 @@ func (ji *JobInfo) extractWaitingTime(pg *PodGroup, waitingTimeKey string) (*tim
 	return &jobWaitingTime, nil
 }
 
+// extractDuration reads the positive duration of job from podgroup annotations, e.g. the running estimate
+func extractDuration(pg *PodGroup, key string) (*time.Duration, error) {
+	value, exist := pg.Annotations[key]
+	if !exist {
+		return nil, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if duration <= 0 {
+		return nil, fmt.Errorf("invalid duration %s of %s", value, key)
+	}
+
+	return &duration, nil
+}
+
+// EvictionProtected checks whether the task of the job is still within the minimum running duration of the job,
+// which protects it from eviction. The minimum running duration is capped by maxMinRuntime, and not honored at all
+// if maxMinRuntime is not positive.
+func (ji *JobInfo) EvictionProtected(task *TaskInfo, maxMinRuntime time.Duration, now time.Time) bool {
+	if ji.MinRuntime == nil || maxMinRuntime <= 0 || task.Pod == nil || task.Pod.Status.StartTime == nil {
+		return false
+	}
+	return now.Sub(task.Pod.Status.StartTime.Time) < min(*ji.MinRuntime, maxMinRuntime)
+}
+
+// extractBool returns the boolean value of the annotation of the PodGroup, it is false if the annotation is not set
+// or invalid.
+func extractBool(pg *PodGroup, key string) bool {
+	value, found := pg.Annotations[key]
+	if !found {
+		return false
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		klog.Warningf("invalid %s=%s", key, value)
+		return false
+	}
+	return b
+}
+
 // extractPreemptable return volcano.sh/preemptable value for job
 func (ji *JobInfo) extractPreemptable(pg *PodGroup) bool {
 	// check annotation first
@@ -695,12 +774,17 @@ func (ji *JobInfo) Clone() *JobInfo {
 		Queue:     ji.Queue,
 		Priority:  ji.Priority,
 
-		MinAvailable:   ji.MinAvailable,
-		WaitingTime:    ji.WaitingTime,
-		JobFitErrors:   ji.JobFitErrors,
-		NodesFitErrors: make(map[TaskID]*FitErrors),
-		Allocated:      EmptyResource(),
-		TotalRequest:   EmptyResource(),
+		MinAvailable:      ji.MinAvailable,
+		WaitingTime:       ji.WaitingTime,
+		RunningEstimate:   ji.RunningEstimate,
+		MinRuntime:        ji.MinRuntime,
+		CheckpointTimeout: ji.CheckpointTimeout,
+		Suspended:         ji.Suspended,
+		SuspendOnReclaim:  ji.SuspendOnReclaim,
+		JobFitErrors:      ji.JobFitErrors,
+		NodesFitErrors:    make(map[TaskID]*FitErrors),
+		Allocated:         EmptyResource(),
+		TotalRequest:      EmptyResource(),
 
 		PodGroup: ji.PodGroup.Clone(),
 
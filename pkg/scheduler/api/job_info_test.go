@@ -22,6 +22,7 @@ package api
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
@@ -718,3 +719,25 @@ func TestParseMinMemberInfoChanged(t *testing.T) {
 		})
 	}
 }
+
+func TestEvictionProtected(t *testing.T) {
+	now := time.Now()
+	minRuntime := 2 * time.Hour
+	job := &JobInfo{MinRuntime: &minRuntime}
+	task := &TaskInfo{Pod: &v1.Pod{Status: v1.PodStatus{StartTime: &metav1.Time{Time: now.Add(-time.Hour)}}}}
+
+	tests := []struct {
+		name          string
+		maxMinRuntime time.Duration
+		expected      bool
+	}{
+		{name: "min runtime ignored without a cap", maxMinRuntime: 0, expected: false},
+		{name: "protected by the min runtime under the cap", maxMinRuntime: 3 * time.Hour, expected: true},
+		{name: "min runtime capped", maxMinRuntime: 30 * time.Minute, expected: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, job.EvictionProtected(task, test.maxMinRuntime, now))
+		})
+	}
+}
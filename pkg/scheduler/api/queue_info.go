@@ -47,11 +47,20 @@ type QueueInfo struct {
 	// path from the root to the node itself.
 	Hierarchy string
 
+	// DequeueStrategy defines whether the jobs behind an unschedulable
+	// head job are still dequeued, defaults to traverse.
+	DequeueStrategy scheduling.DequeueStrategy
+
 	Queue *scheduling.Queue
 }
 
 // NewQueueInfo creates new queueInfo object
 func NewQueueInfo(queue *scheduling.Queue) *QueueInfo {
+	dequeueStrategy := queue.Spec.DequeueStrategy
+	if dequeueStrategy == "" {
+		dequeueStrategy = scheduling.DefaultDequeueStrategy
+	}
+
 	return &QueueInfo{
 		UID:  QueueID(queue.Name),
 		Name: queue.Name,
@@ -60,6 +69,8 @@ func NewQueueInfo(queue *scheduling.Queue) *QueueInfo {
 		Hierarchy: queue.Annotations[v1beta1.KubeHierarchyAnnotationKey],
 		Weights:   queue.Annotations[v1beta1.KubeHierarchyWeightAnnotationKey],
 
+		DequeueStrategy: dequeueStrategy,
+
 		Queue: queue,
 	}
 }
@@ -73,6 +84,8 @@ func (q *QueueInfo) Clone() *QueueInfo {
 		Hierarchy: q.Hierarchy,
 		Weights:   q.Weights,
 		Queue:     q.Queue.DeepCopy(),
+
+		DequeueStrategy: q.DequeueStrategy,
 	}
 }
 
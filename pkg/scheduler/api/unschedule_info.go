@@ -29,6 +29,10 @@ const (
 	NodePodNumberExceeded = "node(s) pod number exceeded"
 	// NodeResourceFitFailed means node could not fit the request of pod
 	NodeResourceFitFailed = "node(s) resource fit failed"
+	// NodeReservedForBackfill means node is reserved for a blocked job and the job of pod could not end before it starts
+	NodeReservedForBackfill = "node(s) reserved for blocked job"
+	// NodeLockedForReservation means node is locked for the target job of reservation
+	NodeLockedForReservation = "node(s) locked for target job"
 
 	// AllNodeUnavailableMsg is the default error message
 	AllNodeUnavailableMsg = "all nodes are unavailable"
@@ -91,6 +95,11 @@ func (f *FitErrors) SetNodeError(nodeName string, err error) {
 	f.nodes[nodeName] = fe
 }
 
+// Nodes returns the FitError on each node
+func (f *FitErrors) Nodes() map[string]*FitError {
+	return f.nodes
+}
+
 // GetUnschedulableAndUnresolvableNodes returns the set of nodes that has no help from preempting pods from it
 func (f *FitErrors) GetUnschedulableAndUnresolvableNodes() map[string]sets.Empty {
 	ret := make(map[string]sets.Empty)
@@ -156,6 +156,12 @@ type SchedulerCache struct {
 
 	NamespaceCollection map[string]*schedulingapi.NamespaceCollection
 
+	// gracefulEvictions are the evictions of the tasks waiting for them to checkpoint
+	gracefulEvictions map[schedulingapi.TaskID]*gracefulEviction
+	// suspensionRequests are the jobs being requested to suspend instead of having their tasks reclaimed, by the
+	// deadlines of the suspensions
+	suspensionRequests map[schedulingapi.JobID]time.Time
+
 	errTasks                      workqueue.TypedRateLimitingInterface[string]
 	nodeQueue                     workqueue.TypedRateLimitingInterface[schedulercache.QueueObjectWrapper]
 	nodeInitialEventTracker       *schedulercache.InitialEventAsyncHandlerTracker
@@ -195,6 +201,9 @@ type SchedulerCache struct {
 
 	// timeout on waiting for handlers handle initial resource synchronization before starting scheduling, 0 will skip waiting
 	resourceSyncTimeout time.Duration
+
+	// scheduleTrigger is signaled by the event handlers to run an early scheduling cycle, see ScheduleTrigger
+	scheduleTrigger chan struct{}
 }
 
 type multiSchedulerInfo struct {
@@ -546,6 +555,8 @@ func newSchedulerCache(config *rest.Config, schedulerNames []string, defaultQueu
 		Queues:              make(map[schedulingapi.QueueID]*schedulingapi.QueueInfo),
 		PriorityClasses:     make(map[string]*schedulingv1.PriorityClass),
 		errTasks:            workqueue.NewTypedRateLimitingQueue[string](errTaskRateLimiter),
+		gracefulEvictions:   make(map[schedulingapi.TaskID]*gracefulEviction),
+		suspensionRequests:  make(map[schedulingapi.JobID]time.Time),
 		nodeQueue:           workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[schedulercache.QueueObjectWrapper]()),
 		DeletedJobs:         workqueue.NewTypedRateLimitingQueue[string](deletedJobsRateLimiter),
 		hyperNodesQueue:     workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[schedulercache.QueueObjectWrapper]()),
@@ -564,6 +575,7 @@ func newSchedulerCache(config *rest.Config, schedulerNames []string, defaultQueu
 		NodeList:            []string{},
 		nodeWorkers:         nodeWorkers,
 		resourceSyncTimeout: resourceSyncTimeout,
+		scheduleTrigger:     make(chan struct{}, 1),
 	}
 
 	if options.ServerOpts.ShardingMode == util.HardShardingMode || options.ServerOpts.ShardingMode == util.SoftShardingMode {
@@ -865,6 +877,9 @@ func (sc *SchedulerCache) Run(stopCh <-chan struct{}) {
 	// Cleanup jobs.
 	go wait.Until(sc.processCleanupJob, 0, stopCh)
 
+	// Evict the tasks which have checkpointed or timed out.
+	go wait.Until(sc.processGracefulEvictions, time.Second, stopCh)
+
 	go wait.Until(sc.processBindTask, time.Millisecond*20, stopCh)
 
 	// Get metrics data
@@ -961,6 +976,16 @@ func (sc *SchedulerCache) Evict(taskInfo *schedulingapi.TaskInfo, reason string)
 	// Add new task to node.
 	node.UpdateTask(task)
 
+	// The reclaimed jobs opting in to suspension are suspended by the job controller as a whole.
+	if sc.deferEvictionToSuspension(job, task, podgroup, reason) {
+		return nil
+	}
+
+	// The victims of the job opting in to graceful eviction are requested to checkpoint before they are evicted.
+	if sc.deferEviction(job, task, podgroup, reason) {
+		return nil
+	}
+
 	p := task.Pod
 
 	go func() {
@@ -1562,6 +1587,13 @@ func (sc *SchedulerCache) Snapshot() *schedulingapi.ClusterInfo {
 			continue
 		}
 
+		// The suspended job is neither scheduled nor accounted by its queue until it is resumed.
+		if value.Suspended {
+			klog.V(4).Infof("The Job <%v:%s/%s> is suspended, ignore it.",
+				value.UID, value.Namespace, value.Name)
+			continue
+		}
+
 		wg.Add(1)
 		go cloneJob(value)
 	}
@@ -21,6 +21,7 @@ import (
 	"math"
 	"os"
 	"strconv"
+	"time"
 
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -135,6 +136,8 @@ func newMockSchedulerCache(schedulerName string) *SchedulerCache {
 		schedulerNames:         []string{schedulerName},
 		nodeSelectorLabels:     make(map[string]sets.Empty),
 		NamespaceCollection:    make(map[string]*schedulingapi.NamespaceCollection),
+		gracefulEvictions:      make(map[schedulingapi.TaskID]*gracefulEviction),
+		suspensionRequests:     make(map[schedulingapi.JobID]time.Time),
 		CSINodesStatus:         make(map[string]*schedulingapi.CSINodeStatusInfo),
 		imageStates:            make(map[string]*imageState),
 		InUseNodesInShard:      sets.Set[string]{},
@@ -144,6 +147,8 @@ func newMockSchedulerCache(schedulerName string) *SchedulerCache {
 		NodeList:       []string{},
 		binderRegistry: NewBinderRegistry(),
 		resyncPeriod:   0,
+
+		scheduleTrigger: make(chan struct{}, 1),
 	}
 	if options.ServerOpts != nil && len(options.ServerOpts.NodeSelector) > 0 {
 		msc.updateNodeSelectors(options.ServerOpts.NodeSelector)
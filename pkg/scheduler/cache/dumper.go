@@ -31,6 +31,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/util"
 )
 
 // Dumper writes some information from the scheduler cache to the scheduler logs
@@ -54,7 +55,7 @@ func (d *Dumper) dumpToJSONFile() {
 	defer file.Close()
 	klog.Infoln("Starting to dump info in scheduler cache to file", fName)
 
-	if err := encodeCache(file, snapshot.Nodes, snapshot.HyperNodesSetByTier, snapshot.HyperNodeTierNameMap, snapshot.RealNodesSet, snapshot.HyperNodes, snapshot.Jobs); err != nil {
+	if err := encodeCache(file, snapshot.Nodes, snapshot.HyperNodesSetByTier, snapshot.HyperNodeTierNameMap, snapshot.RealNodesSet, snapshot.HyperNodes, snapshot.Jobs, snapshot.Queues, util.Reservation.Info()); err != nil {
 		klog.Errorf("Failed to dump info in scheduler cache, json encode error: %v", err)
 		return
 	}
@@ -88,6 +89,9 @@ func (d *Dumper) dumpAll() {
 	klog.Info("Dump of hyperNodes info in scheduler cache")
 	d.printHyperNodeInfo(snapshot.HyperNodesSetByTier, snapshot.RealNodesSet, snapshot.HyperNodes)
 
+	klog.Info("Dump of reservation info in scheduler")
+	klog.Info(d.printReservationInfo(util.Reservation.Info()))
+
 	d.displaySchedulerMemStats()
 }
 
@@ -113,6 +117,14 @@ func (d *Dumper) printJobInfo(jobInfo *api.JobInfo) string {
 	return data.String()
 }
 
+func (d *Dumper) printReservationInfo(info util.ReservationInfo) string {
+	var data strings.Builder
+	data.WriteString("\n")
+	data.WriteString(fmt.Sprintf("TargetJob: %s, ElectedTime: %v, LockedNodes: %v", info.TargetJob, info.ElectedTime, info.LockedNodes))
+	data.WriteString("\n")
+	return data.String()
+}
+
 func (d *Dumper) printHyperNodeInfo(HyperNodesSetByTier map[int]sets.Set[string], realNodesSet map[string]sets.Set[string], hyperNodeInfoMap api.HyperNodeInfoMap) {
 	var data strings.Builder
 	data.WriteString("\n")
@@ -260,6 +260,7 @@ func (sc *SchedulerCache) addPod(pod *v1.Pod) error {
 		klog.Errorf("generate taskInfo for pod(%s) failed: %v", pod.Name, err)
 		sc.resyncTask(pi)
 	}
+	sc.keepGracefulEviction(pi)
 
 	return sc.addTask(pi)
 }
@@ -400,6 +401,7 @@ func (sc *SchedulerCache) AddPod(obj interface{}) {
 		return
 	}
 	klog.V(3).Infof("Added pod <%s/%v> into cache.", pod.Namespace, pod.Name)
+	sc.triggerScheduleOnPodAdded(pod)
 }
 
 // UpdatePod update pod to scheduler cache
@@ -425,6 +427,7 @@ func (sc *SchedulerCache) UpdatePod(oldObj, newObj interface{}) {
 	}
 
 	klog.V(4).Infof("Updated pod <%s/%v> in cache.", oldPod.Namespace, oldPod.Name)
+	sc.triggerScheduleOnPodUpdated(oldPod, newPod)
 }
 
 // DeletePod delete pod from scheduler cache
@@ -455,6 +458,7 @@ func (sc *SchedulerCache) DeletePod(obj interface{}) {
 	}
 
 	klog.V(3).Infof("Deleted pod <%s/%v> from cache.", pod.Namespace, pod.Name)
+	sc.triggerScheduleOnPodDeleted(pod)
 }
 
 // addNodeImageStates adds states of the images on given node to the given nodeInfo and update the imageStates in
@@ -566,6 +570,8 @@ func (sc *SchedulerCache) AddNode(obj interface{}, isInInitialList bool) {
 	if isInInitialList {
 		sc.nodeInitialEventTracker.Add(node.Name)
 		sc.hyperNodesInitialEventTracker.Add(string(hyperNodeEventSourceNode) + "/" + node.Name)
+	} else {
+		sc.triggerSchedule("node added", "", node.Name)
 	}
 }
 
@@ -582,6 +588,7 @@ func (sc *SchedulerCache) UpdateNode(oldObj, newObj interface{}) {
 		return
 	}
 	sc.nodeQueue.Add(schedulercache.QueueObjectWrapper{Object: newNode.Name, IsInInitialList: false})
+	sc.triggerScheduleOnNodeUpdated(oldNode, newNode)
 	if !reflect.DeepEqual(oldNode.GetLabels(), newNode.GetLabels()) {
 		sc.hyperNodesQueue.Add(schedulercache.QueueObjectWrapper{Object: string(hyperNodeEventSourceNode) + "/" + newNode.Name, IsInInitialList: false})
 	}
@@ -796,6 +803,9 @@ func (sc *SchedulerCache) setPodGroup(ss *schedulingapi.PodGroup) error {
 	}
 
 	sc.Jobs[job].SetPodGroup(ss)
+	if sc.Jobs[job].Suspended || ss.Status.Phase == scheduling.PodGroupCompleted {
+		sc.forgetSuspensionRequest(job)
+	}
 
 	// TODO(k82cn): set default queue in admission.
 	if len(ss.Spec.Queue) == 0 {
@@ -821,6 +831,7 @@ func (sc *SchedulerCache) deletePodGroup(id schedulingapi.JobID) error {
 
 	// Unset SchedulingSpec
 	job.UnsetPodGroup()
+	sc.forgetSuspensionRequest(id)
 
 	sc.deleteJob(job)
 
@@ -853,6 +864,7 @@ func (sc *SchedulerCache) AddPodGroupV1beta1(obj interface{}) {
 		klog.Errorf("Failed to add PodGroup %s into cache: %v", ss.Name, err)
 		return
 	}
+	sc.triggerSchedule("podgroup added", ss.Namespace, ss.Name)
 }
 
 // UpdatePodGroupV1beta1 add podgroup to scheduler cache
@@ -889,6 +901,7 @@ func (sc *SchedulerCache) UpdatePodGroupV1beta1(oldObj, newObj interface{}) {
 		klog.Errorf("Failed to update SchedulingSpec %s into cache: %v", pg.Name, err)
 		return
 	}
+	sc.triggerScheduleOnPodGroupUpdated(oldSS, newSS)
 }
 
 // DeletePodGroupV1beta1 delete podgroup from scheduler cache
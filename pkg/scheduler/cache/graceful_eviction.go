@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
+)
+
+const (
+	// CheckpointRequestedReason is the event reason when the victim is requested to checkpoint before eviction.
+	CheckpointRequestedReason = "CheckpointRequested"
+)
+
+// gracefulEviction is the eviction of a task deferred until the task checkpoints or the deadline passes.
+type gracefulEviction struct {
+	task     *schedulingapi.TaskInfo
+	reason   string
+	deadline time.Time
+}
+
+// checkpointCompleted checks whether the workload of the pod has acknowledged that it has checkpointed.
+func checkpointCompleted(pod *v1.Pod) bool {
+	return pod != nil && pod.Annotations[vcv1beta1.CheckpointCompletedAnnotationKey] == "true"
+}
+
+// deferEviction defers the eviction of the task if its job opts in to graceful eviction, the pod and the PodGroup
+// are annotated with the deadline to signal the workload to checkpoint. It returns false if the task should be
+// evicted immediately. Assumes that lock is already acquired.
+func (sc *SchedulerCache) deferEviction(job *schedulingapi.JobInfo, task *schedulingapi.TaskInfo, podgroup *vcv1beta1.PodGroup, reason string) bool {
+	if job.CheckpointTimeout == nil || checkpointCompleted(task.Pod) {
+		return false
+	}
+	if _, found := sc.gracefulEvictions[task.UID]; found {
+		return true
+	}
+
+	deadline := time.Now().Add(*job.CheckpointTimeout)
+	sc.gracefulEvictions[task.UID] = &gracefulEviction{task: task.Clone(), reason: reason, deadline: deadline}
+
+	klog.V(3).Infof("Request Task <%s/%s> to checkpoint before eviction by %v, because of %v.",
+		task.Namespace, task.Name, deadline.Format(time.RFC3339), reason)
+	sc.Recorder.Eventf(podgroup, v1.EventTypeNormal, CheckpointRequestedReason,
+		"Pod %s is requested to checkpoint before %s, then evicted because of %v", task.Name, deadline.Format(time.RFC3339), reason)
+
+	go sc.requestCheckpoint(task, podgroup, deadline)
+	return true
+}
+
+// requestCheckpoint annotates the pod and the PodGroup with the deadline of the checkpoint, the pod is evicted
+// at once if it can not be signaled.
+func (sc *SchedulerCache) requestCheckpoint(task *schedulingapi.TaskInfo, podgroup *vcv1beta1.PodGroup, deadline time.Time) {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{vcv1beta1.EvictionRequestedAnnotationKey: deadline.Format(time.RFC3339)},
+		},
+	})
+	if err != nil {
+		klog.Errorf("Failed to build eviction request patch of Task <%s/%s>: %v", task.Namespace, task.Name, err)
+		return
+	}
+
+	if _, err := sc.kubeClient.CoreV1().Pods(task.Namespace).Patch(context.TODO(), task.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		klog.Errorf("Failed to request Task <%s/%s> to checkpoint, evict it at once: %v", task.Namespace, task.Name, err)
+		sc.Mutex.Lock()
+		if eviction, found := sc.gracefulEvictions[task.UID]; found {
+			eviction.deadline = time.Time{}
+		}
+		sc.Mutex.Unlock()
+		return
+	}
+
+	if _, err := sc.vcClient.SchedulingV1beta1().PodGroups(podgroup.Namespace).Patch(context.TODO(), podgroup.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		klog.Warningf("Failed to annotate PodGroup <%s/%s> with eviction request: %v", podgroup.Namespace, podgroup.Name, err)
+	}
+}
+
+// keepGracefulEviction keeps the task waiting for eviction as releasing, so that its resources are taken as being
+// released when the pod is updated by the workload. Assumes that lock is already acquired.
+func (sc *SchedulerCache) keepGracefulEviction(task *schedulingapi.TaskInfo) {
+	if _, found := sc.gracefulEvictions[task.UID]; found && task.Status == schedulingapi.Running {
+		task.Status = schedulingapi.Releasing
+	}
+}
+
+// processGracefulEvictions evicts the tasks which have checkpointed or whose deadline has passed, the evictions of the
+// tasks which have gone are dropped.
+func (sc *SchedulerCache) processGracefulEvictions() {
+	sc.Mutex.Lock()
+	defer sc.Mutex.Unlock()
+
+	now := time.Now()
+	for uid, eviction := range sc.gracefulEvictions {
+		job, task, err := sc.findJobAndTask(eviction.task)
+		if err != nil {
+			klog.V(4).Infof("Task <%s/%s> waiting for eviction has gone: %v", eviction.task.Namespace, eviction.task.Name, err)
+			delete(sc.gracefulEvictions, uid)
+			continue
+		}
+
+		completed := checkpointCompleted(task.Pod)
+		if !completed && now.Before(eviction.deadline) {
+			continue
+		}
+		delete(sc.gracefulEvictions, uid)
+		klog.V(3).Infof("Evict Task <%s/%s>, checkpoint completed: %v.", task.Namespace, task.Name, completed)
+
+		p, reason := task.Pod, eviction.reason
+		go func() {
+			if err := sc.Evictor.Evict(p, reason); err != nil {
+				sc.resyncTask(task)
+			}
+		}()
+		sc.recordPodGroupEvent(job.PodGroup, v1.EventTypeNormal, "Evict", reason)
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestGracefulEviction(t *testing.T) {
+	tests := []struct {
+		name              string
+		checkpointTimeout string
+		checkpointed      bool
+		expectDeferred    bool
+	}{
+		{
+			name:           "evict at once if the job does not opt in",
+			expectDeferred: false,
+		},
+		{
+			name:              "evict once the checkpoint is completed",
+			checkpointTimeout: "1h",
+			checkpointed:      true,
+			expectDeferred:    true,
+		},
+		{
+			name:              "evict once the deadline passes",
+			checkpointTimeout: "1ms",
+			expectDeferred:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sc := NewDefaultMockSchedulerCache("volcano")
+			evictor := util.NewFakeEvictor(1)
+			sc.Evictor = evictor
+
+			pod := buildPod("c1", "p1", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), nil, make(map[string]string))
+			pod.Annotations = map[string]string{vcv1beta1.KubeGroupNameAnnotationKey: "pg1"}
+			pg := &vcv1beta1.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "pg1", Namespace: "c1", Annotations: map[string]string{}},
+				Spec:       vcv1beta1.PodGroupSpec{Queue: "default"},
+			}
+			if test.checkpointTimeout != "" {
+				pg.Annotations[vcv1beta1.CheckpointTimeoutAnnotationKey] = test.checkpointTimeout
+			}
+			if _, err := sc.kubeClient.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("failed to create pod: %v", err)
+			}
+			if _, err := sc.vcClient.SchedulingV1beta1().PodGroups(pg.Namespace).Create(context.TODO(), pg, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("failed to create podgroup: %v", err)
+			}
+			if err := sc.AddOrUpdateNode(buildNode("n1", api.BuildResourceList("2", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...))); err != nil {
+				t.Fatalf("failed to add node: %v", err)
+			}
+			sc.AddPodGroupV1beta1(pg)
+			sc.AddPod(pod)
+
+			if err := sc.Evict(api.NewTaskInfo(pod), "preempt"); err != nil {
+				t.Fatalf("failed to evict task: %v", err)
+			}
+			if !test.expectDeferred {
+				if key := <-evictor.Channel; key != "c1/p1" {
+					t.Fatalf("expected c1/p1 to be evicted, got %s", key)
+				}
+				return
+			}
+			if evictor.Length() != 0 {
+				t.Fatalf("expected the eviction to be deferred, got evicted %v", evictor.Evicts())
+			}
+
+			// the pod is annotated with the deadline asynchronously
+			err := wait.PollUntilContextTimeout(context.TODO(), 10*time.Millisecond, time.Second, true, func(ctx context.Context) (bool, error) {
+				p, err := sc.kubeClient.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+				if err != nil {
+					return false, err
+				}
+				_, found := p.Annotations[vcv1beta1.EvictionRequestedAnnotationKey]
+				return found, nil
+			})
+			if err != nil {
+				t.Fatalf("expected pod to be requested to checkpoint: %v", err)
+			}
+
+			updated := pod.DeepCopy()
+			if test.checkpointed {
+				updated.Annotations[vcv1beta1.CheckpointCompletedAnnotationKey] = "true"
+			}
+			sc.UpdatePod(pod, updated)
+			if status := sc.Jobs["c1/pg1"].Tasks["c1-p1"].Status; status != api.Releasing {
+				t.Errorf("expected task waiting for eviction to be Releasing, got %v", status)
+			}
+
+			time.Sleep(10 * time.Millisecond)
+			sc.processGracefulEvictions()
+			if key := <-evictor.Channel; key != "c1/p1" {
+				t.Fatalf("expected c1/p1 to be evicted, got %s", key)
+			}
+			if len(sc.gracefulEvictions) != 0 {
+				t.Errorf("expected no eviction waiting, got %d", len(sc.gracefulEvictions))
+			}
+		})
+	}
+}
@@ -104,6 +104,9 @@ type Cache interface {
 
 	//OnSessionClose is called after session close
 	OnSessionClose()
+
+	// ScheduleTrigger returns the channel signaled when an early scheduling cycle may make progress
+	ScheduleTrigger() <-chan struct{}
 }
 
 // Binder interface for binding task and hostname
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/klog/v2"
+
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+)
+
+// ScheduleTrigger returns the channel signaled when the cache changes in a way that an early scheduling cycle may
+// make progress, e.g. a PodGroup is inqueue, a node is added or resources are freed.
+func (sc *SchedulerCache) ScheduleTrigger() <-chan struct{} {
+	return sc.scheduleTrigger
+}
+
+// triggerSchedule signals the scheduler to run an early scheduling cycle, the signals before the scheduler
+// receives them are coalesced into one.
+func (sc *SchedulerCache) triggerSchedule(reason string, namespace, name string) {
+	select {
+	case sc.scheduleTrigger <- struct{}{}:
+		klog.V(5).Infof("Triggered an early scheduling cycle: %s <%s/%s>", reason, namespace, name)
+	default:
+	}
+}
+
+// triggerScheduleOnPodAdded triggers a scheduling cycle if the pod is pending to be scheduled by the scheduler.
+func (sc *SchedulerCache) triggerScheduleOnPodAdded(pod *v1.Pod) {
+	if pod.Spec.NodeName == "" && pod.Status.Phase == v1.PodPending && pod.DeletionTimestamp == nil &&
+		slices.Contains(sc.schedulerNames, pod.Spec.SchedulerName) {
+		sc.triggerSchedule("pod added", pod.Namespace, pod.Name)
+	}
+}
+
+// triggerScheduleOnPodUpdated triggers a scheduling cycle if the pod terminates and frees the resources on its node.
+func (sc *SchedulerCache) triggerScheduleOnPodUpdated(oldPod, newPod *v1.Pod) {
+	if newPod.Spec.NodeName != "" && !podTerminated(oldPod) && podTerminated(newPod) {
+		sc.triggerSchedule("pod terminated", newPod.Namespace, newPod.Name)
+	}
+}
+
+// triggerScheduleOnPodDeleted triggers a scheduling cycle if the pod frees the resources on its node.
+func (sc *SchedulerCache) triggerScheduleOnPodDeleted(pod *v1.Pod) {
+	if pod.Spec.NodeName != "" && !podTerminated(pod) {
+		sc.triggerSchedule("pod deleted", pod.Namespace, pod.Name)
+	}
+}
+
+// triggerScheduleOnNodeUpdated triggers a scheduling cycle if the node gains resources or becomes schedulable.
+func (sc *SchedulerCache) triggerScheduleOnNodeUpdated(oldNode, newNode *v1.Node) {
+	if !equality.Semantic.DeepEqual(oldNode.Status.Allocatable, newNode.Status.Allocatable) ||
+		(oldNode.Spec.Unschedulable && !newNode.Spec.Unschedulable) ||
+		len(newNode.Spec.Taints) < len(oldNode.Spec.Taints) {
+		sc.triggerSchedule("node updated", "", newNode.Name)
+	}
+}
+
+// triggerScheduleOnPodGroupUpdated triggers a scheduling cycle if the PodGroup becomes inqueue or its spec changes.
+func (sc *SchedulerCache) triggerScheduleOnPodGroupUpdated(oldPG, newPG *schedulingv1beta1.PodGroup) {
+	if (oldPG.Status.Phase != schedulingv1beta1.PodGroupInqueue && newPG.Status.Phase == schedulingv1beta1.PodGroupInqueue) ||
+		!equality.Semantic.DeepEqual(oldPG.Spec, newPG.Spec) {
+		sc.triggerSchedule("podgroup updated", newPG.Namespace, newPG.Name)
+	}
+}
+
+func podTerminated(pod *v1.Pod) bool {
+	return pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed
+}
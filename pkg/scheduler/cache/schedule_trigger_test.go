@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestScheduleTrigger(t *testing.T) {
+	pod := func(nodeName string, phase v1.PodPhase, schedulerName string) *v1.Pod {
+		p := buildPod("ns", "p1", nodeName, phase, api.BuildResourceList("1", "1G"), nil, make(map[string]string))
+		p.Spec.SchedulerName = schedulerName
+		return p
+	}
+	node := func(cpu string, unschedulable bool) *v1.Node {
+		n := buildNode("n1", api.BuildResourceList(cpu, "4G"))
+		n.Spec.Unschedulable = unschedulable
+		return n
+	}
+	podGroup := func(phase schedulingv1.PodGroupPhase, minMember int32) *schedulingv1.PodGroup {
+		return &schedulingv1.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pg1"},
+			Spec:       schedulingv1.PodGroupSpec{MinMember: minMember},
+			Status:     schedulingv1.PodGroupStatus{Phase: phase},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		event     func(sc *SchedulerCache)
+		triggered bool
+	}{
+		{
+			name:      "pending pod of the scheduler is added",
+			event:     func(sc *SchedulerCache) { sc.triggerScheduleOnPodAdded(pod("", v1.PodPending, "volcano")) },
+			triggered: true,
+		},
+		{
+			name:  "pending pod of another scheduler is added",
+			event: func(sc *SchedulerCache) { sc.triggerScheduleOnPodAdded(pod("", v1.PodPending, "default-scheduler")) },
+		},
+		{
+			name:  "running pod is added",
+			event: func(sc *SchedulerCache) { sc.triggerScheduleOnPodAdded(pod("n1", v1.PodRunning, "volcano")) },
+		},
+		{
+			name: "running pod succeeds",
+			event: func(sc *SchedulerCache) {
+				sc.triggerScheduleOnPodUpdated(pod("n1", v1.PodRunning, "volcano"), pod("n1", v1.PodSucceeded, "volcano"))
+			},
+			triggered: true,
+		},
+		{
+			name: "pending pod is bound",
+			event: func(sc *SchedulerCache) {
+				sc.triggerScheduleOnPodUpdated(pod("", v1.PodPending, "volcano"), pod("n1", v1.PodPending, "volcano"))
+			},
+		},
+		{
+			name: "running pod is deleted",
+			event: func(sc *SchedulerCache) {
+				sc.triggerScheduleOnPodDeleted(pod("n1", v1.PodRunning, "default-scheduler"))
+			},
+			triggered: true,
+		},
+		{
+			name:  "failed pod is deleted",
+			event: func(sc *SchedulerCache) { sc.triggerScheduleOnPodDeleted(pod("n1", v1.PodFailed, "volcano")) },
+		},
+		{
+			name:      "node becomes schedulable",
+			event:     func(sc *SchedulerCache) { sc.triggerScheduleOnNodeUpdated(node("4", true), node("4", false)) },
+			triggered: true,
+		},
+		{
+			name:      "allocatable of node changes",
+			event:     func(sc *SchedulerCache) { sc.triggerScheduleOnNodeUpdated(node("4", false), node("8", false)) },
+			triggered: true,
+		},
+		{
+			name:  "heartbeat of node",
+			event: func(sc *SchedulerCache) { sc.triggerScheduleOnNodeUpdated(node("4", false), node("4", false)) },
+		},
+		{
+			name: "podgroup becomes inqueue",
+			event: func(sc *SchedulerCache) {
+				sc.triggerScheduleOnPodGroupUpdated(podGroup(schedulingv1.PodGroupPending, 1), podGroup(schedulingv1.PodGroupInqueue, 1))
+			},
+			triggered: true,
+		},
+		{
+			name: "podgroup becomes running",
+			event: func(sc *SchedulerCache) {
+				sc.triggerScheduleOnPodGroupUpdated(podGroup(schedulingv1.PodGroupInqueue, 1), podGroup(schedulingv1.PodGroupRunning, 1))
+			},
+		},
+		{
+			name: "min member of podgroup changes",
+			event: func(sc *SchedulerCache) {
+				sc.triggerScheduleOnPodGroupUpdated(podGroup(schedulingv1.PodGroupRunning, 1), podGroup(schedulingv1.PodGroupRunning, 2))
+			},
+			triggered: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sc := &SchedulerCache{schedulerNames: []string{"volcano"}, scheduleTrigger: make(chan struct{}, 1)}
+			test.event(sc)
+			select {
+			case <-sc.ScheduleTrigger():
+				if !test.triggered {
+					t.Errorf("expected no scheduling cycle to be triggered")
+				}
+			default:
+				if test.triggered {
+					t.Errorf("expected a scheduling cycle to be triggered")
+				}
+			}
+		})
+	}
+}
+
+func TestScheduleTriggerCoalesced(t *testing.T) {
+	sc := &SchedulerCache{scheduleTrigger: make(chan struct{}, 1)}
+	for i := 0; i < 3; i++ {
+		sc.triggerSchedule("test", "ns", "p1")
+	}
+	<-sc.ScheduleTrigger()
+	select {
+	case <-sc.ScheduleTrigger():
+		t.Errorf("expected the signals to be coalesced into one")
+	default:
+	}
+
+	// the signals are dropped if the cache is not created with the trigger
+	(&SchedulerCache{}).triggerSchedule("test", "ns", "p1")
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	schedulingapi "volcano.sh/volcano/pkg/scheduler/api"
+)
+
+const (
+	// SuspendRequestedReason is the event reason when the job is requested to suspend instead of being evicted.
+	SuspendRequestedReason = "SuspendRequested"
+
+	// reclaimEvictionReason is the reason of the evictions by the reclaim action.
+	reclaimEvictionReason = "reclaim"
+
+	// suspensionTimeout is how long the reclaimed tasks are left to the suspension of their job, they are evicted as
+	// usual if the job is not suspended by then.
+	suspensionTimeout = 2 * time.Minute
+)
+
+// deferEvictionToSuspension requests the job controller to suspend the job of the reclaimed task if the job opts in
+// to suspension on reclaim, the pods of the job are then deleted by the job controller while its PodGroup keeps its
+// place in the queue. The evictions are deferred until the job is suspended, it returns false if the task should be
+// evicted immediately, e.g. the job is not suspended within the suspensionTimeout, or the PodGroup already carries a
+// request which is not waited for by this scheduler. Assumes that lock is already acquired.
+func (sc *SchedulerCache) deferEvictionToSuspension(job *schedulingapi.JobInfo, task *schedulingapi.TaskInfo, podgroup *vcv1beta1.PodGroup, reason string) bool {
+	if !job.SuspendOnReclaim || reason != reclaimEvictionReason {
+		return false
+	}
+	if deadline, found := sc.suspensionRequests[job.UID]; found {
+		if time.Now().Before(deadline) {
+			return true
+		}
+		klog.V(3).Infof("Job <%s/%s> is not suspended in %v, evict Task <%s/%s> as usual.",
+			job.Namespace, job.Name, suspensionTimeout, task.Namespace, task.Name)
+		sc.forgetSuspensionRequest(job.UID)
+		return false
+	}
+	// The suspension has been requested but timed out, or before the scheduler restarts, the tasks are evicted as
+	// usual while the job controller may still suspend the job by the request.
+	if _, found := podgroup.Annotations[vcv1beta1.SuspendRequestedAnnotationKey]; found {
+		klog.V(4).Infof("Job <%s/%s> has been requested to suspend, evict Task <%s/%s> as usual.",
+			job.Namespace, job.Name, task.Namespace, task.Name)
+		return false
+	}
+	sc.suspensionRequests[job.UID] = time.Now().Add(suspensionTimeout)
+
+	klog.V(3).Infof("Request Job <%s/%s> to suspend instead of evicting Task <%s/%s>, because of %v.",
+		job.Namespace, job.Name, task.Namespace, task.Name, reason)
+	sc.Recorder.Eventf(podgroup, v1.EventTypeNormal, SuspendRequestedReason,
+		"Job is requested to suspend instead of evicting pod %s, because of %v", task.Name, reason)
+
+	go sc.requestSuspension(job.UID, task, podgroup, reason)
+	return true
+}
+
+// forgetSuspensionRequest drops the suspension request of the job once the job is suspended, completed or deleted, or
+// the request times out. Assumes that lock is already acquired.
+func (sc *SchedulerCache) forgetSuspensionRequest(jobID schedulingapi.JobID) {
+	delete(sc.suspensionRequests, jobID)
+}
+
+// requestSuspension annotates the PodGroup with the suspension request, the task is evicted at once if the PodGroup
+// can not be annotated.
+func (sc *SchedulerCache) requestSuspension(jobID schedulingapi.JobID, task *schedulingapi.TaskInfo, podgroup *vcv1beta1.PodGroup, reason string) {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{vcv1beta1.SuspendRequestedAnnotationKey: reason},
+		},
+	})
+	if err == nil {
+		_, err = sc.vcClient.SchedulingV1beta1().PodGroups(podgroup.Namespace).Patch(context.TODO(), podgroup.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	}
+	if err == nil {
+		return
+	}
+
+	klog.Errorf("Failed to request Job of PodGroup <%s/%s> to suspend, evict Task <%s/%s> at once: %v",
+		podgroup.Namespace, podgroup.Name, task.Namespace, task.Name, err)
+	sc.Mutex.Lock()
+	sc.forgetSuspensionRequest(jobID)
+	sc.Mutex.Unlock()
+	if err := sc.Evictor.Evict(task.Pod, reason); err != nil {
+		sc.resyncTask(task)
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+
+	vcv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestSuspendOnReclaim(t *testing.T) {
+	tests := []struct {
+		name             string
+		suspendOnReclaim bool
+		reason           string
+		expectSuspended  bool
+	}{
+		{
+			name:   "evict at once if the job does not opt in",
+			reason: "reclaim",
+		},
+		{
+			name:             "evict at once if the task is preempted",
+			suspendOnReclaim: true,
+			reason:           "preempt",
+		},
+		{
+			name:             "suspend the job if the task is reclaimed",
+			suspendOnReclaim: true,
+			reason:           "reclaim",
+			expectSuspended:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sc := NewDefaultMockSchedulerCache("volcano")
+			evictor := util.NewFakeEvictor(1)
+			sc.Evictor = evictor
+
+			pod := buildPod("c1", "p1", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), nil, make(map[string]string))
+			pod.Annotations = map[string]string{vcv1beta1.KubeGroupNameAnnotationKey: "pg1"}
+			pg := &vcv1beta1.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "pg1", Namespace: "c1", Annotations: map[string]string{}},
+				Spec:       vcv1beta1.PodGroupSpec{Queue: "default"},
+			}
+			if test.suspendOnReclaim {
+				pg.Annotations[vcv1beta1.SuspendOnReclaimAnnotationKey] = "true"
+			}
+			if _, err := sc.kubeClient.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("failed to create pod: %v", err)
+			}
+			if _, err := sc.vcClient.SchedulingV1beta1().PodGroups(pg.Namespace).Create(context.TODO(), pg, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("failed to create podgroup: %v", err)
+			}
+			if err := sc.AddOrUpdateNode(buildNode("n1", api.BuildResourceList("2", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...))); err != nil {
+				t.Fatalf("failed to add node: %v", err)
+			}
+			sc.AddPodGroupV1beta1(pg)
+			sc.AddPod(pod)
+
+			if err := sc.Evict(api.NewTaskInfo(pod), test.reason); err != nil {
+				t.Fatalf("failed to evict task: %v", err)
+			}
+			if !test.expectSuspended {
+				if key := <-evictor.Channel; key != "c1/p1" {
+					t.Fatalf("expected c1/p1 to be evicted, got %s", key)
+				}
+				return
+			}
+
+			// the podgroup is annotated with the suspension request asynchronously
+			err := wait.PollUntilContextTimeout(context.TODO(), 10*time.Millisecond, time.Second, true, func(ctx context.Context) (bool, error) {
+				p, err := sc.vcClient.SchedulingV1beta1().PodGroups(pg.Namespace).Get(ctx, pg.Name, metav1.GetOptions{})
+				if err != nil {
+					return false, err
+				}
+				return p.Annotations[vcv1beta1.SuspendRequestedAnnotationKey] == test.reason, nil
+			})
+			if err != nil {
+				t.Fatalf("expected job to be requested to suspend: %v", err)
+			}
+			if evictor.Length() != 0 {
+				t.Fatalf("expected the pods to be left to the job controller, got evicted %v", evictor.Evicts())
+			}
+		})
+	}
+}
+
+func TestSuspensionRequestDefersEviction(t *testing.T) {
+	tests := []struct {
+		name          string
+		deadline      *time.Time
+		suspended     bool
+		completed     bool
+		expectEvicted bool
+	}{
+		{
+			name:     "keep deferring until the job is suspended",
+			deadline: ptr.To(time.Now().Add(time.Minute)),
+		},
+		{
+			name:          "evict once the suspension times out",
+			deadline:      ptr.To(time.Now().Add(-time.Second)),
+			expectEvicted: true,
+		},
+		{
+			name:          "evict if the request is not waited for, e.g. before the scheduler restarts",
+			expectEvicted: true,
+		},
+		{
+			name:      "forget the request once the job is suspended",
+			deadline:  ptr.To(time.Now().Add(time.Minute)),
+			suspended: true,
+		},
+		{
+			name:      "forget the request once the job is completed",
+			deadline:  ptr.To(time.Now().Add(time.Minute)),
+			completed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sc := NewDefaultMockSchedulerCache("volcano")
+			pod := buildPod("c1", "p1", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), nil, make(map[string]string))
+			pg := &vcv1beta1.PodGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "pg1", Namespace: "c1", Annotations: map[string]string{
+					vcv1beta1.SuspendOnReclaimAnnotationKey: "true",
+					vcv1beta1.SuspendRequestedAnnotationKey: "reclaim",
+				}},
+				Spec: vcv1beta1.PodGroupSpec{Queue: "default"},
+			}
+			sc.AddPodGroupV1beta1(pg)
+			job := sc.Jobs["c1/pg1"]
+			if test.deadline != nil {
+				sc.suspensionRequests[job.UID] = *test.deadline
+			}
+
+			if test.suspended || test.completed {
+				updated := pg.DeepCopy()
+				updated.ResourceVersion = "2"
+				if test.suspended {
+					delete(updated.Annotations, vcv1beta1.SuspendRequestedAnnotationKey)
+					updated.Annotations[vcv1beta1.SuspendedAnnotationKey] = "true"
+				} else {
+					updated.Status.Phase = vcv1beta1.PodGroupCompleted
+				}
+				sc.UpdatePodGroupV1beta1(pg, updated)
+				if _, found := sc.suspensionRequests[job.UID]; found {
+					t.Fatalf("expected the suspension request to be forgotten")
+				}
+				return
+			}
+
+			// The request timed out is forgotten, and the later tasks of the job are evicted as usual as well.
+			for i := 0; i < 2; i++ {
+				deferred := sc.deferEvictionToSuspension(job, api.NewTaskInfo(pod), pg, "reclaim")
+				if deferred == test.expectEvicted {
+					t.Errorf("expected task evicted %v, got deferred %v", test.expectEvicted, deferred)
+				}
+			}
+			if _, found := sc.suspensionRequests[job.UID]; found == test.expectEvicted {
+				t.Errorf("expected the suspension request kept %v, got %v", !test.expectEvicted, found)
+			}
+		})
+	}
+}
+
+func TestSnapshotSkipsSuspendedJob(t *testing.T) {
+	sc := NewDefaultMockSchedulerCache("volcano")
+	sc.AddQueueV1beta1(&vcv1beta1.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec:       vcv1beta1.QueueSpec{Weight: 1},
+	})
+	for _, name := range []string{"pg1", "pg2"} {
+		pg := &vcv1beta1.PodGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "c1", Annotations: map[string]string{}},
+			Spec:       vcv1beta1.PodGroupSpec{Queue: "default"},
+		}
+		if name == "pg2" {
+			pg.Annotations[vcv1beta1.SuspendedAnnotationKey] = "true"
+		}
+		sc.AddPodGroupV1beta1(pg)
+	}
+
+	snapshot := sc.Snapshot()
+	if _, found := snapshot.Jobs["c1/pg1"]; !found {
+		t.Errorf("expected job c1/pg1 in the snapshot")
+	}
+	if _, found := snapshot.Jobs["c1/pg2"]; found {
+		t.Errorf("expected suspended job c1/pg2 not in the snapshot")
+	}
+}
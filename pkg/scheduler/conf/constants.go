@@ -19,4 +19,24 @@ package conf
 const (
 	// EnablePredicateErrCacheKey is the key whether predicate error cache is enabled
 	EnablePredicateErrCacheKey = "predicateErrorCacheEnable"
+	// EnableBackfillReservationKey is the key whether backfill plans reservation for the blocked gang job
+	EnableBackfillReservationKey = "reservationEnable"
+	// EnableGangPreemptionKey is the key whether preempt and reclaim evict the victim jobs atomically for gang-scheduling
+	EnableGangPreemptionKey = "gangPreemptionEnable"
+
+	// EvictionDryRunKey is the key whether the action only records the evictions as events and metrics without evicting
+	EvictionDryRunKey = "evictionDryRun"
+	// EvictionMaxPerCycleKey is the key of the maximum evictions of the action in the cluster per scheduling cycle
+	EvictionMaxPerCycleKey = "evictionBudget.maxPerCycle"
+	// EvictionMaxPerWindowKey is the key of the maximum evictions of the action in the cluster per time window
+	EvictionMaxPerWindowKey = "evictionBudget.maxPerWindow"
+	// EvictionQueueMaxPerCycleKey is the key of the maximum evictions of the action in a queue per scheduling cycle
+	EvictionQueueMaxPerCycleKey = "evictionBudget.queueMaxPerCycle"
+	// EvictionQueueMaxPerWindowKey is the key of the maximum evictions of the action in a queue per time window
+	EvictionQueueMaxPerWindowKey = "evictionBudget.queueMaxPerWindow"
+	// EvictionWindowKey is the key of the time window of the eviction budget, e.g. "10m"
+	EvictionWindowKey = "evictionBudget.window"
+	// EvictionMaxMinRuntimeKey is the key of the cap of the min runtime of the jobs honored by the action, e.g. "2h",
+	// the min runtime of the jobs is ignored if it is not set
+	EvictionMaxMinRuntimeKey = "evictionMaxMinRuntime"
 )
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package explain
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	"k8s.io/klog/v2"
+)
+
+// PathPrefix is the path of the endpoint serving the decision of a job as /explain/jobs/<namespace>/<podgroup>.
+const PathPrefix = "/explain/jobs/"
+
+// NoDecisionMessage prefixes the not found message of a job without decision in the last session, which tells it
+// apart from the endpoint not being served.
+const NoDecisionMessage = "no decision of job "
+
+// DefaultStore keeps the decisions of the scheduler, it is disabled unless the endpoint is served.
+var DefaultStore = NewStore()
+
+// Store keeps the decisions of the jobs in the last scheduling session.
+type Store struct {
+	mu        sync.RWMutex
+	enabled   bool
+	decisions map[string]*JobDecision
+}
+
+// NewStore returns a disabled Store.
+func NewStore() *Store {
+	return &Store{decisions: map[string]*JobDecision{}}
+}
+
+func key(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// Enable enables the store, the scheduler only records the decisions if the store is enabled.
+func (s *Store) Enable() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.enabled = true
+}
+
+// Enabled checks whether the store is enabled.
+func (s *Store) Enabled() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.enabled
+}
+
+// Replace replaces all the decisions with the ones of the last session, the jobs not in the session are dropped.
+func (s *Store) Replace(decisions []*JobDecision) {
+	m := make(map[string]*JobDecision, len(decisions))
+	for _, d := range decisions {
+		m[key(d.Namespace, d.Name)] = d
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.decisions = m
+}
+
+// Get returns the decision of the job by the namespace and name of its PodGroup.
+func (s *Store) Get(namespace, name string) (*JobDecision, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	d, found := s.decisions[key(namespace, name)]
+	return d, found
+}
+
+// ServeHTTP serves the decision of a job as JSON.
+func (s *Store) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, PathPrefix), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		http.Error(w, "expected path "+PathPrefix+"<namespace>/<podgroup>", http.StatusBadRequest)
+		return
+	}
+
+	decision, found := s.Get(parts[0], parts[1])
+	if !found {
+		http.Error(w, NoDecisionMessage+key(parts[0], parts[1])+" in the last session", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(decision); err != nil {
+		klog.Errorf("Failed to encode the decision of job %s/%s: %v", parts[0], parts[1], err)
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package explain
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStoreServeHTTP(t *testing.T) {
+	store := NewStore()
+	store.Replace([]*JobDecision{{Namespace: "ns1", Name: "pg1", Queue: "q1"}})
+	store.Replace([]*JobDecision{{Namespace: "ns1", Name: "pg2", Queue: "q1", Rejections: []Rejection{{Stage: JobValidStage, Plugin: "gang"}}}})
+
+	testCases := []struct {
+		name         string
+		method       string
+		path         string
+		expectedCode int
+	}{
+		{name: "decision of job in the last session", method: http.MethodGet, path: PathPrefix + "ns1/pg2", expectedCode: http.StatusOK},
+		{name: "decision of job not in the last session", method: http.MethodGet, path: PathPrefix + "ns1/pg1", expectedCode: http.StatusNotFound},
+		{name: "invalid path", method: http.MethodGet, path: PathPrefix + "ns1", expectedCode: http.StatusBadRequest},
+		{name: "invalid method", method: http.MethodPost, path: PathPrefix + "ns1/pg2", expectedCode: http.StatusMethodNotAllowed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			store.ServeHTTP(recorder, httptest.NewRequest(tc.method, tc.path, nil))
+			if recorder.Code != tc.expectedCode {
+				t.Fatalf("expected code %d, got %d: %s", tc.expectedCode, recorder.Code, recorder.Body.String())
+			}
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			decision := &JobDecision{}
+			if err := json.Unmarshal(recorder.Body.Bytes(), decision); err != nil {
+				t.Fatalf("failed to decode decision: %v", err)
+			}
+			if decision.Name != "pg2" || len(decision.Rejections) != 1 || decision.Rejections[0].Plugin != "gang" {
+				t.Errorf("unexpected decision %+v", decision)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package explain keeps the decisions the scheduler made on each job in the last session,
+// so that users could find out why their jobs are pending.
+package explain
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// Stage is the extension point of the scheduling cycle at which a job is rejected.
+type Stage string
+
+const (
+	// JobValidStage means the job is rejected by the JobValid function of a plugin, e.g. gang.
+	JobValidStage Stage = "JobValid"
+	// JobEnqueueableStage means the job is rejected by the JobEnqueueable function of a plugin.
+	JobEnqueueableStage Stage = "JobEnqueueable"
+	// OverusedStage means the queue of the job is overused according to a plugin.
+	OverusedStage Stage = "Overused"
+	// AllocatableStage means the tasks of the job are not allocatable in the queue according to a plugin.
+	AllocatableStage Stage = "Allocatable"
+)
+
+// JobDecision is the decision trail of a job in the last scheduling session.
+type JobDecision struct {
+	// Namespace and Name of the PodGroup of the job.
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Queue     string `json:"queue"`
+	// Phase is the phase of the PodGroup when the session is closed.
+	Phase string `json:"phase"`
+	// Message is the message of the Unschedulable condition of the PodGroup updated in the session.
+	Message string `json:"message,omitempty"`
+
+	SessionID string    `json:"sessionID"`
+	Timestamp time.Time `json:"timestamp"`
+
+	// PendingTasks is the number of tasks of the job which are not allocated.
+	PendingTasks int `json:"pendingTasks"`
+
+	Rejections []Rejection        `json:"rejections,omitempty"`
+	Predicates []PredicateFailure `json:"predicates,omitempty"`
+	QueueShare *QueueShare        `json:"queueShare,omitempty"`
+}
+
+// Rejection is the rejection of the job by a plugin at a stage of the scheduling cycle.
+type Rejection struct {
+	Stage   Stage  `json:"stage"`
+	Plugin  string `json:"plugin"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// PredicateFailure is a reason the tasks of the job failed the predicates of a plugin on the nodes.
+type PredicateFailure struct {
+	Plugin string `json:"plugin,omitempty"`
+	Reason string `json:"reason"`
+	// Nodes is the number of nodes on which the tasks of the job failed for the reason.
+	Nodes int `json:"nodes"`
+	// Tasks is the number of tasks of the job which failed for the reason.
+	Tasks int `json:"tasks"`
+}
+
+// QueueShare is the share of the queue of the job in the session.
+type QueueShare struct {
+	Queue     string          `json:"queue"`
+	Deserved  v1.ResourceList `json:"deserved,omitempty"`
+	Allocated v1.ResourceList `json:"allocated,omitempty"`
+}
@@ -23,6 +23,8 @@ limitations under the License.
 package framework
 
 import (
+	"fmt"
+
 	"github.com/mitchellh/mapstructure"
 	"k8s.io/klog/v2"
 
@@ -116,6 +118,45 @@ func (a Arguments) GetString(ptr *string, key string) {
 	*ptr = value
 }
 
+// ValidateInt checks the value of the key is an integer not less than min if it is given
+func (a Arguments) ValidateInt(key string, min int) error {
+	argv, ok := a[key]
+	if !ok {
+		return nil
+	}
+
+	value, ok := argv.(int)
+	if !ok {
+		return fmt.Errorf("argument %s must be an integer, got %v", key, argv)
+	}
+	if value < min {
+		return fmt.Errorf("argument %s must not be less than %d, got %d", key, min, value)
+	}
+	return nil
+}
+
+// ValidateFloat64 checks the value of the key is a number not less than min if it is given
+func (a Arguments) ValidateFloat64(key string, min float64) error {
+	argv, ok := a[key]
+	if !ok {
+		return nil
+	}
+
+	var value float64
+	switch v := argv.(type) {
+	case float64:
+		value = v
+	case int:
+		value = float64(v)
+	default:
+		return fmt.Errorf("argument %s must be a number, got %v", key, argv)
+	}
+	if value < min {
+		return fmt.Errorf("argument %s must not be less than %v, got %v", key, min, value)
+	}
+	return nil
+}
+
 // Get can automatically convert parameters according to the passed generic T type.
 // If the parameter conversion is successful, it returns the converted parameter.
 // If the parameter does not exist, it returns false.
@@ -135,6 +176,20 @@ func Get[T any](a Arguments, key string) (T, bool) {
 	return result, true
 }
 
+// Validate checks the value of the key can be converted to the type T by Get if it is given.
+func Validate[T any](a Arguments, key string) error {
+	argv, ok := a[key]
+	if !ok {
+		return nil
+	}
+
+	var result T
+	if err := mapstructure.Decode(argv, &result); err != nil {
+		return fmt.Errorf("argument %s can not be converted to type %T: %v", key, result, err)
+	}
+	return nil
+}
+
 // GetArgOfActionFromConf return argument of action reading from configuration of schedule
 func GetArgOfActionFromConf(configurations []conf.Configuration, actionName string) Arguments {
 	for _, c := range configurations {
@@ -248,3 +248,38 @@ func TestArgumentsGetString(t *testing.T) {
 		}
 	}
 }
+
+func TestArgumentsValidate(t *testing.T) {
+	args := Arguments{
+		"int":      5,
+		"negative": -1,
+		"float":    1.5,
+		"string":   "value",
+		"list":     []interface{}{"a", "b"},
+	}
+
+	cases := []struct {
+		name      string
+		validate  func() error
+		expectErr bool
+	}{
+		{name: "missing int", validate: func() error { return args.ValidateInt("missing", 0) }},
+		{name: "valid int", validate: func() error { return args.ValidateInt("int", 0) }},
+		{name: "int less than min", validate: func() error { return args.ValidateInt("negative", 0) }, expectErr: true},
+		{name: "int of wrong type", validate: func() error { return args.ValidateInt("float", 0) }, expectErr: true},
+		{name: "valid float", validate: func() error { return args.ValidateFloat64("float", 1.0) }},
+		{name: "int as float", validate: func() error { return args.ValidateFloat64("int", 1.0) }},
+		{name: "float less than min", validate: func() error { return args.ValidateFloat64("float", 2.0) }, expectErr: true},
+		{name: "float of wrong type", validate: func() error { return args.ValidateFloat64("string", 0) }, expectErr: true},
+		{name: "convertible type", validate: func() error { return Validate[[]string](args, "list") }},
+		{name: "inconvertible type", validate: func() error { return Validate[map[string]int](args, "list") }, expectErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if err := c.validate(); (err != nil) != c.expectErr {
+				t.Errorf("expected error %v, got %v", c.expectErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+// backfillActionName is the name of the action whose configuration enables the backfill reservation.
+const backfillActionName = "backfill"
+
+// releaseEvent is the estimated release of the resources of a running task.
+type releaseEvent struct {
+	node    string
+	endTime time.Time
+	resreq  *api.Resource
+}
+
+// BackfillReservation returns the reservation planned for the blocked gang job in the session, nil means no reservation.
+// The reservation is planned lazily on the first call, so the sessions without backfill or allocation pay nothing for it.
+func (ssn *Session) BackfillReservation() *api.BackfillReservation {
+	if !ssn.enableBackfillReservation {
+		return nil
+	}
+	ssn.planBackfillOnce.Do(func() {
+		ssn.backfillReservation = ssn.planBackfillReservation(time.Now())
+	})
+	return ssn.backfillReservation
+}
+
+// backfillReservationEnabled checks whether the backfill reservation is enabled in the configuration of backfill action.
+func backfillReservationEnabled(configurations []conf.Configuration) bool {
+	enabled := false
+	GetArgOfActionFromConf(configurations, backfillActionName).GetBool(&enabled, conf.EnableBackfillReservationKey)
+	return enabled
+}
+
+// planBackfillReservation plans the reservation for the highest-priority blocked gang job, following EASY backfill:
+// the resources of the running tasks are released one by one in the order of their estimated end times until the
+// blocked job fits, which gives the start time and the nodes of the reservation. Tasks of jobs without running
+// estimate are taken as never ending, so no reservation is planned if the blocked job can not fit without them.
+func (ssn *Session) planBackfillReservation(now time.Time) *api.BackfillReservation {
+	job := ssn.blockedGangJob()
+	if job == nil {
+		return nil
+	}
+
+	tasks := ssn.reservedTasks(job)
+	if len(tasks) == 0 {
+		return nil
+	}
+	for _, task := range tasks {
+		if err := ssn.PrePredicateFn(task); err != nil {
+			klog.V(4).Infof("PrePredicate for task %s/%s failed in backfill reservation for: %v", task.Namespace, task.Name, err)
+			return nil
+		}
+	}
+
+	idle := map[string]*api.Resource{}
+	for _, node := range ssn.NodeList {
+		if node.Ready() {
+			idle[node.Name] = node.FutureIdle()
+		}
+	}
+
+	var events []*releaseEvent
+	for _, runningJob := range ssn.Jobs {
+		if runningJob.RunningEstimate == nil {
+			continue
+		}
+		for _, status := range []api.TaskStatus{api.Bound, api.Binding, api.Running} {
+			for _, task := range runningJob.TaskStatusIndex[status] {
+				if _, found := idle[task.NodeName]; !found {
+					continue
+				}
+				startTime := now
+				if task.Pod != nil && task.Pod.Status.StartTime != nil {
+					startTime = task.Pod.Status.StartTime.Time
+				}
+				endTime := startTime.Add(*runningJob.RunningEstimate)
+				if endTime.Before(now) {
+					endTime = now
+				}
+				events = append(events, &releaseEvent{node: task.NodeName, endTime: endTime, resreq: task.Resreq})
+			}
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].endTime.Before(events[j].endTime)
+	})
+
+	fits := map[api.TaskID]map[string]bool{}
+	startTime := now
+	for i := 0; ; {
+		if nodes := ssn.fitReservedTasks(tasks, idle, fits); nodes != nil {
+			klog.V(3).Infof("Reserve nodes %v for job <%s/%s> at %v in backfill.",
+				sets.List(nodes), job.Namespace, job.Name, startTime.Format(time.RFC3339))
+			return &api.BackfillReservation{Job: job.UID, StartTime: startTime, Nodes: nodes}
+		}
+		if i == len(events) {
+			klog.V(4).Infof("Job <%s/%s> could not fit when all the estimated tasks end, skip reservation.",
+				job.Namespace, job.Name)
+			return nil
+		}
+
+		startTime = events[i].endTime
+		for ; i < len(events) && !events[i].endTime.After(startTime); i++ {
+			idle[events[i].node].Add(events[i].resreq)
+		}
+	}
+}
+
+// blockedGangJob returns the highest-priority gang job which is enqueued but not ready.
+func (ssn *Session) blockedGangJob() *api.JobInfo {
+	var blocked *api.JobInfo
+	for _, job := range ssn.Jobs {
+		if job.PodGroup == nil || job.PodGroup.Status.Phase != scheduling.PodGroupInqueue {
+			continue
+		}
+		if job.MinAvailable <= 1 || !job.HasPendingTasks() || ssn.JobReady(job) {
+			continue
+		}
+		if _, found := ssn.Queues[job.Queue]; !found {
+			continue
+		}
+		if blocked == nil || ssn.JobOrderFn(job, blocked) {
+			blocked = job
+		}
+	}
+	return blocked
+}
+
+// reservedTasks returns the pending tasks which are needed for the job to be ready.
+func (ssn *Session) reservedTasks(job *api.JobInfo) []*api.TaskInfo {
+	var tasks []*api.TaskInfo
+	for _, task := range job.TaskStatusIndex[api.Pending] {
+		if task.BestEffort || task.SchGated {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return ssn.TaskOrderFn(tasks[i], tasks[j])
+	})
+
+	if needed := int(job.MinAvailable - job.ReadyTaskNum() - job.WaitingTaskNum()); needed > 0 && needed < len(tasks) {
+		tasks = tasks[:needed]
+	}
+	return tasks
+}
+
+// fitReservedTasks places the tasks on the nodes by their idle resources, and returns the nodes used,
+// nil means the tasks could not fit. The nodes which the tasks could never be placed on, e.g. by node selectors
+// or taints, are skipped by the session predicates; the failures resolved once the running tasks end are ignored.
+// The predicates do not change as the running tasks end, so they are only checked on the nodes with enough resources
+// and their results are cached in fits across the calls. The idle resources are not modified.
+func (ssn *Session) fitReservedTasks(tasks []*api.TaskInfo, idle map[string]*api.Resource, fits map[api.TaskID]map[string]bool) sets.Set[string] {
+	left := make(map[string]*api.Resource, len(idle))
+	for name, resource := range idle {
+		left[name] = resource.Clone()
+	}
+
+	used := sets.New[string]()
+	for _, task := range tasks {
+		if fits[task.UID] == nil {
+			fits[task.UID] = map[string]bool{}
+		}
+		fit := false
+		for _, node := range ssn.NodeList {
+			resource, found := left[node.Name]
+			if !found || !task.InitResreq.LessEqual(resource, api.Zero) {
+				continue
+			}
+			predicated, checked := fits[task.UID][node.Name]
+			if !checked {
+				err := ssn.PredicateForPreemptAction(task, node)
+				if err != nil {
+					klog.V(5).Infof("Task <%s/%s> could not be reserved on node <%s>: %v", task.Namespace, task.Name, node.Name, err)
+				}
+				predicated = err == nil
+				fits[task.UID][node.Name] = predicated
+			}
+			if !predicated {
+				continue
+			}
+			resource.Sub(task.InitResreq)
+			used.Insert(node.Name)
+			fit = true
+			break
+		}
+		if !fit {
+			return nil
+		}
+	}
+	return used
+}
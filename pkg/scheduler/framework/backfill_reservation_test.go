@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+func TestFitReservedTasks(t *testing.T) {
+	trueValue := true
+	calls := map[string]int{}
+	ssn := &Session{
+		Tiers: []conf.Tier{{Plugins: []conf.PluginOption{{Name: "fake", EnabledPredicate: &trueValue}}}},
+		predicateFns: map[string]api.PredicateFn{
+			"fake": func(task *api.TaskInfo, node *api.NodeInfo) error {
+				calls[string(task.UID)+"/"+node.Name]++
+				if node.Name == "n1" {
+					return fmt.Errorf("node selector mismatch")
+				}
+				return nil
+			},
+		},
+	}
+	for _, name := range []string{"n1", "n2", "n3"} {
+		ssn.NodeList = append(ssn.NodeList, &api.NodeInfo{Name: name})
+	}
+	tasks := []*api.TaskInfo{
+		{UID: "t1", InitResreq: &api.Resource{MilliCPU: 1000}},
+		{UID: "t2", InitResreq: &api.Resource{MilliCPU: 1000}},
+	}
+	idle := map[string]*api.Resource{
+		"n1": {MilliCPU: 2000},
+		"n2": {MilliCPU: 1000},
+		"n3": {},
+	}
+	fits := map[api.TaskID]map[string]bool{}
+
+	if nodes := ssn.fitReservedTasks(tasks, idle, fits); nodes != nil {
+		t.Fatalf("expected tasks not to fit, got %v", sets.List(nodes))
+	}
+	if calls["t2/n3"] != 0 {
+		t.Errorf("expected no predicate on the node without enough resources, got %v", calls)
+	}
+
+	// the resources released on n3 let the tasks fit, and the predicates are not checked again
+	idle["n3"].Add(&api.Resource{MilliCPU: 1000})
+	nodes := ssn.fitReservedTasks(tasks, idle, fits)
+	if !nodes.Equal(sets.New("n2", "n3")) {
+		t.Fatalf("expected tasks to fit on n2 and n3, got %v", sets.List(nodes))
+	}
+	for key, count := range calls {
+		if count != 1 {
+			t.Errorf("expected predicate of %s to be checked once, got %d", key, count)
+		}
+	}
+	if idle["n2"].MilliCPU != 1000 {
+		t.Errorf("expected the idle resources not to be modified, got %v", idle["n2"])
+	}
+}
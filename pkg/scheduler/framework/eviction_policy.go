@@ -0,0 +1,312 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+)
+
+const (
+	// defaultEvictionWindow is the time window of the eviction budget if the window is not set
+	defaultEvictionWindow = 10 * time.Minute
+
+	// DryRunEvictReason is the event reason of the eviction recorded in dry-run mode.
+	DryRunEvictReason = "DryRunEvict"
+)
+
+// evictionRecord is an eviction committed by an action, which is kept for the budget of the time window.
+type evictionRecord struct {
+	task  api.TaskID
+	queue api.QueueID
+	time  time.Time
+}
+
+// evictionRecords keeps the evictions committed by the actions across sessions, keyed by the action name.
+type evictionRecords struct {
+	sync.Mutex
+	records map[string][]evictionRecord
+}
+
+var (
+	// evictionHistory keeps the evictions executed by the actions.
+	evictionHistory = &evictionRecords{records: map[string][]evictionRecord{}}
+	// dryRunEvictionHistory keeps the evictions only recorded in dry-run mode apart, so that they simulate the budget
+	// of the dry-run action without draining the budget of the evictions executed by the action.
+	dryRunEvictionHistory = &evictionRecords{records: map[string][]evictionRecord{}}
+)
+
+// ResetEvictionHistory drops the evictions recorded in the history of all the actions, including the dry-run ones.
+func ResetEvictionHistory() {
+	for _, history := range []*evictionRecords{evictionHistory, dryRunEvictionHistory} {
+		history.Lock()
+		history.records = map[string][]evictionRecord{}
+		history.Unlock()
+	}
+}
+
+// EvictionPolicy limits the evictions of an action within the eviction budget of the cluster and of each queue,
+// or only records the evictions as events and metrics in dry-run mode. A limit of zero means no limit.
+type EvictionPolicy struct {
+	Action            string
+	DryRun            bool
+	MaxPerCycle       int
+	MaxPerWindow      int
+	QueueMaxPerCycle  int
+	QueueMaxPerWindow int
+	Window            time.Duration
+	// MaxMinRuntime caps the min runtime of the jobs protecting their tasks from eviction, the min runtime is
+	// ignored if it is zero
+	MaxMinRuntime time.Duration
+
+	// evictions are the tasks evicted by the action in the cycle with their queues, including the ones not committed
+	evictions map[api.TaskID]api.QueueID
+	// committed are the tasks of evictions which have been committed and recorded in the history
+	committed sets.Set[api.TaskID]
+}
+
+// NewEvictionPolicy creates the eviction policy of the action from its configuration.
+func NewEvictionPolicy(action string, configurations []conf.Configuration) *EvictionPolicy {
+	policy := &EvictionPolicy{
+		Action:    action,
+		Window:    defaultEvictionWindow,
+		evictions: map[api.TaskID]api.QueueID{},
+		committed: sets.New[api.TaskID](),
+	}
+
+	arguments := GetArgOfActionFromConf(configurations, action)
+	arguments.GetBool(&policy.DryRun, conf.EvictionDryRunKey)
+	arguments.GetInt(&policy.MaxPerCycle, conf.EvictionMaxPerCycleKey)
+	arguments.GetInt(&policy.MaxPerWindow, conf.EvictionMaxPerWindowKey)
+	arguments.GetInt(&policy.QueueMaxPerCycle, conf.EvictionQueueMaxPerCycleKey)
+	arguments.GetInt(&policy.QueueMaxPerWindow, conf.EvictionQueueMaxPerWindowKey)
+	var window string
+	arguments.GetString(&window, conf.EvictionWindowKey)
+	if window != "" {
+		if duration, err := time.ParseDuration(window); err != nil || duration <= 0 {
+			klog.Warningf("Invalid eviction budget window %q of action %s, using default value: %v.", window, action, defaultEvictionWindow)
+		} else {
+			policy.Window = duration
+		}
+	}
+	var maxMinRuntime string
+	arguments.GetString(&maxMinRuntime, conf.EvictionMaxMinRuntimeKey)
+	if maxMinRuntime != "" {
+		if duration, err := time.ParseDuration(maxMinRuntime); err != nil || duration <= 0 {
+			klog.Warningf("Invalid max min runtime %q of action %s, the min runtime of the jobs is ignored.", maxMinRuntime, action)
+		} else {
+			policy.MaxMinRuntime = duration
+		}
+	}
+	return policy
+}
+
+// SetEvictionPolicy sets the eviction policy of the action being executed, nil means the evictions are not limited.
+func (ssn *Session) SetEvictionPolicy(policy *EvictionPolicy) {
+	ssn.evictionPolicy = policy
+}
+
+// EvictionAllowed checks whether the tasks can be evicted together within the eviction budget of the current action.
+func (ssn *Session) EvictionAllowed(tasks ...*api.TaskInfo) bool {
+	policy := ssn.evictionPolicy
+	if policy == nil || (policy.MaxPerCycle <= 0 && policy.MaxPerWindow <= 0 &&
+		policy.QueueMaxPerCycle <= 0 && policy.QueueMaxPerWindow <= 0) {
+		return true
+	}
+
+	cycle := map[api.QueueID]int{}
+	for _, queue := range policy.evictions {
+		cycle[queue]++
+	}
+	window, recorded := policy.windowEvictions(time.Now())
+	for uid, queue := range policy.evictions {
+		if !policy.committed.Has(uid) && !recorded.Has(uid) {
+			window[queue]++
+		}
+	}
+	cycleTotal, windowTotal := len(policy.evictions), 0
+	for _, count := range window {
+		windowTotal += count
+	}
+
+	for _, task := range tasks {
+		if _, found := policy.evictions[task.UID]; found {
+			continue
+		}
+		queue := ssn.jobQueue(task)
+		cycle[queue]++
+		cycleTotal++
+		if !recorded.Has(task.UID) {
+			window[queue]++
+			windowTotal++
+		}
+		if exceeded(cycleTotal, policy.MaxPerCycle) || exceeded(windowTotal, policy.MaxPerWindow) ||
+			exceeded(cycle[queue], policy.QueueMaxPerCycle) || exceeded(window[queue], policy.QueueMaxPerWindow) {
+			klog.V(3).Infof("Eviction budget of action %s is exhausted, skip evicting Task <%s/%s> in Queue <%s>.",
+				policy.Action, task.Namespace, task.Name, queue)
+			metrics.RegisterEvictionThrottled(policy.Action, string(queue))
+			return false
+		}
+	}
+	return true
+}
+
+func exceeded(count, limit int) bool {
+	return limit > 0 && count > limit
+}
+
+// history returns the history which the evictions of the action are recorded in.
+func (policy *EvictionPolicy) history() *evictionRecords {
+	if policy.DryRun {
+		return dryRunEvictionHistory
+	}
+	return evictionHistory
+}
+
+// windowEvictions returns the evictions of the action in the time window by queue and the tasks evicted in the window,
+// the records older than the window are dropped.
+func (policy *EvictionPolicy) windowEvictions(now time.Time) (map[api.QueueID]int, sets.Set[api.TaskID]) {
+	history := policy.history()
+	history.Lock()
+	defer history.Unlock()
+
+	counts := map[api.QueueID]int{}
+	tasks := sets.New[api.TaskID]()
+	var records []evictionRecord
+	for _, record := range history.records[policy.Action] {
+		if now.Sub(record.time) >= policy.Window {
+			continue
+		}
+		records = append(records, record)
+		counts[record.queue]++
+		if record.task != "" {
+			tasks.Insert(record.task)
+		}
+	}
+	history.records[policy.Action] = records
+	return counts, tasks
+}
+
+// record records the eviction of the task in the history. The task which is only evicted in dry-run mode keeps running,
+// so it may be picked again in the later cycles, which is recorded once in the time window; it returns false if the
+// eviction of the task has been recorded in the window.
+func (policy *EvictionPolicy) record(task api.TaskID, queue api.QueueID, now time.Time) bool {
+	history := policy.history()
+	history.Lock()
+	defer history.Unlock()
+
+	if policy.DryRun {
+		for _, record := range history.records[policy.Action] {
+			if record.task == task && now.Sub(record.time) < policy.Window {
+				return false
+			}
+		}
+	}
+	history.records[policy.Action] = append(history.records[policy.Action], evictionRecord{task: task, queue: queue, time: now})
+	return true
+}
+
+// evicting records the task evicted in the session, which is counted in the budget before it is committed.
+func (policy *EvictionPolicy) evicting(task *api.TaskInfo, queue api.QueueID) {
+	if policy == nil {
+		return
+	}
+	policy.evictions[task.UID] = queue
+}
+
+// unevicting removes the task whose eviction is discarded.
+func (policy *EvictionPolicy) unevicting(task *api.TaskInfo) {
+	if policy == nil || policy.committed.Has(task.UID) {
+		return
+	}
+	delete(policy.evictions, task.UID)
+}
+
+// evicted records the committed eviction of the task in the history, it returns false if the eviction has been
+// recorded before.
+func (policy *EvictionPolicy) evicted(task *api.TaskInfo, queue api.QueueID, now time.Time) bool {
+	if policy == nil {
+		return true
+	}
+	policy.evictions[task.UID] = queue
+	if policy.committed.Has(task.UID) {
+		return false
+	}
+	policy.committed.Insert(task.UID)
+
+	if !policy.record(task.UID, queue, now) {
+		return false
+	}
+	metrics.RegisterEviction(policy.Action, string(queue), policy.DryRun)
+	return true
+}
+
+// dryRun checks whether the evictions are only recorded without evicting.
+func (policy *EvictionPolicy) dryRun() bool {
+	return policy != nil && policy.DryRun
+}
+
+// jobQueue returns the queue of the job of the task, which the eviction of the task is counted in.
+func (ssn *Session) jobQueue(task *api.TaskInfo) api.QueueID {
+	if job, found := ssn.Jobs[task.Job]; found {
+		return job.Queue
+	}
+	return ""
+}
+
+// recordDryRunEviction records the eviction of the task as an event of its pod instead of evicting it. The task keeps
+// running and may be picked again in the later cycles, whose event is only recorded once in the time window.
+func (ssn *Session) recordDryRunEviction(task *api.TaskInfo, reason string) {
+	klog.V(3).Infof("Task <%s/%s> would be evicted by action %s for %s in dry-run mode.",
+		task.Namespace, task.Name, ssn.evictionPolicy.Action, reason)
+	if !ssn.evictionPolicy.evicted(task, ssn.jobQueue(task), time.Now()) {
+		return
+	}
+	if task.Pod != nil {
+		ssn.recorder.Eventf(task.Pod, v1.EventTypeNormal, DryRunEvictReason,
+			"Pod would be evicted by action %s for %s", ssn.evictionPolicy.Action, reason)
+	}
+}
+
+// evictableTasks filters out the tasks protected from eviction by the minimum running duration of their jobs, which
+// is capped by the eviction policy of the current action.
+func (ssn *Session) evictableTasks(tasks []*api.TaskInfo) []*api.TaskInfo {
+	policy := ssn.evictionPolicy
+	if policy == nil || policy.MaxMinRuntime <= 0 {
+		return tasks
+	}
+
+	now := time.Now()
+	evictable := make([]*api.TaskInfo, 0, len(tasks))
+	for _, task := range tasks {
+		if job, found := ssn.Jobs[task.Job]; found && job.EvictionProtected(task, policy.MaxMinRuntime, now) {
+			klog.V(4).Infof("Task <%s/%s> is protected from eviction by the min runtime %v of its job.",
+				task.Namespace, task.Name, min(*job.MinRuntime, policy.MaxMinRuntime))
+			continue
+		}
+		evictable = append(evictable, task)
+	}
+	return evictable
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+)
+
+func TestEvictionAllowed(t *testing.T) {
+	ssn := &Session{
+		Jobs: map[api.JobID]*api.JobInfo{
+			"ns/job1": api.NewJobInfo("ns/job1"),
+			"ns/job2": api.NewJobInfo("ns/job2"),
+		},
+		Nodes: map[string]*api.NodeInfo{},
+	}
+	ssn.Jobs["ns/job1"].Queue = "q1"
+	ssn.Jobs["ns/job2"].Queue = "q2"
+
+	buildTask := func(name string, job api.JobID) *api.TaskInfo {
+		task := &api.TaskInfo{UID: api.TaskID(name), Name: name, Namespace: "ns", Job: job, Resreq: api.EmptyResource(), InitResreq: api.EmptyResource()}
+		task.Status = api.Running
+		ssn.Jobs[job].AddTaskInfo(task)
+		return task
+	}
+	task1, task2, task3 := buildTask("task1", "ns/job1"), buildTask("task2", "ns/job1"), buildTask("task3", "ns/job2")
+
+	ssn.SetEvictionPolicy(NewEvictionPolicy("test", []conf.Configuration{{
+		Name: "test",
+		Arguments: map[string]interface{}{
+			conf.EvictionMaxPerCycleKey:      2,
+			conf.EvictionQueueMaxPerCycleKey: 1,
+		},
+	}}))
+
+	if !ssn.EvictionAllowed(task1) {
+		t.Fatalf("expected task1 to be allowed to evict")
+	}
+	if ssn.EvictionAllowed(task1, task2) {
+		t.Errorf("expected task1 and task2 not to be allowed to evict together by the budget of queue q1")
+	}
+
+	stmt := NewStatement(ssn)
+	stmt.Evict(task1, "test")
+	if ssn.EvictionAllowed(task2) {
+		t.Errorf("expected task2 not to be allowed to evict after task1 is evicted")
+	}
+	if !ssn.EvictionAllowed(task3) {
+		t.Errorf("expected task3 to be allowed to evict in queue q2")
+	}
+
+	stmt.Discard()
+	if !ssn.EvictionAllowed(task2) {
+		t.Errorf("expected task2 to be allowed to evict after the eviction of task1 is discarded")
+	}
+}
+
+func TestEvictionBudgetWindow(t *testing.T) {
+	ResetEvictionHistory()
+	defer ResetEvictionHistory()
+
+	ssn := &Session{
+		Jobs:  map[api.JobID]*api.JobInfo{"ns/job1": api.NewJobInfo("ns/job1")},
+		Nodes: map[string]*api.NodeInfo{},
+	}
+	ssn.Jobs["ns/job1"].Queue = "q1"
+	task := &api.TaskInfo{UID: "task1", Name: "task1", Namespace: "ns", Job: "ns/job1", Resreq: api.EmptyResource(), InitResreq: api.EmptyResource()}
+	task.Status = api.Running
+	ssn.Jobs["ns/job1"].AddTaskInfo(task)
+
+	configurations := []conf.Configuration{{
+		Name:      "test",
+		Arguments: map[string]interface{}{conf.EvictionMaxPerWindowKey: 2, conf.EvictionWindowKey: "10m"},
+	}}
+	policy := NewEvictionPolicy("test", configurations)
+	now := time.Now()
+	// The eviction out of the window is not counted in the budget.
+	policy.record("evicted1", "q1", now.Add(-time.Hour))
+	policy.record("evicted2", "q1", now)
+
+	ssn.SetEvictionPolicy(NewEvictionPolicy("test", configurations))
+	if !ssn.EvictionAllowed(task) {
+		t.Fatalf("expected task1 to be allowed to evict within the budget of the window")
+	}
+
+	policy.record("evicted3", "q1", now)
+	ssn.SetEvictionPolicy(NewEvictionPolicy("test", configurations))
+	if ssn.EvictionAllowed(task) {
+		t.Errorf("expected task1 not to be allowed to evict out of the budget of the window")
+	}
+}
+
+func TestDryRunEviction(t *testing.T) {
+	ResetEvictionHistory()
+	defer ResetEvictionHistory()
+
+	recorder := record.NewFakeRecorder(10)
+	ssn := &Session{
+		Jobs:     map[api.JobID]*api.JobInfo{"ns/job1": api.NewJobInfo("ns/job1")},
+		Nodes:    map[string]*api.NodeInfo{},
+		recorder: recorder,
+	}
+	ssn.Jobs["ns/job1"].Queue = "q1"
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "task1", Namespace: "ns"}}
+	task := &api.TaskInfo{UID: "task1", Name: "task1", Namespace: "ns", Job: "ns/job1", Pod: pod,
+		Resreq: api.EmptyResource(), InitResreq: api.EmptyResource()}
+	task.Status = api.Running
+	ssn.Jobs["ns/job1"].AddTaskInfo(task)
+
+	configurations := []conf.Configuration{{
+		Name: "test",
+		Arguments: map[string]interface{}{
+			conf.EvictionDryRunKey:            true,
+			conf.EvictionQueueMaxPerWindowKey: 1,
+		},
+	}}
+	dryRunCount := func() float64 {
+		return evictionCount(t, map[string]string{"action": "test", "queue": "q1", "dry_run": "true"})
+	}
+	before := dryRunCount()
+
+	// The task keeps running in dry-run mode, so it is picked again in every cycle.
+	for cycle := 0; cycle < 3; cycle++ {
+		ssn.SetEvictionPolicy(NewEvictionPolicy("test", configurations))
+		if !ssn.EvictionAllowed(task) {
+			t.Fatalf("expected task1 to be allowed to evict again in cycle %d", cycle)
+		}
+		if err := ssn.Evict(task, "test"); err != nil {
+			t.Fatalf("failed to evict task1 in dry-run mode: %v", err)
+		}
+	}
+
+	if len(recorder.Events) != 1 {
+		t.Fatalf("expected one %s event, got %d", DryRunEvictReason, len(recorder.Events))
+	}
+	if event := <-recorder.Events; !strings.Contains(event, DryRunEvictReason) {
+		t.Errorf("expected %s event, got %q", DryRunEvictReason, event)
+	}
+	if got := dryRunCount() - before; got != 1 {
+		t.Errorf("expected one eviction with the dry_run label, got %v", got)
+	}
+
+	// The dry-run evictions do not drain the budget of the evictions executed by the action.
+	policy := NewEvictionPolicy("test", []conf.Configuration{{Name: "test", Arguments: map[string]interface{}{conf.EvictionQueueMaxPerWindowKey: 1}}})
+	if counts, _ := policy.windowEvictions(time.Now()); len(counts) != 0 {
+		t.Errorf("expected no eviction in the budget of the executed evictions, got %v", counts)
+	}
+}
+
+// evictionCount returns the value of the eviction counter with the labels.
+func evictionCount(t *testing.T, labels map[string]string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != "volcano_eviction_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			matched := 0
+			for _, label := range metric.GetLabel() {
+				if labels[label.GetName()] == label.GetValue() {
+					matched++
+				}
+			}
+			if matched == len(labels) {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/explain"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+// decisionTrail records the decisions of the plugins on the jobs in the session,
+// which are published to the explain store on session close.
+type decisionTrail struct {
+	sync.Mutex
+	rejections map[api.JobID][]explain.Rejection
+	overused   map[api.QueueID]string
+}
+
+func newDecisionTrail() *decisionTrail {
+	return &decisionTrail{
+		rejections: map[api.JobID][]explain.Rejection{},
+		overused:   map[api.QueueID]string{},
+	}
+}
+
+// recordRejection records the rejection of the job by the plugin at the stage, the last one of the same plugin
+// at the same stage wins.
+func (ssn *Session) recordRejection(jobID api.JobID, stage explain.Stage, plugin, reason, message string) {
+	if ssn.decisions == nil {
+		return
+	}
+	ssn.decisions.Lock()
+	defer ssn.decisions.Unlock()
+
+	rejection := explain.Rejection{Stage: stage, Plugin: plugin, Reason: reason, Message: message}
+	rejections := ssn.decisions.rejections[jobID]
+	for i := range rejections {
+		if rejections[i].Stage == stage && rejections[i].Plugin == plugin {
+			rejections[i] = rejection
+			return
+		}
+	}
+	ssn.decisions.rejections[jobID] = append(rejections, rejection)
+}
+
+// recordOverused records the plugin which considers the queue overused.
+func (ssn *Session) recordOverused(queueID api.QueueID, plugin string) {
+	if ssn.decisions == nil {
+		return
+	}
+	ssn.decisions.Lock()
+	defer ssn.decisions.Unlock()
+	ssn.decisions.overused[queueID] = plugin
+}
+
+// SetQueueDeserved sets the deserved resource of the queue calculated by the plugin on session open, which is
+// shown in the decisions of the jobs in the queue.
+func (ssn *Session) SetQueueDeserved(queueID api.QueueID, deserved *api.Resource) {
+	if deserved == nil {
+		return
+	}
+	if ssn.queueDeserved == nil {
+		ssn.queueDeserved = map[api.QueueID]*api.Resource{}
+	}
+	ssn.queueDeserved[queueID] = deserved.Clone()
+}
+
+// QueueDeserved returns the deserved resource of the queue set by the plugins, or the one in the spec of the queue.
+func (ssn *Session) QueueDeserved(queueID api.QueueID) *api.Resource {
+	if deserved, found := ssn.queueDeserved[queueID]; found {
+		return deserved
+	}
+	if queue, found := ssn.Queues[queueID]; found && queue.Queue != nil && queue.Queue.Spec.Deserved != nil {
+		return api.NewResource(queue.Queue.Spec.Deserved)
+	}
+	return nil
+}
+
+// publishDecisions builds the decisions of all the jobs in the session and replaces the ones in the explain store.
+func (ssn *Session) publishDecisions(allocated map[api.QueueID]*api.Resource) {
+	if ssn.decisions == nil {
+		return
+	}
+	ssn.decisions.Lock()
+	defer ssn.decisions.Unlock()
+
+	now := time.Now()
+	decisions := make([]*explain.JobDecision, 0, len(ssn.Jobs))
+	for _, job := range ssn.Jobs {
+		if job.PodGroup == nil {
+			continue
+		}
+
+		decision := &explain.JobDecision{
+			Namespace:    job.Namespace,
+			Name:         job.Name,
+			Queue:        string(job.Queue),
+			Phase:        string(job.PodGroup.Status.Phase),
+			Message:      unschedulableMessage(ssn, job),
+			SessionID:    string(ssn.UID),
+			Timestamp:    now,
+			PendingTasks: len(job.TaskStatusIndex[api.Pending]) + len(job.TaskStatusIndex[api.Pipelined]),
+			Rejections:   append([]explain.Rejection(nil), ssn.decisions.rejections[job.UID]...),
+			Predicates:   predicateFailures(job),
+		}
+		if plugin, found := ssn.decisions.overused[job.Queue]; found && decision.PendingTasks > 0 {
+			decision.Rejections = append(decision.Rejections, explain.Rejection{
+				Stage:   explain.OverusedStage,
+				Plugin:  plugin,
+				Message: "queue " + string(job.Queue) + " is overused",
+			})
+		}
+		if queue, found := ssn.Queues[job.Queue]; found {
+			decision.QueueShare = ssn.queueShare(queue, allocated[job.Queue])
+		}
+		decisions = append(decisions, decision)
+	}
+
+	explain.DefaultStore.Replace(decisions)
+}
+
+// queueShare returns the deserved and allocated resource of the queue.
+func (ssn *Session) queueShare(queue *api.QueueInfo, allocated *api.Resource) *explain.QueueShare {
+	share := &explain.QueueShare{Queue: queue.Name}
+	if deserved := ssn.QueueDeserved(queue.UID); deserved != nil {
+		share.Deserved = util.ConvertRes2ResList(deserved)
+	}
+	if allocated != nil {
+		share.Allocated = util.ConvertRes2ResList(allocated)
+	}
+	return share
+}
+
+// unschedulableMessage returns the message of the Unschedulable condition of the PodGroup updated in the session.
+func unschedulableMessage(ssn *Session, job *api.JobInfo) string {
+	for _, c := range job.PodGroup.Status.Conditions {
+		if c.Type == scheduling.PodGroupUnschedulableType && c.Status == v1.ConditionTrue && c.TransitionID == string(ssn.UID) {
+			return c.Message
+		}
+	}
+	return job.JobFitErrors
+}
+
+// predicateFailures aggregates the predicate failures of the tasks of the job by plugin and reason.
+func predicateFailures(job *api.JobInfo) []explain.PredicateFailure {
+	type failureKey struct {
+		plugin string
+		reason string
+	}
+	nodes := map[failureKey]sets.Set[string]{}
+	tasks := map[failureKey]int{}
+	for _, fitErrors := range job.NodesFitErrors {
+		if fitErrors == nil {
+			continue
+		}
+		taskFailures := sets.New[failureKey]()
+		for nodeName, fitError := range fitErrors.Nodes() {
+			for _, status := range fitError.Status {
+				if status == nil || status.Reason == "" {
+					continue
+				}
+				key := failureKey{plugin: status.Plugin, reason: status.Reason}
+				if _, found := nodes[key]; !found {
+					nodes[key] = sets.New[string]()
+				}
+				nodes[key].Insert(nodeName)
+				taskFailures.Insert(key)
+			}
+		}
+		for key := range taskFailures {
+			tasks[key]++
+		}
+	}
+
+	failures := make([]explain.PredicateFailure, 0, len(nodes))
+	for key, nodeNames := range nodes {
+		failures = append(failures, explain.PredicateFailure{
+			Plugin: key.plugin,
+			Reason: key.reason,
+			Nodes:  nodeNames.Len(),
+			Tasks:  tasks[key],
+		})
+	}
+	sort.Slice(failures, func(i, j int) bool {
+		if failures[i].Nodes != failures[j].Nodes {
+			return failures[i].Nodes > failures[j].Nodes
+		}
+		if failures[i].Plugin != failures[j].Plugin {
+			return failures[i].Plugin < failures[j].Plugin
+		}
+		return failures[i].Reason < failures[j].Reason
+	})
+	return failures
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/explain"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestPublishDecisions(t *testing.T) {
+	queue := &api.QueueInfo{
+		UID:   "q1",
+		Name:  "q1",
+		Queue: &scheduling.Queue{ObjectMeta: metav1.ObjectMeta{Name: "q1"}},
+	}
+	task := api.NewTaskInfo(util.BuildPod("ns1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil))
+	job := api.NewJobInfo(task.Job, task)
+	job.Name, job.Namespace, job.Queue = "pg1", "ns1", "q1"
+	job.PodGroup = &api.PodGroup{PodGroup: scheduling.PodGroup{
+		Status: scheduling.PodGroupStatus{
+			Phase: scheduling.PodGroupInqueue,
+			Conditions: []scheduling.PodGroupCondition{{
+				Type:         scheduling.PodGroupUnschedulableType,
+				Status:       v1.ConditionTrue,
+				TransitionID: "ssn-1",
+				Message:      "1/1 tasks in gang unschedulable",
+			}},
+		},
+	}}
+	fitErrors := api.NewFitErrors()
+	fitErrors.SetNodeError("n1", newFitErr("p1", "n1", &api.Status{Code: api.Unschedulable, Reason: "Insufficient cpu", Plugin: "predicates"}))
+	fitErrors.SetNodeError("n2", newFitErr("p1", "n2", &api.Status{Code: api.Unschedulable, Reason: "Insufficient cpu", Plugin: "predicates"}))
+	fitErrors.SetNodeError("n3", newFitErr("p1", "n3", &api.Status{Code: api.UnschedulableAndUnresolvable, Reason: "node(s) had untolerated taint", Plugin: "predicates"}))
+	job.NodesFitErrors[task.UID] = fitErrors
+
+	ssn := &Session{
+		UID: "ssn-1",
+		Tiers: []conf.Tier{{Plugins: []conf.PluginOption{
+			{Name: "gang"},
+			{Name: "proportion", EnabledOverused: ptr.To(true), EnabledAllocatable: ptr.To(true)},
+		}}},
+		Jobs:   map[api.JobID]*api.JobInfo{job.UID: job},
+		Queues: map[api.QueueID]*api.QueueInfo{queue.UID: queue},
+		jobValidFns: map[string]api.ValidateExFn{
+			"gang": func(obj interface{}) *api.ValidateResult {
+				return &api.ValidateResult{Pass: false, Reason: "NotEnoughPods", Message: "not enough valid pods"}
+			},
+		},
+		overusedFns: map[string]api.ValidateFn{
+			"proportion": func(obj interface{}) bool { return true },
+		},
+		allocatableFns: map[string]api.AllocatableFn{
+			"proportion": func(queue *api.QueueInfo, candidate *api.TaskInfo) bool { return false },
+		},
+		decisions: newDecisionTrail(),
+	}
+
+	ssn.JobValid(job)
+	ssn.JobValid(job)
+	ssn.Allocatable(queue, task)
+	ssn.Overused(queue)
+	ssn.SetQueueDeserved(queue.UID, api.NewResource(api.BuildResourceList("4", "4Gi")))
+	ssn.publishDecisions(map[api.QueueID]*api.Resource{queue.UID: api.NewResource(api.BuildResourceList("2", "2Gi"))})
+
+	decision, found := explain.DefaultStore.Get("ns1", "pg1")
+	if !found {
+		t.Fatalf("expected decision of job ns1/pg1 to be published")
+	}
+
+	expectedRejections := []explain.Rejection{
+		{Stage: explain.JobValidStage, Plugin: "gang", Reason: "NotEnoughPods", Message: "not enough valid pods"},
+		{Stage: explain.AllocatableStage, Plugin: "proportion", Message: "task ns1/p1 is not allocatable in queue q1"},
+		{Stage: explain.OverusedStage, Plugin: "proportion", Message: "queue q1 is overused"},
+	}
+	if !reflect.DeepEqual(decision.Rejections, expectedRejections) {
+		t.Errorf("expected rejections %v, got %v", expectedRejections, decision.Rejections)
+	}
+
+	expectedPredicates := []explain.PredicateFailure{
+		{Plugin: "predicates", Reason: "Insufficient cpu", Nodes: 2, Tasks: 1},
+		{Plugin: "predicates", Reason: "node(s) had untolerated taint", Nodes: 1, Tasks: 1},
+	}
+	if !reflect.DeepEqual(decision.Predicates, expectedPredicates) {
+		t.Errorf("expected predicate failures %v, got %v", expectedPredicates, decision.Predicates)
+	}
+
+	if decision.Message != "1/1 tasks in gang unschedulable" || decision.PendingTasks != 1 || decision.Phase != string(scheduling.PodGroupInqueue) {
+		t.Errorf("unexpected decision %+v", decision)
+	}
+	if decision.QueueShare == nil || decision.QueueShare.Deserved.Cpu().String() != "4" || decision.QueueShare.Allocated.Cpu().String() != "2" {
+		t.Errorf("unexpected queue share %+v", decision.QueueShare)
+	}
+}
@@ -54,6 +54,8 @@ func OpenSession(cache cache.Cache, tiers []conf.Tier, configurations []conf.Con
 
 	ssn.InitCycleState()
 
+	ssn.enableBackfillReservation = backfillReservationEnabled(configurations)
+
 	return ssn
 }
 
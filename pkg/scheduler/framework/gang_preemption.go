@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// maxWholeVictimJobs is the maximum number of victim jobs on a node considered to be evicted as a whole, the victim
+// sets are enumerated over them so that the number must be kept small.
+const maxWholeVictimJobs = 8
+
+// victimJob is a job having victims on the node.
+type victimJob struct {
+	// partial are the victims on the node which can be evicted while keeping the job above its gang minimum
+	partial []*api.TaskInfo
+	// whole are all the running tasks of the job if it can be evicted as a whole, nil otherwise
+	whole []*api.TaskInfo
+	// wholeOnNode is the resource released on the node by evicting the job as a whole
+	wholeOnNode *api.Resource
+}
+
+// SetGangPreemption sets whether the action being executed selects the victims at job level by GangVictims, the
+// victims are not limited by the gang minimum of their jobs in the plugins then, which is kept by GangVictims instead.
+func (ssn *Session) SetGangPreemption(enabled bool) {
+	ssn.gangPreemption = enabled
+}
+
+// GangPreemption checks whether the action being executed selects the victims at job level.
+func (ssn *Session) GangPreemption() bool {
+	return ssn.gangPreemption
+}
+
+// GangVictims selects the victims on the node for the preemptor at job level: each victim job either keeps enough
+// tasks for its gang minimum, or is evicted as a whole including its tasks on the other nodes, so that no job is left
+// running below its gang minimum. Among the victim sets making room for the preemptor on the node, the one disrupting
+// the fewest jobs and then losing the least runtime is chosen. The filter of the action and victimsFn, e.g.
+// Preemptable or Reclaimable, decide which tasks can be victims.
+func (ssn *Session) GangVictims(
+	preemptor *api.TaskInfo,
+	node *api.NodeInfo,
+	filter func(*api.TaskInfo) bool,
+	victimsFn func(*api.TaskInfo, []*api.TaskInfo) []*api.TaskInfo,
+) ([]*api.TaskInfo, error) {
+	var preemptees []*api.TaskInfo
+	for _, task := range node.Tasks {
+		if filter == nil || filter(task) {
+			preemptees = append(preemptees, task.Clone())
+		}
+	}
+
+	// group the victims by jobs in the order of eviction
+	var jobIDs []api.JobID
+	victimsByJob := map[api.JobID][]*api.TaskInfo{}
+	victimsQueue := ssn.BuildVictimsPriorityQueue(victimsFn(preemptor, preemptees), preemptor)
+	for !victimsQueue.Empty() {
+		victim := victimsQueue.Pop().(*api.TaskInfo)
+		if _, found := victimsByJob[victim.Job]; !found {
+			jobIDs = append(jobIDs, victim.Job)
+		}
+		victimsByJob[victim.Job] = append(victimsByJob[victim.Job], victim)
+	}
+
+	var wholeCandidates []int
+	victimJobs := make([]*victimJob, 0, len(jobIDs))
+	for _, jobID := range jobIDs {
+		victims := victimsByJob[jobID]
+		vj := &victimJob{partial: victims}
+		// the tasks whose job has gone are not limited by gang-scheduling
+		if job, found := ssn.Jobs[jobID]; found {
+			spare := int(job.ReadyTaskNum() - job.MinAvailable)
+			if spare < len(victims) {
+				vj.partial = victims[:max(spare, 0)]
+				vj.whole = ssn.wholeJobVictims(preemptor, job, filter, victimsFn)
+			}
+		}
+		if vj.whole != nil {
+			vj.wholeOnNode = api.EmptyResource()
+			for _, task := range vj.whole {
+				if task.NodeName == node.Name {
+					vj.wholeOnNode.Add(task.Resreq)
+				}
+			}
+			if len(wholeCandidates) < maxWholeVictimJobs {
+				wholeCandidates = append(wholeCandidates, len(victimJobs))
+			}
+		}
+		victimJobs = append(victimJobs, vj)
+	}
+
+	now := time.Now()
+	var best []*api.TaskInfo
+	bestDisrupted, bestLost, found := 0, time.Duration(0), false
+	for mask := 0; mask < 1<<len(wholeCandidates); mask++ {
+		idle := node.FutureIdle()
+		fits := func() bool { return preemptor.InitResreq.LessEqual(idle, api.Zero) }
+
+		var victims []*api.TaskInfo
+		disrupted, lost := 0, time.Duration(0)
+		whole := map[int]bool{}
+		for bit, i := range wholeCandidates {
+			if mask&(1<<bit) == 0 {
+				continue
+			}
+			whole[i] = true
+			idle.Add(victimJobs[i].wholeOnNode)
+			victims = append(victims, victimJobs[i].whole...)
+			disrupted++
+			for _, task := range victimJobs[i].whole {
+				lost += taskRuntime(task, now)
+			}
+		}
+		for i, vj := range victimJobs {
+			if whole[i] || fits() {
+				continue
+			}
+			for _, task := range vj.partial {
+				if fits() {
+					break
+				}
+				idle.Add(task.Resreq)
+				victims = append(victims, task)
+				lost += taskRuntime(task, now)
+			}
+			if len(vj.partial) > 0 {
+				disrupted++
+			}
+		}
+
+		if !fits() {
+			continue
+		}
+		if !found || disrupted < bestDisrupted || (disrupted == bestDisrupted && lost < bestLost) {
+			best, bestDisrupted, bestLost, found = victims, disrupted, lost, true
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no victim set on Node <%s> makes room for Task <%s/%s> without breaking gang-scheduling",
+			node.Name, preemptor.Namespace, preemptor.Name)
+	}
+	klog.V(3).Infof("Select <%d> victims of <%d> jobs on Node <%s> for Task <%s/%s>, losing runtime <%v>.",
+		len(best), bestDisrupted, node.Name, preemptor.Namespace, preemptor.Name, bestLost)
+	return best, nil
+}
+
+// wholeJobVictims returns all the running tasks of the job if they can all be victims of the preemptor, nil otherwise.
+func (ssn *Session) wholeJobVictims(
+	preemptor *api.TaskInfo,
+	job *api.JobInfo,
+	filter func(*api.TaskInfo) bool,
+	victimsFn func(*api.TaskInfo, []*api.TaskInfo) []*api.TaskInfo,
+) []*api.TaskInfo {
+	// the job of the preemptor itself is never evicted as a whole
+	if job.UID == preemptor.Job {
+		return nil
+	}
+
+	var tasks []*api.TaskInfo
+	for status, statusTasks := range job.TaskStatusIndex {
+		if !api.AllocatedStatus(status) {
+			continue
+		}
+		// the tasks allocated in the session can not be evicted
+		if !api.PreemptableStatus(status) {
+			return nil
+		}
+		for _, task := range statusTasks {
+			if filter != nil && !filter(task) {
+				return nil
+			}
+			tasks = append(tasks, task.Clone())
+		}
+	}
+	if len(tasks) == 0 || len(victimsFn(preemptor, tasks)) != len(tasks) {
+		return nil
+	}
+
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].Name < tasks[j].Name })
+	return tasks
+}
+
+// taskRuntime returns how long the task has been running, which is lost if the task is evicted.
+func taskRuntime(task *api.TaskInfo, now time.Time) time.Duration {
+	if task.Pod == nil || task.Pod.Status.StartTime == nil {
+		return 0
+	}
+	return now.Sub(task.Pod.Status.StartTime.Time)
+}
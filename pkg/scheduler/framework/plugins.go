@@ -46,6 +46,63 @@ func RegisterPluginBuilder(name string, pc PluginBuilder) {
 	pluginBuilders[name] = pc
 }
 
+// PluginArgumentsValidator validates the arguments given to the plugin in the scheduler configuration
+type PluginArgumentsValidator = func(Arguments) error
+
+// Plugin arguments validators management
+var pluginArgumentsValidators = map[string]PluginArgumentsValidator{}
+
+// RegisterPluginArgumentsValidator register the arguments validator of the plugin
+func RegisterPluginArgumentsValidator(name string, validator PluginArgumentsValidator) {
+	pluginMutex.Lock()
+	defer pluginMutex.Unlock()
+
+	pluginArgumentsValidators[name] = validator
+}
+
+// ValidatePluginArguments validates the arguments of the plugin by its registered validator, the arguments of the
+// plugins without validator are always valid
+func ValidatePluginArguments(name string, arguments Arguments) error {
+	pluginMutex.RLock()
+	validator, found := pluginArgumentsValidators[name]
+	if !found {
+		validator, found = pluginArgumentsValidators[builderName(name)]
+	}
+	pluginMutex.RUnlock()
+
+	if !found {
+		return nil
+	}
+	return validator(arguments)
+}
+
+// PluginReleaser releases the resources kept by the plugin across the sessions, e.g. the connections to the processes
+// it calls, which are rebuilt by the following sessions on demand
+type PluginReleaser = func()
+
+// Plugin releasers management
+var pluginReleasers = map[string]PluginReleaser{}
+
+// RegisterPluginReleaser register the releaser of the plugin
+func RegisterPluginReleaser(name string, releaser PluginReleaser) {
+	pluginMutex.Lock()
+	defer pluginMutex.Unlock()
+
+	pluginReleasers[name] = releaser
+}
+
+// ReleasePlugins releases the resources kept by all the plugins, it is called with no session open once the scheduler
+// configuration is reloaded or the scheduler stops.
+func ReleasePlugins() {
+	pluginMutex.RLock()
+	defer pluginMutex.RUnlock()
+
+	for name, releaser := range pluginReleasers {
+		klog.V(3).Infof("Release the resources of plugin %s", name)
+		releaser()
+	}
+}
+
 // CleanupPluginBuilders cleans up all the plugin
 func CleanupPluginBuilders() {
 	pluginMutex.Lock()
@@ -60,10 +117,23 @@ func GetPluginBuilder(name string) (PluginBuilder, bool) {
 	defer pluginMutex.RUnlock()
 
 	pb, found := pluginBuilders[name]
+	if !found {
+		pb, found = pluginBuilders[builderName(name)]
+	}
 	return pb, found
 }
 
-// LoadCustomPlugins loads custom implement plugins
+// builderName returns the name of the builder of the plugin. A plugin configured more than once, e.g. the remote
+// plugin for several out-of-process plugins, is named <builder>/<instance> in the scheduler configuration.
+func builderName(name string) string {
+	if i := strings.Index(name, "/"); i > 0 {
+		return name[:i]
+	}
+	return name
+}
+
+// LoadCustomPlugins loads custom implement plugins, which must be built with the same toolchain and dependencies as
+// the scheduler. The remote plugin calls the plugins out of the process over gRPC without such restriction.
 func LoadCustomPlugins(pluginsDir string) error {
 	pluginPaths, _ := filepath.Glob(fmt.Sprintf("%s/*.so", pluginsDir))
 	for _, pluginPath := range pluginPaths {
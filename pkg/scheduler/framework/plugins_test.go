@@ -52,3 +52,18 @@ func TestGetPluginName(t *testing.T) {
 		}
 	}
 }
+
+func TestBuilderName(t *testing.T) {
+	cases := map[string]string{
+		"remote":       "remote",
+		"remote/gpu":   "remote",
+		"remote/gpu/a": "remote",
+		"/gpu":         "/gpu",
+	}
+
+	for name, expected := range cases {
+		if got := builderName(name); got != expected {
+			t.Errorf("builder of plugin %s should be %s, but not %s", name, expected, got)
+		}
+	}
+}
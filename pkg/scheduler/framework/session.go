@@ -23,13 +23,17 @@ limitations under the License.
 package framework
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"maps"
 	"sort"
 	"sync"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/uuid"
@@ -49,6 +53,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/cache"
 	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/explain"
 	"volcano.sh/volcano/pkg/scheduler/gate"
 	"volcano.sh/volcano/pkg/scheduler/metrics"
 	"volcano.sh/volcano/pkg/scheduler/util"
@@ -60,6 +65,9 @@ const (
 	// Its Tier value is set to the maximum existing Tier + 1 among real HyperNodes. This is recalculated every time a session opens.
 	// If no real HyperNodes exist in the cluster, this virtual top-tier HyperNode will still exist with Tier = 1 and will encompass all Nodes in the cluster.
 	ClusterTopHyperNode = "<cluster-top-hypernode>"
+
+	// QueueBlockedReason is the event reason when a fifo queue is blocked by its unschedulable head job.
+	QueueBlockedReason = "QueueBlocked"
 )
 
 // Session information for the current session
@@ -160,6 +168,24 @@ type Session struct {
 	// The key is task's UID, value is the CycleState.
 	cycleStatesMap sync.Map
 
+	// queueDeserved is the deserved resource of the queues calculated by the plugins.
+	queueDeserved map[api.QueueID]*api.Resource
+	// backfillReservation is the reservation planned for the blocked gang job, which is honored when allocating tasks.
+	// It is planned on the first use only if enableBackfillReservation is set, see BackfillReservation.
+	backfillReservation       *api.BackfillReservation
+	enableBackfillReservation bool
+	planBackfillOnce          sync.Once
+	// evictionPolicy is the eviction budget and dry-run mode of the action being executed.
+	evictionPolicy *EvictionPolicy
+	// gangPreemption is whether the action being executed selects the victims at job level, see GangVictims.
+	gangPreemption bool
+	// decisions records the decisions of the plugins on the jobs for the explain endpoint, nil if it is disabled.
+	decisions *decisionTrail
+	// traceCtx is the context of the span the session is traced under, see SetTraceContext.
+	traceCtx context.Context
+	// jobSpan is the span of the job being attempted, which the time of the plugins is accumulated into.
+	jobSpan *JobSpan
+
 	NodesInShard sets.Set[string]
 }
 
@@ -185,6 +211,7 @@ func openSession(cache cache.Cache) *Session {
 		CSINodesStatus: map[string]*api.CSINodeStatusInfo{},
 		RevocableNodes: map[string]*api.NodeInfo{},
 		Queues:         map[api.QueueID]*api.QueueInfo{},
+		queueDeserved:  map[api.QueueID]*api.Resource{},
 
 		plugins:                       map[string]Plugin{},
 		jobOrderFns:                   map[string]api.CompareFn{},
@@ -224,6 +251,9 @@ func openSession(cache cache.Cache) *Session {
 		hyperNodeGradientForJobFns:    map[string]api.HyperNodeGradientForJobFn{},
 		hyperNodeGradientForSubJobFns: map[string]api.HyperNodeGradientForSubJobFn{},
 	}
+	if explain.DefaultStore.Enabled() {
+		ssn.decisions = newDecisionTrail()
+	}
 
 	snapshot := cache.Snapshot()
 
@@ -497,7 +527,7 @@ func addNodeSharableDeviceUsage(ssn *Session, task *api.TaskInfo) {
 }
 
 // updateQueueStatus updates allocated field in queue status on session close.
-func updateQueueStatus(ssn *Session) {
+func updateQueueStatus(ssn *Session) map[api.QueueID]*api.Resource {
 	rootQueue := api.QueueID("root")
 	// calculate allocated resources on each queue
 	var allocatedResources = make(map[api.QueueID]*api.Resource, len(ssn.Queues))
@@ -547,13 +577,16 @@ func updateQueueStatus(ssn *Session) {
 			klog.Errorf("failed to update queue <%s> status: %s", ssn.Queues[queueID].Name, err.Error())
 		}
 	}
+
+	return allocatedResources
 }
 
 func closeSession(ssn *Session) {
 	ju := NewJobUpdater(ssn)
 	ju.UpdateAll()
 
-	updateQueueStatus(ssn)
+	allocatedResources := updateQueueStatus(ssn)
+	ssn.publishDecisions(allocatedResources)
 
 	ssn.Jobs = nil
 	ssn.Nodes = nil
@@ -654,7 +687,16 @@ func (ssn *Session) FilterOutUnschedulableAndUnresolvableNodesForTask(task *api.
 // - Unschedulable
 // - UnschedulableAndUnresolvable
 // - ErrorSkipOrWait
+// and rejects the nodes locked for the target job of reservation, and the nodes reserved for the blocked job
+// if the job of task could not end before the reservation starts.
 func (ssn *Session) PredicateForAllocateAction(task *api.TaskInfo, node *api.NodeInfo) error {
+	if !task.BestEffort && util.Reservation.IsLockedForOther(node.Name, task.Job) {
+		return api.NewFitErrWithStatus(task, node, &api.Status{Code: api.Unschedulable, Reason: api.NodeLockedForReservation})
+	}
+	if !task.BestEffort && !ssn.BackfillReservation().Permits(ssn.Jobs[task.Job], node.Name, time.Now()) {
+		return api.NewFitErrWithStatus(task, node, &api.Status{Code: api.Unschedulable, Reason: api.NodeReservedForBackfill})
+	}
+
 	err := ssn.PredicateFn(task, node)
 	if err == nil {
 		return nil
@@ -868,9 +910,14 @@ func (ssn *Session) GetCycleState(taskID api.TaskID) *k8sframework.CycleState {
 
 // Evict the task in the session
 func (ssn *Session) Evict(reclaimee *api.TaskInfo, reason string) error {
+	if ssn.evictionPolicy.dryRun() {
+		ssn.recordDryRunEviction(reclaimee, reason)
+		return nil
+	}
 	if err := ssn.cache.Evict(reclaimee, reason); err != nil {
 		return err
 	}
+	ssn.evictionPolicy.evicted(reclaimee, ssn.jobQueue(reclaimee), time.Now())
 
 	// Update status in session
 	job, found := ssn.Jobs[reclaimee.Job]
@@ -943,6 +990,50 @@ func (ssn *Session) KubeClient() kubernetes.Interface {
 	return ssn.kubeClient
 }
 
+// UpdateReservationAnnotations sets the target job of the reservation as the annotation of the locked nodes, and removes
+// it from the others.
+func (ssn *Session) UpdateReservationAnnotations() {
+	info := util.Reservation.Info()
+	lockedNodes := util.Reservation.LockedNodes()
+	for _, node := range ssn.Nodes {
+		if node.Node == nil {
+			continue
+		}
+
+		var value *string
+		if lockedNodes.Has(node.Name) {
+			value = &info.TargetJob
+		}
+		current, found := node.Node.Annotations[vcv1beta1.ReservedForJobAnnotationKey]
+		if (value == nil && !found) || (value != nil && found && current == *value) {
+			continue
+		}
+
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]*string{vcv1beta1.ReservedForJobAnnotationKey: value},
+			},
+		})
+		if err != nil {
+			klog.Errorf("Failed to build reservation annotation patch of node <%s>: %v", node.Name, err)
+			continue
+		}
+		if _, err := ssn.kubeClient.CoreV1().Nodes().Patch(context.TODO(), node.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			klog.Errorf("Failed to update reservation annotation of node <%s>: %v", node.Name, err)
+		}
+	}
+}
+
+// ReleaseReservation releases the reservation left over by the reserve action, e.g. after the action is removed from the
+// configuration, and removes the target job from the annotations of the nodes.
+func (ssn *Session) ReleaseReservation() {
+	if targetJob := util.Reservation.TargetJob(); targetJob != "" {
+		klog.V(3).Infof("Release the nodes locked for target job <%s> as the reserve action is disabled.", targetJob)
+		util.Reservation.Release(false, time.Now())
+	}
+	ssn.UpdateReservationAnnotations()
+}
+
 // SchGateManager returns the scheduler gate manager.
 // Returns nil when SchedulingGatesQueueAdmission feature gate is disabled.
 func (ssn *Session) SchGateManager() *gate.SchGateManager {
@@ -983,6 +1074,35 @@ func (ssn *Session) RecordPodGroupEvent(podGroup *api.PodGroup, eventType, reaso
 	ssn.recorder.Eventf(pg, eventType, reason, msg)
 }
 
+// RecordQueueEvent records queue events
+func (ssn *Session) RecordQueueEvent(queue *api.QueueInfo, eventType, reason, msg string) {
+	if queue == nil || queue.Queue == nil {
+		return
+	}
+
+	q := &vcv1beta1.Queue{}
+	if err := schedulingscheme.Scheme.Convert(queue.Queue, q, nil); err != nil {
+		klog.Errorf("Error while converting Queue to v1beta1.Queue with error: %v", err)
+		return
+	}
+	ssn.recorder.Eventf(q, eventType, reason, msg)
+}
+
+// BlockQueue stops dequeuing the jobs behind the unschedulable head job of a fifo queue,
+// the blocking reason is reported on the queue and the PodGroups of the blocked jobs.
+func (ssn *Session) BlockQueue(queue *api.QueueInfo, head *api.JobInfo, jobs *util.PriorityQueue, action string) {
+	msg := fmt.Sprintf("queue <%s> with %s dequeue strategy is blocked in %s by job <%s/%s>",
+		queue.Name, queue.DequeueStrategy, action, head.Namespace, head.Name)
+	klog.V(3).Info(msg)
+	ssn.RecordQueueEvent(queue, v1.EventTypeWarning, QueueBlockedReason, msg)
+
+	for !jobs.Empty() {
+		job := jobs.Pop().(*api.JobInfo)
+		job.JobFitErrors = msg
+		klog.V(4).Infof("Job <%s/%s> skip %s, reason: %s", job.Namespace, job.Name, action, msg)
+	}
+}
+
 // SharedDRAManager returns the shared DRAManager from cache
 func (ssn *Session) SharedDRAManager() fwk.SharedDRAManager {
 	return ssn.cache.SharedDRAManager()
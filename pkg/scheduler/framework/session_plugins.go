@@ -22,12 +22,14 @@ package framework
 
 import (
 	"context"
+	"fmt"
 
 	fwk "k8s.io/kube-scheduler/framework"
 
 	"volcano.sh/apis/pkg/apis/scheduling"
 	"volcano.sh/volcano/pkg/controllers/job/helpers"
 	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/explain"
 	"volcano.sh/volcano/pkg/scheduler/util"
 )
 
@@ -207,9 +209,10 @@ func (ssn *Session) AddHyperNodeGradientForSubJobFn(name string, fn api.HyperNod
 	ssn.hyperNodeGradientForSubJobFns[name] = fn
 }
 
-// Reclaimable invoke reclaimable function of the plugins
+// Reclaimable invoke reclaimable function of the plugins, the tasks protected by the min runtime of their jobs are skipped
 func (ssn *Session) Reclaimable(reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) []*api.TaskInfo {
 	var victims []*api.TaskInfo
+	reclaimees = ssn.evictableTasks(reclaimees)
 
 	for _, tier := range ssn.Tiers {
 		for _, plugin := range tier.Plugins {
@@ -256,9 +259,10 @@ func (ssn *Session) Reclaimable(reclaimer *api.TaskInfo, reclaimees []*api.TaskI
 	return victims
 }
 
-// Preemptable invoke preemptable function of the plugins
+// Preemptable invoke preemptable function of the plugins, the tasks protected by the min runtime of their jobs are skipped
 func (ssn *Session) Preemptable(preemptor *api.TaskInfo, preemptees []*api.TaskInfo) []*api.TaskInfo {
 	var victims []*api.TaskInfo
+	preemptees = ssn.evictableTasks(preemptees)
 
 	for _, tier := range ssn.Tiers {
 		for _, plugin := range tier.Plugins {
@@ -318,6 +322,7 @@ func (ssn *Session) Overused(queue *api.QueueInfo) bool {
 				continue
 			}
 			if of(queue) {
+				ssn.recordOverused(queue.UID, plugin.Name)
 				return true
 			}
 		}
@@ -358,6 +363,8 @@ func (ssn *Session) Allocatable(queue *api.QueueInfo, candidate *api.TaskInfo) b
 				continue
 			}
 			if !af(queue, candidate) {
+				ssn.recordRejection(candidate.Job, explain.AllocatableStage, plugin.Name, "",
+					fmt.Sprintf("task %s/%s is not allocatable in queue %s", candidate.Namespace, candidate.Name, queue.Name))
 				return false
 			}
 		}
@@ -515,6 +522,9 @@ func (ssn *Session) JobValid(obj interface{}) *api.ValidateResult {
 			}
 
 			if vr := jrf(obj); vr != nil && !vr.Pass {
+				if job, ok := obj.(*api.JobInfo); ok {
+					ssn.recordRejection(job.UID, explain.JobValidStage, plugin.Name, vr.Reason, vr.Message)
+				}
 				return vr
 			}
 		}
@@ -538,6 +548,9 @@ func (ssn *Session) JobEnqueueable(obj interface{}) bool {
 
 			res := fn(obj)
 			if res < 0 {
+				if job, ok := obj.(*api.JobInfo); ok {
+					ssn.recordRejection(job.UID, explain.JobEnqueueableStage, plugin.Name, "", "job is not enqueueable")
+				}
 				return false
 			}
 			if res > 0 {
@@ -588,10 +601,11 @@ func (ssn *Session) TargetJob(jobs []*api.JobInfo) *api.JobInfo {
 	return nil
 }
 
-// VictimTasks returns the victims selected
+// VictimTasks returns the victims selected, the tasks protected by the min runtime of their jobs are skipped
 func (ssn *Session) VictimTasks(tasks []*api.TaskInfo) map[*api.TaskInfo]bool {
 	// different filters may add the same task to victims, so use a map to remove duplicate tasks.
 	victimSet := make(map[*api.TaskInfo]bool)
+	tasks = ssn.evictableTasks(tasks)
 	for _, tier := range ssn.Tiers {
 		for _, plugin := range tier.Plugins {
 			if !isEnabled(plugin.EnabledVictim) {
@@ -791,7 +805,9 @@ func (ssn *Session) PredicateFn(task *api.TaskInfo, node *api.NodeInfo) error {
 			if !found {
 				continue
 			}
+			start := ssn.pluginStart()
 			err := pfn(task, node)
+			ssn.observePlugin(plugin.Name, predicateHook, start, err != nil)
 			if err != nil {
 				return err
 			}
@@ -891,7 +907,9 @@ func (ssn *Session) PrePredicateFn(task *api.TaskInfo) error {
 			if !found {
 				continue
 			}
+			start := ssn.pluginStart()
 			err := pfn(task)
+			ssn.observePlugin(plugin.Name, predicateHook, start, err != nil)
 			if err != nil {
 				return err
 			}
@@ -932,7 +950,9 @@ func (ssn *Session) NodeOrderFn(task *api.TaskInfo, node *api.NodeInfo) (float64
 			if !found {
 				continue
 			}
+			start := ssn.pluginStart()
 			score, err := pfn(task, node)
+			ssn.observePlugin(plugin.Name, scoreHook, start, err != nil)
 			if err != nil {
 				return 0, err
 			}
@@ -954,7 +974,9 @@ func (ssn *Session) BatchNodeOrderFn(task *api.TaskInfo, nodes []*api.NodeInfo)
 			if !found {
 				continue
 			}
+			start := ssn.pluginStart()
 			score, err := pfn(task, nodes)
+			ssn.observePlugin(plugin.Name, scoreHook, start, err != nil)
 			if err != nil {
 				return nil, err
 			}
@@ -980,14 +1002,18 @@ func (ssn *Session) NodeOrderMapFn(task *api.TaskInfo, node *api.NodeInfo) (map[
 				continue
 			}
 			if pfn, found := ssn.nodeOrderFns[plugin.Name]; found {
+				start := ssn.pluginStart()
 				score, err := pfn(task, node)
+				ssn.observePlugin(plugin.Name, scoreHook, start, err != nil)
 				if err != nil {
 					return nodeScoreMap, priorityScore, err
 				}
 				priorityScore += score
 			}
 			if pfn, found := ssn.nodeMapFns[plugin.Name]; found {
+				start := ssn.pluginStart()
 				score, err := pfn(task, node)
+				ssn.observePlugin(plugin.Name, scoreHook, start, err != nil)
 				if err != nil {
 					return nodeScoreMap, priorityScore, err
 				}
@@ -1033,7 +1059,10 @@ func (ssn *Session) NodeOrderReduceFn(task *api.TaskInfo, pluginNodeScoreMap map
 			if !found {
 				continue
 			}
-			if err := pfn(task, pluginNodeScoreMap[plugin.Name]); err != nil {
+			start := ssn.pluginStart()
+			err := pfn(task, pluginNodeScoreMap[plugin.Name])
+			ssn.observePlugin(plugin.Name, scoreHook, start, err != nil)
+			if err != nil {
 				return nodeScoreMap, err
 			}
 			for _, hp := range pluginNodeScoreMap[plugin.Name] {
@@ -25,6 +25,7 @@ package framework
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"k8s.io/klog/v2"
 
@@ -91,6 +92,7 @@ func (s *Statement) Evict(reclaimee *api.TaskInfo, reason string) {
 		}
 	}
 
+	s.ssn.evictionPolicy.evicting(reclaimee, s.ssn.jobQueue(reclaimee))
 	s.operations = append(s.operations, operation{
 		name:   Evict,
 		task:   reclaimee,
@@ -99,12 +101,19 @@ func (s *Statement) Evict(reclaimee *api.TaskInfo, reason string) {
 }
 
 func (s *Statement) evict(reclaimee *api.TaskInfo, reason string) error {
+	// In dry-run mode the eviction is only recorded, and the task keeps running in the session.
+	if s.ssn.evictionPolicy.dryRun() {
+		s.ssn.recordDryRunEviction(reclaimee, reason)
+		return s.unevict(reclaimee)
+	}
+
 	if err := s.ssn.cache.Evict(reclaimee, reason); err != nil {
 		if e := s.unevict(reclaimee); e != nil {
 			klog.Errorf("Faled to unevict task <%v/%v>: %v.", reclaimee.Namespace, reclaimee.Name, e)
 		}
 		return err
 	}
+	s.ssn.evictionPolicy.evicted(reclaimee, s.ssn.jobQueue(reclaimee), time.Now())
 
 	return nil
 }
@@ -117,6 +126,8 @@ func (s *Statement) UnEvict(reclaimee *api.TaskInfo) error {
 }
 
 func (s *Statement) unevict(reclaimee *api.TaskInfo) error {
+	s.ssn.evictionPolicy.unevicting(reclaimee)
+
 	// Update status in session
 	job, found := s.ssn.Jobs[reclaimee.Job]
 	if found {
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/tracing"
+)
+
+const (
+	// predicateHook and scoreHook are the hooks of the plugins whose time is traced in the span of the job.
+	predicateHook = "predicate"
+	scoreHook     = "score"
+
+	// readyOutcome, pipelinedOutcome and pendingOutcome are the outcomes of the job attempted in an action.
+	readyOutcome     = "ready"
+	pipelinedOutcome = "pipelined"
+	pendingOutcome   = "pending"
+)
+
+// JobSpan is the span of a job attempted in an action, it accumulates the time spent by the plugins on the job.
+// The methods of a nil JobSpan do nothing, which is returned if the session is not traced.
+type JobSpan struct {
+	ssn  *Session
+	job  *api.JobInfo
+	span trace.Span
+
+	mu      sync.Mutex
+	timings map[pluginHook]*pluginTiming
+}
+
+type pluginHook struct {
+	plugin string
+	hook   string
+}
+
+// pluginTiming is the accumulated time of the calls of a plugin hook.
+type pluginTiming struct {
+	start    time.Time
+	duration time.Duration
+	calls    int64
+	rejected int64
+}
+
+// SetTraceContext sets the context of the span the session is traced under, e.g. the span of the action executed.
+func (ssn *Session) SetTraceContext(ctx context.Context) {
+	ssn.traceCtx = ctx
+}
+
+// TraceContext returns the context of the span the session is traced under.
+func (ssn *Session) TraceContext() context.Context {
+	if ssn.traceCtx == nil {
+		return context.Background()
+	}
+	return ssn.traceCtx
+}
+
+// TraceJob starts the span of the job attempted by the action, which must be ended by the action when the attempt
+// is done. It returns nil if the session is not traced.
+func (ssn *Session) TraceJob(job *api.JobInfo) *JobSpan {
+	ctx := ssn.TraceContext()
+	if !trace.SpanFromContext(ctx).IsRecording() {
+		return nil
+	}
+	_, span := tracing.Tracer().Start(ctx, "job", trace.WithAttributes(
+		attribute.String("job.uid", string(job.UID)),
+		attribute.String("job.namespace", job.Namespace),
+		attribute.String("job.name", job.Name),
+		attribute.String("job.queue", string(job.Queue)),
+		attribute.Int("job.min_available", int(job.MinAvailable)),
+		attribute.Int("job.pending_tasks", len(job.TaskStatusIndex[api.Pending])),
+	))
+	jobSpan := &JobSpan{ssn: ssn, job: job, span: span, timings: map[pluginHook]*pluginTiming{}}
+	ssn.jobSpan = jobSpan
+	return jobSpan
+}
+
+// End ends the span of the job with its outcome, the time spent by each plugin hook is recorded as a child span
+// whose duration is the accumulated time of the calls.
+func (s *JobSpan) End() {
+	if s == nil {
+		return
+	}
+	if s.ssn.jobSpan == s {
+		s.ssn.jobSpan = nil
+	}
+
+	s.mu.Lock()
+	hooks := make([]pluginHook, 0, len(s.timings))
+	for hook := range s.timings {
+		hooks = append(hooks, hook)
+	}
+	sort.Slice(hooks, func(i, j int) bool {
+		if hooks[i].hook != hooks[j].hook {
+			return hooks[i].hook < hooks[j].hook
+		}
+		return hooks[i].plugin < hooks[j].plugin
+	})
+	ctx := trace.ContextWithSpan(context.Background(), s.span)
+	for _, hook := range hooks {
+		timing := s.timings[hook]
+		_, span := tracing.Tracer().Start(ctx, hook.hook+"/"+hook.plugin, trace.WithTimestamp(timing.start),
+			trace.WithAttributes(
+				attribute.String("plugin", hook.plugin),
+				attribute.Int64("calls", timing.calls),
+				attribute.Int64("rejected", timing.rejected),
+			))
+		span.End(trace.WithTimestamp(timing.start.Add(timing.duration)))
+	}
+	s.mu.Unlock()
+
+	outcome := pendingOutcome
+	if s.ssn.JobReady(s.job) {
+		outcome = readyOutcome
+	} else if s.ssn.JobPipelined(s.job) {
+		outcome = pipelinedOutcome
+	}
+	s.span.SetAttributes(
+		attribute.String("job.outcome", outcome),
+		attribute.Int("job.allocated_tasks", s.ssn.allocatedTaskNum(s.job)),
+	)
+	s.span.End()
+}
+
+// observePlugin accumulates the time of a call of the plugin hook since start into the span of the job being
+// attempted, it may be called concurrently by the predicate workers.
+func (ssn *Session) observePlugin(plugin, hook string, start time.Time, rejected bool) {
+	s := ssn.jobSpan
+	if s == nil || start.IsZero() {
+		return
+	}
+	duration := time.Since(start)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := pluginHook{plugin: plugin, hook: hook}
+	timing, found := s.timings[key]
+	if !found {
+		timing = &pluginTiming{start: start}
+		s.timings[key] = timing
+	}
+	timing.duration += duration
+	timing.calls++
+	if rejected {
+		timing.rejected++
+	}
+}
+
+// pluginStart returns the start time of a call of a plugin hook, which is zero if the time of the plugins is not
+// accumulated into the span of a job.
+func (ssn *Session) pluginStart() time.Time {
+	if ssn.jobSpan == nil {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+// allocatedTaskNum returns the number of the allocated tasks of the job in the session.
+func (ssn *Session) allocatedTaskNum(job *api.JobInfo) int {
+	num := 0
+	if job, found := ssn.Jobs[job.UID]; found {
+		for status, tasks := range job.TaskStatusIndex {
+			if api.AllocatedStatus(status) {
+				num += len(tasks)
+			}
+		}
+	}
+	return num
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto" // auto-registry collectors in default registry
+)
+
+const (
+	// ConfigReloadSucceeded label
+	ConfigReloadSucceeded = "succeeded"
+
+	// ConfigReloadRolledBack label
+	ConfigReloadRolledBack = "rolled_back"
+)
+
+var (
+	schedulerConfigInfo = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "scheduler_config_info",
+			Help:      "Hash of the scheduler configuration in use, the value is always 1",
+		}, []string{"hash"},
+	)
+
+	schedulerConfigReloads = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "scheduler_config_reloads_total",
+			Help:      "Number of scheduler configuration reloads by result",
+		}, []string{"result"},
+	)
+)
+
+// UpdateSchedulerConfigHash records the hash of the scheduler configuration in use
+func UpdateSchedulerConfigHash(hash string) {
+	schedulerConfigInfo.Reset()
+	schedulerConfigInfo.WithLabelValues(hash).Set(1)
+}
+
+// RegisterSchedulerConfigReload records a reload of the scheduler configuration with its result
+func RegisterSchedulerConfigReload(result string) {
+	schedulerConfigReloads.WithLabelValues(result).Inc()
+}
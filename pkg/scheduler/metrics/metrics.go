@@ -17,6 +17,7 @@ limitations under the License.
 package metrics
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -133,6 +134,22 @@ var (
 		},
 	)
 
+	evictionCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "eviction_total",
+			Help:      "Number of tasks evicted by the actions, evictions in dry-run mode are only recorded but not executed",
+		}, []string{"action", "queue", "dry_run"},
+	)
+
+	evictionThrottledCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "eviction_throttled_total",
+			Help:      "Number of evictions skipped by the actions as the eviction budget is exhausted",
+		}, []string{"action", "queue"},
+	)
+
 	unscheduleTaskCount = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Subsystem: VolcanoSubSystemName,
@@ -214,6 +231,16 @@ func RegisterPreemptionAttempts() {
 	preemptionAttempts.Inc()
 }
 
+// RegisterEviction records the eviction of a task by the action
+func RegisterEviction(action, queue string, dryRun bool) {
+	evictionCount.WithLabelValues(action, queue, strconv.FormatBool(dryRun)).Inc()
+}
+
+// RegisterEvictionThrottled records the eviction skipped by the action as the eviction budget is exhausted
+func RegisterEvictionThrottled(action, queue string) {
+	evictionThrottledCount.WithLabelValues(action, queue).Inc()
+}
+
 // UpdateUnscheduleTaskCount records total number of unscheduleable tasks
 func UpdateUnscheduleTaskCount(jobID string, taskCount int) {
 	unscheduleTaskCount.WithLabelValues(jobID).Set(float64(taskCount))
@@ -105,6 +105,14 @@ var (
 		}, []string{"queue_name"},
 	)
 
+	queueUsageFactor = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: VolcanoSubSystemName,
+			Name:      "queue_usage_factor",
+			Help:      "Factor of the historical usage scaling the share of one queue",
+		}, []string{"queue_name"},
+	)
+
 	queueWeight = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Subsystem: VolcanoSubSystemName,
@@ -222,6 +230,11 @@ func UpdateQueueShare(queueName string, share float64) {
 	queueShare.WithLabelValues(queueName).Set(share)
 }
 
+// UpdateQueueUsageFactor records the factor of the historical usage for one queue
+func UpdateQueueUsageFactor(queueName string, factor float64) {
+	queueUsageFactor.WithLabelValues(queueName).Set(factor)
+}
+
 // UpdateQueueWeight records weight for one queue
 func UpdateQueueWeight(queueName string, weight int32) {
 	queueWeight.WithLabelValues(queueName).Set(float64(weight))
@@ -261,6 +274,7 @@ func DeleteQueueMetrics(queueName string) {
 	queueDeservedMilliCPU.DeleteLabelValues(queueName)
 	queueDeservedMemory.DeleteLabelValues(queueName)
 	queueShare.DeleteLabelValues(queueName)
+	queueUsageFactor.DeleteLabelValues(queueName)
 	queueWeight.DeleteLabelValues(queueName)
 	queueOverused.DeleteLabelValues(queueName)
 	queueCapacityMilliCPU.DeleteLabelValues(queueName)
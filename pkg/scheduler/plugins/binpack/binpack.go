@@ -91,6 +91,33 @@ func New(aruguments framework.Arguments) framework.Plugin {
 	return &binpackPlugin{weight: weight}
 }
 
+// ValidateArguments validates the weights in the arguments of the binpack plugin.
+func ValidateArguments(args framework.Arguments) error {
+	for _, key := range []string{BinpackWeight, BinpackCPU, BinpackMemory} {
+		if err := args.ValidateInt(key, 0); err != nil {
+			return err
+		}
+	}
+
+	argv, ok := args[BinpackResources]
+	if !ok {
+		return nil
+	}
+	resourcesStr, ok := argv.(string)
+	if !ok {
+		return fmt.Errorf("argument %s must be a comma separated list of resource names, got %v", BinpackResources, argv)
+	}
+	for _, resource := range strings.Split(resourcesStr, ",") {
+		if resource = strings.TrimSpace(resource); resource == "" {
+			continue
+		}
+		if err := args.ValidateInt(BinpackResourcesPrefix+resource, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func calculateWeight(args framework.Arguments) priorityWeight {
 	/*
 	   User Should give priorityWeight in this format(binpack.weight, binpack.cpu, binpack.memory).
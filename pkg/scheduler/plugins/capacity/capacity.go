@@ -34,6 +34,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/metrics"
 	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/fairshare"
 )
 
 const (
@@ -57,6 +58,8 @@ type capacityPlugin struct {
 	// These tasks reserve queue capacity to prevent other tasks from consuming it
 	// Rebuilt fresh at the start of each scheduling cycle in OnSessionOpen
 	queueGateReservedTasks map[api.QueueID]map[api.TaskID]*api.TaskInfo
+	// fairShare is the configuration of the historical usage accounting of the queues
+	fairShare *fairshare.Config
 }
 
 type queueAttr struct {
@@ -77,6 +80,8 @@ type queueAttr struct {
 	// realCapability represents the resource limit of the queue, LessEqual capability
 	realCapability *api.Resource
 	guarantee      *api.Resource
+	// usageFactor scales the share of the queue by its historical usage, 1 means it consumed its share of deserved
+	usageFactor float64
 }
 
 // New return capacityPlugin action
@@ -87,9 +92,15 @@ func New(arguments framework.Arguments) framework.Plugin {
 		queueOpts:              map[api.QueueID]*queueAttr{},
 		pluginArguments:        arguments,
 		queueGateReservedTasks: make(map[api.QueueID]map[api.TaskID]*api.TaskInfo),
+		fairShare:              fairshare.NewConfig(arguments),
 	}
 }
 
+// ValidateArguments validates the arguments of the capacity plugin.
+func ValidateArguments(arguments framework.Arguments) error {
+	return fairshare.ValidateArguments(arguments)
+}
+
 func (cp *capacityPlugin) Name() string {
 	return PluginName
 }
@@ -113,6 +124,7 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 	} else {
 		cp.buildQueueAttrs(ssn)
 	}
+	cp.updateUsageFactors(ssn)
 
 	ssn.AddReclaimableFn(cp.Name(), func(reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) ([]*api.TaskInfo, int) {
 		var victims []*api.TaskInfo
@@ -168,15 +180,16 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 			}
 
 			// Check deserved
+			deserved := attr.fairDeserved()
 			if exceeds, dims, reason := cp.checkDeservedExceedance(
-				allocated, attr.deserved, reclaimee, reclaimer, attr.name); !exceeds {
+				allocated, deserved, reclaimee, reclaimer, attr.name); !exceeds {
 				klog.V(5).Infof("%s", reason)
 				continue
 			} else {
 				klog.V(5).Infof("[capacity] Reclaimee <%s/%s> is a victim from queue <%s> for reclaimer <%s/%s>. "+
 					"Allocated: <%v>, Deserved: <%v>, Reclaimee Resreq: <%v>, Reclaimable on dimensions: %v.",
 					reclaimee.Namespace, reclaimee.Name, attr.queueID, reclaimer.Namespace, reclaimer.Name,
-					allocated, attr.deserved, reclaimee.Resreq, dims)
+					allocated, deserved, reclaimee.Resreq, dims)
 				allocated.Sub(reclaimee.Resreq)
 				victims = append(victims, reclaimee)
 				klog.V(5).Infof("[capacity] Current victims: %+v.", victims)
@@ -210,14 +223,15 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 		}
 
 		// If there is a single dimension whose deserved is greater than allocated, current task can reclaim by preempt others.
-		isPreemptive, resourceNames := futureUsed.LessEqualPartlyWithDimensionZeroFiltered(attr.deserved, task.Resreq)
+		deserved := attr.fairDeserved()
+		isPreemptive, resourceNames := futureUsed.LessEqualPartlyWithDimensionZeroFiltered(deserved, task.Resreq)
 		if isPreemptive {
 			klog.V(3).Infof("Queue <%v> can reclaim on resource dimensions: %v. "+
-				"The futureUsed: %v, deserved: %v, allocated: %v, task requested: %v",
-				queue.Name, resourceNames, futureUsed, attr.deserved, attr.allocated, task.Resreq)
+				"The futureUsed: %v, deserved: %v, allocated: %v, task requested: %v, usage factor: %v",
+				queue.Name, resourceNames, futureUsed, deserved, attr.allocated, task.Resreq, attr.usageFactor)
 		} else {
-			klog.V(3).Infof("Queue <%v> can not reclaim, futureUsed: %v, deserved: %v, requested: %v",
-				queue.Name, futureUsed, attr.deserved, task.Resreq)
+			klog.V(3).Infof("Queue <%v> can not reclaim, futureUsed: %v, deserved: %v, requested: %v, usage factor: %v",
+				queue.Name, futureUsed, deserved, task.Resreq, attr.usageFactor)
 		}
 
 		// PreemptiveFn is the opposite of OverusedFn in proportion plugin cause as long as there is a one-dimensional
@@ -468,7 +482,7 @@ func (cp *capacityPlugin) OnSessionOpen(ssn *framework.Session) {
 
 func (cp *capacityPlugin) OnSessionClose(ssn *framework.Session) {
 	for _, attr := range cp.queueOpts {
-		overused := attr.share > 1
+		overused := attr.share*attr.usageFactor > 1
 		metrics.UpdateQueueOverused(attr.name, overused)
 	}
 	cp.totalResource = nil
@@ -501,6 +515,8 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 				elastic:   api.EmptyResource(),
 				inqueue:   api.EmptyResource(),
 				guarantee: api.EmptyResource(),
+
+				usageFactor: 1,
 			}
 			if len(queue.Queue.Spec.Capability) != 0 {
 				attr.capability = api.NewResource(queue.Queue.Spec.Capability)
@@ -581,6 +597,7 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 	for queueID, queueInfo := range ssn.Queues {
 		queue := ssn.Queues[queueID]
 		if attr, ok := cp.queueOpts[queueID]; ok {
+			ssn.SetQueueDeserved(queueID, attr.deserved)
 			metrics.UpdateQueueDeserved(attr.name, attr.deserved.MilliCPU, attr.deserved.Memory, attr.deserved.ScalarResources)
 			metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
 			metrics.UpdateQueueRequest(attr.name, attr.request.MilliCPU, attr.request.Memory, attr.request.ScalarResources)
@@ -624,6 +641,12 @@ func (cp *capacityPlugin) buildQueueAttrs(ssn *framework.Session) {
 
 		return cp.compareShareWithDeserved(cp.queueOpts[lv.UID], cp.queueOpts[rv.UID])
 	})
+
+	if cp.fairShare.Enabled {
+		ssn.AddVictimQueueOrderFn(cp.Name(), func(l, r, preemptor interface{}) int {
+			return cp.compareUsageFactor(cp.queueOpts[l.(*api.QueueInfo).UID], cp.queueOpts[r.(*api.QueueInfo).UID])
+		})
+	}
 }
 
 func (cp *capacityPlugin) buildHierarchicalQueueAttrs(ssn *framework.Session) bool {
@@ -746,6 +769,7 @@ func (cp *capacityPlugin) buildHierarchicalQueueAttrs(ssn *framework.Session) bo
 	// Record metrics
 	for queueID := range ssn.Queues {
 		attr := cp.queueOpts[queueID]
+		ssn.SetQueueDeserved(queueID, attr.deserved)
 		metrics.UpdateQueueDeserved(attr.name, attr.deserved.MilliCPU, attr.deserved.Memory, attr.deserved.ScalarResources)
 		metrics.UpdateQueueAllocated(attr.name, attr.allocated.MilliCPU, attr.allocated.Memory, attr.allocated.ScalarResources)
 		metrics.UpdateQueueRequest(attr.name, attr.request.MilliCPU, attr.request.Memory, attr.request.ScalarResources)
@@ -797,7 +821,7 @@ func (cp *capacityPlugin) buildHierarchicalQueueAttrs(ssn *framework.Session) bo
 		rLevel := getQueueLevel(cp.queueOpts[rv.UID], cp.queueOpts[pv.UID])
 
 		if lLevel == rLevel {
-			return 0
+			return cp.compareUsageFactor(cp.queueOpts[lv.UID], cp.queueOpts[rv.UID])
 		}
 
 		if lLevel > rLevel {
@@ -825,6 +849,7 @@ func (cp *capacityPlugin) newQueueAttr(queue *api.QueueInfo) *queueAttr {
 		guarantee:      api.EmptyResource(),
 		capability:     api.EmptyResource(),
 		realCapability: api.EmptyResource(),
+		usageFactor:    1,
 	}
 	if len(queue.Queue.Spec.Capability) != 0 {
 		attr.capability = api.NewResource(queue.Queue.Spec.Capability)
@@ -947,7 +972,8 @@ func (cp *capacityPlugin) checkHierarchicalQueue(attr *queueAttr) {
 // queues with non-empty deserved are prioritized over best-effort queues.
 // Returns negative if l should come before r.
 func (cp *capacityPlugin) compareShareWithDeserved(lattr, rattr *queueAttr) int {
-	if lattr.share == rattr.share {
+	lShare, rShare := lattr.share*lattr.usageFactor, rattr.share*rattr.usageFactor
+	if lShare == rShare {
 		lHasDeserved := !lattr.deserved.IsEmpty()
 		rHasDeserved := !rattr.deserved.IsEmpty()
 		if lHasDeserved == rHasDeserved {
@@ -959,12 +985,45 @@ func (cp *capacityPlugin) compareShareWithDeserved(lattr, rattr *queueAttr) int
 		return 1
 	}
 
-	if lattr.share < rattr.share {
+	if lShare < rShare {
 		return -1
 	}
 	return 1
 }
 
+// compareUsageFactor orders the queue which consumed more resources historically first, so that its victims are
+// reclaimed first. Returns 0 if fair-share is disabled.
+func (cp *capacityPlugin) compareUsageFactor(lattr, rattr *queueAttr) int {
+	if lattr == nil || rattr == nil {
+		return 0
+	}
+	return fairshare.CompareUsageFactor(lattr.usageFactor, rattr.usageFactor)
+}
+
+// updateUsageFactors sets the usage factors of the leaf queues by their historical usage against the share of their
+// deserved resources in the cluster. The jobs only belong to the leaf queues, so the parent queues are ordered by their
+// current share only, and the queues without deserved resources keep the factor 1.
+func (cp *capacityPlugin) updateUsageFactors(ssn *framework.Session) {
+	targets := make(map[api.QueueID]float64, len(cp.queueOpts))
+	for queueID, attr := range cp.queueOpts {
+		if _, found := ssn.Queues[queueID]; !found || !cp.isLeafQueue(queueID) {
+			continue
+		}
+		targets[queueID] = fairshare.DominantShare(attr.deserved, cp.totalResource)
+	}
+	for queueID, factor := range fairshare.Usage.UsageFactors(ssn, cp.fairShare, targets) {
+		attr := cp.queueOpts[queueID]
+		attr.usageFactor = factor
+		metrics.UpdateQueueUsageFactor(attr.name, factor)
+	}
+}
+
+// fairDeserved returns the deserved resource of the queue scaled by its historical usage, so that the queue which
+// consumed more than its share of deserved historically reclaims less and is reclaimed earlier, and vice versa.
+func (qa *queueAttr) fairDeserved() *api.Resource {
+	return fairshare.Deserved(qa.deserved, qa.realCapability, qa.usageFactor)
+}
+
 func (cp *capacityPlugin) updateShare(attr *queueAttr) {
 	updateQueueAttrShare(attr)
 	metrics.UpdateQueueShare(attr.name, attr.share)
@@ -1155,6 +1214,7 @@ func (qa *queueAttr) Clone() *queueAttr {
 		capability:     qa.capability.Clone(),
 		realCapability: qa.realCapability.Clone(),
 		guarantee:      qa.guarantee.Clone(),
+		usageFactor:    qa.usageFactor,
 		children:       make(map[api.QueueID]*queueAttr),
 	}
 
@@ -987,6 +987,59 @@ func Test_updateQueueAttrShare(t *testing.T) {
 	}
 }
 
+func TestFairDeserved(t *testing.T) {
+	cp := &capacityPlugin{}
+	reclaimee := &api.TaskInfo{Namespace: "ns1", Name: "p1", Resreq: &api.Resource{MilliCPU: 1000, Memory: 1000}}
+	reclaimer := &api.TaskInfo{Namespace: "ns1", Name: "p2", Resreq: &api.Resource{MilliCPU: 1000, Memory: 1000}}
+	tests := []struct {
+		name             string
+		usageFactor      float64
+		realCapability   *api.Resource
+		expectedDeserved *api.Resource
+		expectedExceeds  bool
+	}{
+		{
+			name:             "queue consumed its share of deserved historically is not reclaimed below deserved",
+			usageFactor:      1,
+			expectedDeserved: &api.Resource{MilliCPU: 4000, Memory: 4000},
+		},
+		{
+			name:             "queue consumed more than its share of deserved historically is reclaimed below deserved",
+			usageFactor:      2,
+			expectedDeserved: &api.Resource{MilliCPU: 2000, Memory: 2000},
+			expectedExceeds:  true,
+		},
+		{
+			name:             "queue consumed less than its share of deserved historically is capped by real capability",
+			usageFactor:      0.5,
+			realCapability:   &api.Resource{MilliCPU: 6000, Memory: 10000},
+			expectedDeserved: &api.Resource{MilliCPU: 6000, Memory: 8000},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attr := &queueAttr{
+				name:           "q1",
+				deserved:       &api.Resource{MilliCPU: 4000, Memory: 4000},
+				allocated:      &api.Resource{MilliCPU: 3000, Memory: 3000},
+				realCapability: test.realCapability,
+				usageFactor:    test.usageFactor,
+			}
+			deserved := attr.fairDeserved()
+			if !deserved.Equal(test.expectedDeserved, api.Zero) {
+				t.Errorf("expected deserved %v, got %v", test.expectedDeserved, deserved)
+			}
+			if exceeds, _, _ := cp.checkDeservedExceedance(attr.allocated, deserved, reclaimee, reclaimer, attr.name); exceeds != test.expectedExceeds {
+				t.Errorf("expected exceeds %v, got %v", test.expectedExceeds, exceeds)
+			}
+			if attr.deserved.MilliCPU != 4000 {
+				t.Errorf("expected deserved of the queue not to be modified, got %v", attr.deserved)
+			}
+		})
+	}
+}
+
 // Test_buildHierarchicalQueueAttrs_nilSafety is a regression test for nil pointer
 // dereferences in buildHierarchicalQueueAttrs when the root queue or a job's queue
 // is absent from the session snapshot.
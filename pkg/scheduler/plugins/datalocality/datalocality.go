@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalocality
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	datav1alpha1 "volcano.sh/apis/pkg/apis/datadependency/v1alpha1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "datalocality"
+
+	// NodeLabelKeyArgument is the argument of the node label naming the cluster of the node,
+	// it is datadependency.volcano.sh/cluster-name by default.
+	NodeLabelKeyArgument = "datalocality.nodeLabelKey"
+
+	// errNotDataLocal is returned when the node is not in the clusters where the data of the job lives.
+	errNotDataLocal = "node(s) not in the clusters of the job data"
+)
+
+//
+// User should specify arguments in the config in this format:
+//
+//  actions: "enqueue, allocate, backfill"
+//  tiers:
+//  - plugins:
+//    - name: priority
+//    - name: gang
+//  - plugins:
+//    - name: predicates
+//    - name: proportion
+//    - name: datalocality
+//      arguments:
+//        datalocality.nodeLabelKey: datadependency.volcano.sh/cluster-name
+
+// dataLocalityPlugin places the jobs bound to a DataSource on the nodes in the clusters where the data lives,
+// the clusters are set on the PodGroup of the job by the datadependency controller.
+type dataLocalityPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	nodeLabelKey    string
+}
+
+// New return datalocality plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	dp := &dataLocalityPlugin{pluginArguments: arguments, nodeLabelKey: datav1alpha1.DataLocalityClusterNameLabelKey}
+	arguments.GetString(&dp.nodeLabelKey, NodeLabelKeyArgument)
+	return dp
+}
+
+func (dp *dataLocalityPlugin) Name() string {
+	return PluginName
+}
+
+func (dp *dataLocalityPlugin) OnSessionOpen(ssn *framework.Session) {
+	predicateFn := func(task *api.TaskInfo, node *api.NodeInfo) error {
+		job := ssn.Jobs[task.Job]
+		if job == nil {
+			return nil
+		}
+		clusters := dataClusters(job)
+		if clusters.Len() == 0 {
+			return nil
+		}
+
+		if node.Node == nil || !clusters.Has(node.Node.Labels[dp.nodeLabelKey]) {
+			klog.V(4).Infof("Task <%s/%s> could not be placed on node <%s> out of the data clusters %v",
+				task.Namespace, task.Name, node.Name, sets.List(clusters))
+			return api.NewFitErrWithStatus(task, node, &api.Status{Code: api.UnschedulableAndUnresolvable, Reason: errNotDataLocal})
+		}
+		return nil
+	}
+	ssn.AddPredicateFn(dp.Name(), predicateFn)
+}
+
+func (dp *dataLocalityPlugin) OnSessionClose(ssn *framework.Session) {}
+
+// dataClusters returns the clusters where the data of the job lives, empty means the job is not bound to any data.
+func dataClusters(job *api.JobInfo) sets.Set[string] {
+	clusters := sets.New[string]()
+	if job.PodGroup == nil {
+		return clusters
+	}
+	for _, name := range strings.Split(job.PodGroup.Annotations[datav1alpha1.DataLocalityClusterNamesAnnotationKey], ",") {
+		if name = strings.TrimSpace(name); len(name) != 0 {
+			clusters.Insert(name)
+		}
+	}
+	return clusters
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalocality
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	datav1alpha1 "volcano.sh/apis/pkg/apis/datadependency/v1alpha1"
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+func TestDataLocality(t *testing.T) {
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:             gang.PluginName,
+					EnabledJobReady:  &trueValue,
+					EnabledJobOrder:  &trueValue,
+					EnabledPredicate: &trueValue,
+				},
+				{
+					Name:             PluginName,
+					EnabledPredicate: &trueValue,
+				},
+			},
+		},
+	}
+	plugins := map[string]framework.PluginBuilder{
+		PluginName:      New,
+		gang.PluginName: gang.New,
+	}
+	clusterLabel := func(cluster string) map[string]string {
+		return map[string]string{datav1alpha1.DataLocalityClusterNameLabelKey: cluster}
+	}
+	nodes := []*v1.Node{
+		util.BuildNode("n1", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), clusterLabel("c1")),
+		util.BuildNode("n2", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), clusterLabel("c2")),
+		util.BuildNode("n3", api.BuildResourceList("2", "2Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+	}
+
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:    "the pods are placed on the nodes of the data clusters",
+			Plugins: plugins,
+			PodGroups: []*vcapisv1.PodGroup{
+				util.BuildPodGroupWithAnno("pg1", "c1", "q1", 2, nil, vcapisv1.PodGroupInqueue,
+					map[string]string{datav1alpha1.DataLocalityClusterNamesAnnotationKey: "c2, c3"}),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+			},
+			Nodes:  nodes,
+			Queues: []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+			ExpectBindMap: map[string]string{
+				"c1/p1": "n2",
+				"c1/p2": "n2",
+			},
+			ExpectBindsNum: 2,
+		},
+		{
+			Name:    "the pods are pending if no node is in the data clusters",
+			Plugins: plugins,
+			PodGroups: []*vcapisv1.PodGroup{
+				util.BuildPodGroupWithAnno("pg1", "c1", "q1", 1, nil, vcapisv1.PodGroupInqueue,
+					map[string]string{datav1alpha1.DataLocalityClusterNamesAnnotationKey: "c3"}),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+			},
+			Nodes:          nodes,
+			Queues:         []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+			ExpectBindMap:  map[string]string{},
+			ExpectBindsNum: 0,
+		},
+		{
+			Name:    "the pods of the job without data locality are placed on any node",
+			Plugins: plugins,
+			PodGroups: []*vcapisv1.PodGroup{
+				util.BuildPodGroup("pg1", "c1", "q1", 3, nil, vcapisv1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("2", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p2", "", v1.PodPending, api.BuildResourceList("2", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p3", "", v1.PodPending, api.BuildResourceList("2", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+			},
+			Nodes:            nodes,
+			Queues:           []*vcapisv1.Queue{util.BuildQueue("q1", 1, nil)},
+			ExpectBindsNum:   3,
+			MinimalBindCheck: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
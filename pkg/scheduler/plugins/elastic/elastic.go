@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elastic
+
+import (
+	"k8s.io/klog/v2"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+// PluginName indicates name of volcano scheduler plugin.
+const PluginName = "elastic"
+
+// elasticPlugin runs the elastic jobs between their minAvailable and replicas: the pods of an elastic job above
+// its minAvailable are only allocated after the other jobs, and are the first victims when the resources are
+// reclaimed or preempted.
+type elasticPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+}
+
+// New return elastic plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	return &elasticPlugin{pluginArguments: arguments}
+}
+
+func (ep *elasticPlugin) Name() string {
+	return PluginName
+}
+
+func (ep *elasticPlugin) OnSessionOpen(ssn *framework.Session) {
+	// The jobs in surplus order after the others, so that the victims are picked from them first,
+	// as the victims are picked in the reverse order of the jobs.
+	jobOrderFn := func(l, r interface{}) int {
+		lv := l.(*api.JobInfo)
+		rv := r.(*api.JobInfo)
+
+		lSurplus := inSurplus(lv)
+		rSurplus := inSurplus(rv)
+		klog.V(4).Infof("Elastic JobOrderFn: <%v/%v> is in surplus: %t, <%v/%v> is in surplus: %t",
+			lv.Namespace, lv.Name, lSurplus, rv.Namespace, rv.Name, rSurplus)
+
+		if lSurplus == rSurplus {
+			return 0
+		}
+		if lSurplus {
+			return 1
+		}
+		return -1
+	}
+	ssn.AddJobOrderFn(ep.Name(), jobOrderFn)
+}
+
+func (ep *elasticPlugin) OnSessionClose(ssn *framework.Session) {}
+
+// IsElastic checks whether the job runs elastically between its minAvailable and replicas.
+func IsElastic(job *api.JobInfo) bool {
+	return job.PodGroup != nil && job.PodGroup.Annotations[batch.JobElasticKey] == "true"
+}
+
+// inSurplus checks whether the job is elastic and has allocated its minAvailable, the more tasks it gets are in
+// surplus.
+func inSurplus(job *api.JobInfo) bool {
+	return IsElastic(job) && job.ReadyTaskNum() >= job.MinAvailable
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elastic
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	batch "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	vcapisv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/actions/reclaim"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/plugins/proportion"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func init() {
+	options.Default()
+}
+
+var (
+	trueValue   = true
+	elasticAnno = map[string]string{batch.JobElasticKey: "true"}
+	tiers       = []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:            PluginName,
+					EnabledJobOrder: &trueValue,
+				},
+				{
+					Name:                gang.PluginName,
+					EnabledJobOrder:     &trueValue,
+					EnabledJobReady:     &trueValue,
+					EnabledJobPipelined: &trueValue,
+					EnabledJobStarving:  &trueValue,
+					EnabledPreemptable:  &trueValue,
+					EnabledReclaimable:  &trueValue,
+				},
+				// The victims are left to gang, which keeps one surplus task of each job as the candidates.
+				{
+					Name:               proportion.PluginName,
+					EnabledQueueOrder:  &trueValue,
+					EnabledAllocatable: &trueValue,
+					EnabledOverused:    &trueValue,
+				},
+			},
+		},
+	}
+	plugins = map[string]framework.PluginBuilder{
+		PluginName:            New,
+		gang.PluginName:       gang.New,
+		proportion.PluginName: proportion.New,
+	}
+)
+
+func TestAllocate(t *testing.T) {
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:    "the surplus of elastic job is allocated after the other jobs",
+			Plugins: plugins,
+			PodGroups: []*vcapisv1.PodGroup{
+				util.BuildPodGroupWithAnno("pg1", "c1", "q1", 1, nil, vcapisv1.PodGroupInqueue, elasticAnno),
+				util.BuildPodGroup("pg2", "c1", "q1", 1, nil, vcapisv1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "p1-1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p1-2", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p1-3", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p2-1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg2", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p2-2", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg2", make(map[string]string), make(map[string]string)),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("3", "3Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			},
+			Queues: []*vcapisv1.Queue{
+				util.BuildQueue("q1", 1, nil),
+			},
+			ExpectBindsNum:   3,
+			MinimalBindCheck: true,
+			ExpectTaskStatusNums: map[api.JobID]map[api.TaskStatus]int{
+				"c1/pg1": {api.Binding: 1, api.Pending: 2},
+				"c1/pg2": {api.Binding: 2},
+			},
+		},
+		{
+			Name:    "the elastic job grows up to its replicas on idle resources",
+			Plugins: plugins,
+			PodGroups: []*vcapisv1.PodGroup{
+				util.BuildPodGroupWithAnno("pg1", "c1", "q1", 1, nil, vcapisv1.PodGroupInqueue, elasticAnno),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "p1-1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p1-2", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+				util.BuildPod("c1", "p1-3", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("3", "3Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			},
+			Queues: []*vcapisv1.Queue{
+				util.BuildQueue("q1", 1, nil),
+			},
+			ExpectBindsNum: 3,
+			ExpectBindMap: map[string]string{
+				"c1/p1-1": "n1",
+				"c1/p1-2": "n1",
+				"c1/p1-3": "n1",
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestReclaim(t *testing.T) {
+	tests := []uthelper.TestCommonStruct{
+		{
+			Name:    "the surplus of elastic job is reclaimed first",
+			Plugins: plugins,
+			PodGroups: []*vcapisv1.PodGroup{
+				util.BuildPodGroupWithAnno("pg1", "c1", "q1", 1, nil, vcapisv1.PodGroupRunning, elasticAnno),
+				util.BuildPodGroup("pg2", "c1", "q1", 1, nil, vcapisv1.PodGroupRunning),
+				util.BuildPodGroup("pg3", "c1", "q2", 1, nil, vcapisv1.PodGroupInqueue),
+			},
+			Pods: []*v1.Pod{
+				util.BuildPod("c1", "p1-1", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", map[string]string{vcapisv1.PodPreemptable: "false"}, make(map[string]string)),
+				util.BuildPod("c1", "p1-2", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg1", map[string]string{vcapisv1.PodPreemptable: "true"}, make(map[string]string)),
+				util.BuildPod("c1", "p2-1", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg2", map[string]string{vcapisv1.PodPreemptable: "true"}, make(map[string]string)),
+				util.BuildPod("c1", "p2-2", "n1", v1.PodRunning, api.BuildResourceList("1", "1G"), "pg2", map[string]string{vcapisv1.PodPreemptable: "true"}, make(map[string]string)),
+				util.BuildPod("c1", "p3-1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg3", make(map[string]string), make(map[string]string)),
+			},
+			Nodes: []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("4", "4Gi", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			},
+			Queues: []*vcapisv1.Queue{
+				util.BuildQueue("q1", 1, nil),
+				util.BuildQueue("q2", 1, nil),
+			},
+			ExpectEvictNum: 1,
+			ExpectEvicted:  []string{"c1/p1-2"},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			test.RegisterSession(tiers, nil)
+			defer test.Close()
+			test.Run([]framework.Action{reclaim.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
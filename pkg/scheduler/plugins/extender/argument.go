@@ -19,6 +19,8 @@ package extender
 import "volcano.sh/volcano/pkg/scheduler/api"
 
 type OnSessionOpenRequest struct {
+	// SessionID identifies the session in the other requests, the extender can look up the snapshot by it
+	SessionID      string
 	Jobs           map[api.JobID]*api.JobInfo
 	Nodes          map[string]*api.NodeInfo
 	Queues         map[api.QueueID]*api.QueueInfo
@@ -29,12 +31,17 @@ type OnSessionOpenRequest struct {
 
 type OnSessionOpenResponse struct{}
 
-type OnSessionCloseRequest struct{}
+type OnSessionCloseRequest struct {
+	SessionID string `json:"sessionID,omitempty"`
+}
 type OnSessionCloseResponse struct{}
 
 type PredicateRequest struct {
-	Task *api.TaskInfo `json:"task"`
-	Node *api.NodeInfo `json:"node"`
+	SessionID string        `json:"sessionID,omitempty"`
+	Task      *api.TaskInfo `json:"task"`
+	// Node is not sent if the nodes are sent in the session snapshot, refer to the node by NodeName then
+	Node     *api.NodeInfo `json:"node,omitempty"`
+	NodeName string        `json:"nodeName,omitempty"`
 }
 
 type PredicateResponse struct {
@@ -42,9 +49,27 @@ type PredicateResponse struct {
 	Code         int    `json:"code"`
 }
 
+// BatchPredicateRequest filters all the candidate nodes for a task at once.
+type BatchPredicateRequest struct {
+	SessionID string        `json:"sessionID,omitempty"`
+	Task      *api.TaskInfo `json:"task"`
+	// Nodes are not sent if the nodes are sent in the session snapshot, refer to the nodes by NodeNames then
+	Nodes     []*api.NodeInfo `json:"nodes,omitempty"`
+	NodeNames []string        `json:"nodeNames"`
+}
+
+type BatchPredicateResponse struct {
+	// NodeStatuses are the results of the nodes failing the predicate keyed by node name, the nodes absent pass it
+	NodeStatuses map[string]*PredicateResponse `json:"nodeStatuses"`
+	ErrorMessage string                        `json:"errorMessage"`
+}
+
 type PrioritizeRequest struct {
-	Task  *api.TaskInfo   `json:"task"`
-	Nodes []*api.NodeInfo `json:"nodes"`
+	SessionID string        `json:"sessionID,omitempty"`
+	Task      *api.TaskInfo `json:"task"`
+	// Nodes are not sent if the nodes are sent in the session snapshot, refer to the nodes by NodeNames then
+	Nodes     []*api.NodeInfo `json:"nodes,omitempty"`
+	NodeNames []string        `json:"nodeNames,omitempty"`
 }
 
 type PrioritizeResponse struct {
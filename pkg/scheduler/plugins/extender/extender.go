@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -32,6 +33,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/api"
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/batchpredicate"
 )
 
 const (
@@ -48,6 +50,9 @@ const (
 	ExtenderOnSessionCloseVerb = "extender.onSessionCloseVerb"
 	// ExtenderPredicateVerb is the verb of Predicate method
 	ExtenderPredicateVerb = "extender.predicateVerb"
+	// ExtenderBatchPredicateVerb is the verb of BatchPredicate method, which filters all the nodes for a task at once
+	// and is used instead of Predicate method if set
+	ExtenderBatchPredicateVerb = "extender.batchPredicateVerb"
 	// ExtenderPrioritizeVerb is the verb of Prioritize method
 	ExtenderPrioritizeVerb = "extender.prioritizeVerb"
 	// ExtenderPreemptableVerb is the verb of Preemptable method
@@ -66,6 +71,17 @@ const (
 	ExtenderDeallocateFuncVerb = "extender.deallocateFuncVerb"
 	// ExtenderIgnorable indicates whether the extender can ignore unexpected errors
 	ExtenderIgnorable = "extender.ignorable"
+	// ExtenderSessionSnapshot indicates the nodes are only sent in the snapshot of OnSessionOpen method, and the
+	// predicate and prioritize requests refer to the nodes by name
+	ExtenderSessionSnapshot = "extender.sessionSnapshot"
+	// ExtenderCAFile is the CA file to verify the certificate of the extender
+	ExtenderCAFile = "extender.caFile"
+	// ExtenderCertFile is the client certificate file for mTLS with the extender
+	ExtenderCertFile = "extender.certFile"
+	// ExtenderKeyFile is the client key file for mTLS with the extender
+	ExtenderKeyFile = "extender.keyFile"
+	// ExtenderInsecureSkipVerify indicates whether to skip verifying the certificate of the extender
+	ExtenderInsecureSkipVerify = "extender.insecureSkipVerify"
 
 	// 10MB
 	maxBodySize = 10 << 20
@@ -79,6 +95,7 @@ type extenderConfig struct {
 	onSessionOpenVerb  string
 	onSessionCloseVerb string
 	predicateVerb      string
+	batchPredicateVerb string
 	prioritizeVerb     string
 	preemptableVerb    string
 	reclaimableVerb    string
@@ -88,12 +105,20 @@ type extenderConfig struct {
 	allocateFuncVerb   string
 	deallocateFuncVerb string
 	ignorable          bool
+	sessionSnapshot    bool
 	managedResources   sets.Set[string]
+	tls                tlsConfig
 }
 
 type extenderPlugin struct {
 	client http.Client
 	config *extenderConfig
+	// err is the error to build the client, which fails all the calls
+	err       error
+	sessionID string
+
+	// predicates are the batch predicate results of the tasks in the session, the nodes are filtered once per task
+	predicates *batchpredicate.Cache[*BatchPredicateResponse]
 }
 
 func parseExtenderConfig(arguments framework.Arguments) *extenderConfig {
@@ -114,12 +139,17 @@ func parseExtenderConfig(arguments framework.Arguments) *extenderConfig {
 				   extender.onSessionOpenVerb: onSessionOpen
 				   extender.onSessionCloseVerb: onSessionClose
 				   extender.predicateVerb: predicate
+				   extender.batchPredicateVerb: batchPredicate
 				   extender.prioritizeVerb: prioritize
 				   extender.preemptableVerb: preemptable
 				   extender.reclaimableVerb: reclaimable
 				   extender.queueOverusedVerb: queueOverused
 				   extender.jobEnqueueableVerb: jobEnqueueable
 				   extender.ignorable: true
+				   extender.sessionSnapshot: true
+				   extender.caFile: /etc/volcano/extender/ca.crt
+				   extender.certFile: /etc/volcano/extender/tls.crt
+				   extender.keyFile: /etc/volcano/extender/tls.key
 				   extender.managedResources:
 				   - nvidia.com/gpu
 				   - nvidia.com/gpumem
@@ -131,6 +161,7 @@ func parseExtenderConfig(arguments framework.Arguments) *extenderConfig {
 	ec.onSessionOpenVerb, _ = arguments[ExtenderOnSessionOpenVerb].(string)
 	ec.onSessionCloseVerb, _ = arguments[ExtenderOnSessionCloseVerb].(string)
 	ec.predicateVerb, _ = arguments[ExtenderPredicateVerb].(string)
+	ec.batchPredicateVerb, _ = arguments[ExtenderBatchPredicateVerb].(string)
 	ec.prioritizeVerb, _ = arguments[ExtenderPrioritizeVerb].(string)
 	ec.preemptableVerb, _ = arguments[ExtenderPreemptableVerb].(string)
 	ec.reclaimableVerb, _ = arguments[ExtenderReclaimableVerb].(string)
@@ -141,6 +172,11 @@ func parseExtenderConfig(arguments framework.Arguments) *extenderConfig {
 	ec.deallocateFuncVerb, _ = arguments[ExtenderDeallocateFuncVerb].(string)
 
 	arguments.GetBool(&ec.ignorable, ExtenderIgnorable)
+	arguments.GetBool(&ec.sessionSnapshot, ExtenderSessionSnapshot)
+	arguments.GetString(&ec.tls.caFile, ExtenderCAFile)
+	arguments.GetString(&ec.tls.certFile, ExtenderCertFile)
+	arguments.GetString(&ec.tls.keyFile, ExtenderKeyFile)
+	arguments.GetBool(&ec.tls.insecureSkipVerify, ExtenderInsecureSkipVerify)
 
 	ec.httpTimeout = time.Second
 	if httpTimeout, _ := arguments[ExtenderHTTPTimeout].(string); httpTimeout != "" {
@@ -156,10 +192,46 @@ func parseExtenderConfig(arguments framework.Arguments) *extenderConfig {
 	return ec
 }
 
+// ValidateArguments validates the arguments of the extender plugin.
+func ValidateArguments(arguments framework.Arguments) error {
+	if urlPrefix, _ := arguments[ExtenderURLPrefix].(string); urlPrefix == "" {
+		return fmt.Errorf("argument %s is required", ExtenderURLPrefix)
+	}
+	if argv, ok := arguments[ExtenderHTTPTimeout]; ok {
+		httpTimeout, _ := argv.(string)
+		if _, err := time.ParseDuration(httpTimeout); err != nil {
+			return fmt.Errorf("argument %s must be a duration, got %v", ExtenderHTTPTimeout, argv)
+		}
+	}
+	var sessionSnapshot bool
+	arguments.GetBool(&sessionSnapshot, ExtenderSessionSnapshot)
+	if onSessionOpenVerb, _ := arguments[ExtenderOnSessionOpenVerb].(string); sessionSnapshot && onSessionOpenVerb == "" {
+		return fmt.Errorf("argument %s is required if %s is enabled", ExtenderOnSessionOpenVerb, ExtenderSessionSnapshot)
+	}
+	certFile, _ := arguments[ExtenderCertFile].(string)
+	keyFile, _ := arguments[ExtenderKeyFile].(string)
+	if (certFile == "") != (keyFile == "") {
+		return fmt.Errorf("arguments %s and %s must be set together", ExtenderCertFile, ExtenderKeyFile)
+	}
+	return framework.Validate[[]string](arguments, ExtenderManagedResources)
+}
+
 func New(arguments framework.Arguments) framework.Plugin {
 	cfg := parseExtenderConfig(arguments)
 	klog.V(4).Infof("Initialize extender plugin with endpoint address %s", cfg.urlPrefix)
-	return &extenderPlugin{client: http.Client{Timeout: cfg.httpTimeout}, config: cfg}
+	ep := &extenderPlugin{
+		client:     http.Client{Timeout: cfg.httpTimeout},
+		config:     cfg,
+		predicates: batchpredicate.NewCache[*BatchPredicateResponse](),
+	}
+	transport, err := getTransport(cfg.tls)
+	if err != nil {
+		klog.Errorf("Failed to build the transport to extender %s: %v", cfg.urlPrefix, err)
+		ep.err = err
+		return ep
+	}
+	ep.client.Transport = transport
+	return ep
 }
 
 func (ep *extenderPlugin) Name() string {
@@ -167,13 +239,20 @@ func (ep *extenderPlugin) Name() string {
 }
 
 func (ep *extenderPlugin) OnSessionOpen(ssn *framework.Session) {
+	ep.sessionID = string(ssn.UID)
 	if ep.config.onSessionOpenVerb != "" {
+		nodeList := make([]string, 0, len(ssn.NodeList))
+		for _, node := range ssn.NodeList {
+			nodeList = append(nodeList, node.Name)
+		}
 		err := ep.send(ep.config.onSessionOpenVerb, &OnSessionOpenRequest{
+			SessionID:      ep.sessionID,
 			Jobs:           ssn.Jobs,
 			Nodes:          ssn.Nodes,
 			Queues:         ssn.Queues,
 			NamespaceInfo:  ssn.NamespaceInfo,
 			RevocableNodes: ssn.RevocableNodes,
+			NodeList:       nodeList,
 		}, nil)
 		if err != nil {
 			klog.Warningf("OnSessionClose failed with error %v", err)
@@ -183,32 +262,44 @@ func (ep *extenderPlugin) OnSessionOpen(ssn *framework.Session) {
 		}
 	}
 
-	if ep.config.predicateVerb != "" {
+	if ep.config.batchPredicateVerb != "" {
 		ssn.AddPredicateFn(ep.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
 			if !ep.IsInterested(task) {
 				return nil
 			}
 
-			resp := &PredicateResponse{}
-			err := ep.send(ep.config.predicateVerb, &PredicateRequest{Task: task, Node: node}, resp)
+			resp, err := ep.batchPredicate(ssn, task)
 			if err != nil {
-				klog.Warningf("Predicate failed with error %v", err)
+				klog.Warningf("BatchPredicate failed with error %v", err)
 
 				if ep.config.ignorable {
 					return nil
 				}
 				return api.NewFitError(task, node, err.Error())
 			}
-
-			if len(resp.ErrorMessage) == 0 {
+			return predicateError(task, node, resp.NodeStatuses[node.Name])
+		})
+	} else if ep.config.predicateVerb != "" {
+		ssn.AddPredicateFn(ep.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
+			if !ep.IsInterested(task) {
 				return nil
 			}
-			// keep compatibility with old behavior: error messages length is not zero,
-			// but didn't return a code, and code will be 0 for default. Change code to Error for corresponding
-			if resp.Code == api.Success {
-				resp.Code = api.Error
+
+			req := &PredicateRequest{SessionID: ep.sessionID, Task: task, NodeName: node.Name}
+			if !ep.config.sessionSnapshot {
+				req.Node = node
+			}
+			resp := &PredicateResponse{}
+			err := ep.send(ep.config.predicateVerb, req, resp)
+			if err != nil {
+				klog.Warningf("Predicate failed with error %v", err)
+
+				if ep.config.ignorable {
+					return nil
+				}
+				return api.NewFitError(task, node, err.Error())
 			}
-			return api.NewFitErrWithStatus(task, node, &api.Status{Code: resp.Code, Reason: resp.ErrorMessage, Plugin: PluginName})
+			return predicateError(task, node, resp)
 		})
 	}
 
@@ -218,8 +309,17 @@ func (ep *extenderPlugin) OnSessionOpen(ssn *framework.Session) {
 				return map[string]float64{}, nil
 			}
 
+			req := &PrioritizeRequest{SessionID: ep.sessionID, Task: task}
+			if ep.config.sessionSnapshot {
+				req.NodeNames = make([]string, 0, len(nodes))
+				for _, node := range nodes {
+					req.NodeNames = append(req.NodeNames, node.Name)
+				}
+			} else {
+				req.Nodes = nodes
+			}
 			resp := &PrioritizeResponse{}
-			err := ep.send(ep.config.prioritizeVerb, &PrioritizeRequest{Task: task, Nodes: nodes}, resp)
+			err := ep.send(ep.config.prioritizeVerb, req, resp)
 			if err != nil {
 				klog.Warningf("Prioritize failed with error %v", err)
 
@@ -331,13 +431,52 @@ func (ep *extenderPlugin) OnSessionOpen(ssn *framework.Session) {
 
 func (ep *extenderPlugin) OnSessionClose(ssn *framework.Session) {
 	if ep.config.onSessionCloseVerb != "" {
-		if err := ep.send(ep.config.onSessionCloseVerb, &OnSessionCloseRequest{}, nil); err != nil {
+		if err := ep.send(ep.config.onSessionCloseVerb, &OnSessionCloseRequest{SessionID: ep.sessionID}, nil); err != nil {
 			klog.Warningf("OnSessionClose failed with error %v", err)
 		}
 	}
 }
 
+// batchPredicate filters all the nodes of the session for the task by a single call on the first node, and the result
+// is reused for the other nodes until a task is allocated or deallocated in the session.
+func (ep *extenderPlugin) batchPredicate(ssn *framework.Session, task *api.TaskInfo) (*BatchPredicateResponse, error) {
+	return ep.predicates.Get(task, func() (*BatchPredicateResponse, error) {
+		req := &BatchPredicateRequest{SessionID: ep.sessionID, Task: task, NodeNames: make([]string, 0, len(ssn.NodeList))}
+		for _, node := range ssn.NodeList {
+			req.NodeNames = append(req.NodeNames, node.Name)
+		}
+		if !ep.config.sessionSnapshot {
+			req.Nodes = ssn.NodeList
+		}
+		resp := &BatchPredicateResponse{}
+		if err := ep.send(ep.config.batchPredicateVerb, req, resp); err != nil {
+			return nil, err
+		}
+		if resp.ErrorMessage != "" {
+			return nil, errors.New(resp.ErrorMessage)
+		}
+		return resp, nil
+	})
+}
+
+// predicateError converts the predicate response of the node to the fit error, nil means the node passes.
+func predicateError(task *api.TaskInfo, node *api.NodeInfo, resp *PredicateResponse) error {
+	if resp == nil || len(resp.ErrorMessage) == 0 {
+		return nil
+	}
+	code := resp.Code
+	// keep compatibility with old behavior: error messages length is not zero,
+	// but didn't return a code, and code will be 0 for default. Change code to Error for corresponding
+	if code == api.Success {
+		code = api.Error
+	}
+	return api.NewFitErrWithStatus(task, node, &api.Status{Code: code, Reason: resp.ErrorMessage, Plugin: PluginName})
+}
+
 func (ep *extenderPlugin) send(action string, args interface{}, result interface{}) error {
+	if ep.err != nil {
+		return ep.err
+	}
 	out, err := json.Marshal(args)
 	if err != nil {
 		return err
@@ -356,7 +495,11 @@ func (ep *extenderPlugin) send(action string, args interface{}, result interface
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
+	defer func() {
+		// drain the body so that the connection is kept alive for the following calls
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxBodySize))
+		resp.Body.Close()
+	}()
 
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("failed %v with extender at URL %v, code %v", action, url, resp.StatusCode)
@@ -453,4 +596,15 @@ func addEventHandler(ssn *framework.Session, ep *extenderPlugin) {
 	}
 
 	ssn.AddEventHandler(&eventHandler)
+
+	if ep.config.batchPredicateVerb != "" {
+		// the batched predicate results are filtered against the node state, drop them once it changes
+		invalidate := func(event *framework.Event) {
+			ep.predicates.Invalidate()
+		}
+		ssn.AddEventHandler(&framework.EventHandler{
+			AllocateFunc:   invalidate,
+			DeallocateFunc: invalidate,
+		})
+	}
 }
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender_test
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/extender"
+	"volcano.sh/volcano/pkg/scheduler/plugins/extender/sdk"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestMain(m *testing.M) {
+	options.Default()
+	os.Exit(m.Run())
+}
+
+func TestReferenceExtender(t *testing.T) {
+	tests := []struct {
+		uthelper.TestCommonStruct
+		sessionSnapshot bool
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "nodes are referred by name in the session snapshot",
+				ExpectBindMap:  map[string]string{"c1/p1": "n3"},
+				ExpectBindsNum: 1,
+			},
+			sessionSnapshot: true,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "nodes are sent in the requests",
+				ExpectBindMap:  map[string]string{"c1/p1": "n3"},
+				ExpectBindsNum: 1,
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			lock := sync.Mutex{}
+			calls := map[string]int{}
+			server := sdk.NewReferenceServer("extender.volcano.sh/exclude")
+			ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				lock.Lock()
+				calls[r.URL.Path]++
+				lock.Unlock()
+				server.ServeHTTP(w, r)
+			}))
+			defer ts.Close()
+			caFile := filepath.Join(t.TempDir(), "ca.crt")
+			if err := os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}), 0600); err != nil {
+				t.Fatalf("failed to write CA file: %v", err)
+			}
+
+			trueValue := true
+			tiers := []conf.Tier{
+				{
+					Plugins: []conf.PluginOption{
+						{
+							Name:            gang.PluginName,
+							EnabledJobReady: &trueValue,
+						},
+						{
+							Name:             extender.PluginName,
+							EnabledPredicate: &trueValue,
+							EnabledNodeOrder: &trueValue,
+							Arguments: framework.Arguments{
+								extender.ExtenderURLPrefix:          ts.URL,
+								extender.ExtenderCAFile:             caFile,
+								extender.ExtenderOnSessionOpenVerb:  sdk.OnSessionOpenVerb,
+								extender.ExtenderOnSessionCloseVerb: sdk.OnSessionCloseVerb,
+								extender.ExtenderBatchPredicateVerb: sdk.BatchPredicateVerb,
+								extender.ExtenderPrioritizeVerb:     sdk.PrioritizeVerb,
+								extender.ExtenderSessionSnapshot:    test.sessionSnapshot,
+							},
+						},
+					},
+				},
+			}
+
+			// n1 is the most idle but excluded, so the task is placed on n3 which is more idle than n2
+			test.Plugins = map[string]framework.PluginBuilder{gang.PluginName: gang.New, extender.PluginName: extender.New}
+			test.Nodes = []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("8", "8G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), map[string]string{"extender.volcano.sh/exclude": "true"}),
+				util.BuildNode("n2", api.BuildResourceList("2", "8G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+				util.BuildNode("n3", api.BuildResourceList("4", "8G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			}
+			test.PodGroups = []*schedulingv1.PodGroup{util.BuildPodGroup("pg1", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue)}
+			test.Pods = []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+			}
+			test.Queues = []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)}
+
+			ssn := test.RegisterSession(tiers, nil)
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+			test.Close()
+
+			lock.Lock()
+			defer lock.Unlock()
+			if calls["/"+sdk.BatchPredicateVerb] != 1 {
+				t.Errorf("expected the nodes filtered by a single batch predicate call, got %d calls", calls["/"+sdk.BatchPredicateVerb])
+			}
+			if calls["/"+sdk.OnSessionOpenVerb] != 1 || calls["/"+sdk.OnSessionCloseVerb] != 1 {
+				t.Errorf("expected the session opened and closed once, got %v", calls)
+			}
+			if server.Session(string(ssn.UID)) != nil {
+				t.Errorf("expected the snapshot of session %s dropped once closed", ssn.UID)
+			}
+		})
+	}
+}
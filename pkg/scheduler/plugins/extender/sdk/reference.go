@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"fmt"
+	"math"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/plugins/extender"
+)
+
+// maxNodeScore is the score of the node with the most idle cpu given by the reference extender
+const maxNodeScore = 100
+
+// NewReferenceServer returns the reference extender. It filters out the nodes labeled with the exclude label set to
+// "true" and prefers the nodes with more idle cpu, reading the nodes from the session snapshot if they are referred by
+// name only. It serves as an example and in the tests of the extender plugin.
+func NewReferenceServer(excludeLabel string) *Server {
+	s := NewServer()
+	s.HandleBatchPredicate(func(req *extender.BatchPredicateRequest) *extender.BatchPredicateResponse {
+		nodes, err := s.lookupNodes(req.SessionID, req.Nodes, req.NodeNames)
+		if err != nil {
+			return &extender.BatchPredicateResponse{ErrorMessage: err.Error()}
+		}
+		resp := &extender.BatchPredicateResponse{NodeStatuses: map[string]*extender.PredicateResponse{}}
+		for _, node := range nodes {
+			if node.Node != nil && node.Node.Labels[excludeLabel] == "true" {
+				resp.NodeStatuses[node.Name] = &extender.PredicateResponse{
+					ErrorMessage: fmt.Sprintf("node is labeled with %s", excludeLabel),
+					Code:         api.UnschedulableAndUnresolvable,
+				}
+			}
+		}
+		return resp
+	})
+	s.HandlePrioritize(func(req *extender.PrioritizeRequest) *extender.PrioritizeResponse {
+		nodes, err := s.lookupNodes(req.SessionID, req.Nodes, req.NodeNames)
+		if err != nil {
+			return &extender.PrioritizeResponse{ErrorMessage: err.Error()}
+		}
+		maxIdle := 0.0
+		for _, node := range nodes {
+			if node.Idle != nil {
+				maxIdle = math.Max(maxIdle, node.Idle.MilliCPU)
+			}
+		}
+		resp := &extender.PrioritizeResponse{NodeScore: map[string]float64{}}
+		for _, node := range nodes {
+			score := 0.0
+			if node.Idle != nil && maxIdle > 0 {
+				score = maxNodeScore * node.Idle.MilliCPU / maxIdle
+			}
+			resp.NodeScore[node.Name] = score
+		}
+		return resp
+	})
+	return s
+}
+
+// lookupNodes returns the nodes sent in the request, or the nodes referred by name in the session snapshot.
+func (s *Server) lookupNodes(sessionID string, nodes []*api.NodeInfo, nodeNames []string) ([]*api.NodeInfo, error) {
+	if len(nodes) != 0 {
+		return nodes, nil
+	}
+	session := s.Session(sessionID)
+	if session == nil {
+		return nil, fmt.Errorf("snapshot of session %s not found", sessionID)
+	}
+	result := make([]*api.NodeInfo, 0, len(nodeNames))
+	for _, name := range nodeNames {
+		node, found := session.Nodes[name]
+		if !found {
+			return nil, fmt.Errorf("node %s not found in the snapshot of session %s", name, sessionID)
+		}
+		result = append(result, node)
+	}
+	return result, nil
+}
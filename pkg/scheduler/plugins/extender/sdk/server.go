@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sdk helps to implement the extenders of the extender plugin of the Volcano scheduler in Go. The Server
+// routes the verbs to the handlers, decodes the requests and encodes the responses, and keeps the snapshots of the
+// sessions so that the handlers can look up the nodes referred by name if the session snapshot is enabled.
+package sdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/plugins/extender"
+)
+
+// The default verbs of the extender, which are configured in the arguments of the extender plugin.
+const (
+	OnSessionOpenVerb  = "onSessionOpen"
+	OnSessionCloseVerb = "onSessionClose"
+	PredicateVerb      = "predicate"
+	BatchPredicateVerb = "batchPredicate"
+	PrioritizeVerb     = "prioritize"
+	PreemptableVerb    = "preemptable"
+	ReclaimableVerb    = "reclaimable"
+	JobEnqueueableVerb = "jobEnqueueable"
+
+	// maxSessions is the maximum number of the session snapshots kept, the oldest one is dropped if the scheduler
+	// failed to close it
+	maxSessions = 8
+	// maxBodySize is the maximum size of the requests, the session snapshot of a large cluster can be tens of MB
+	maxBodySize = 256 << 20
+)
+
+// Server is the HTTP handler of an extender.
+type Server struct {
+	mux *http.ServeMux
+
+	lock sync.RWMutex
+	// sessions are the snapshots of the open sessions keyed by session ID
+	sessions map[string]*extender.OnSessionOpenRequest
+	// sessionIDs are the IDs of the open sessions in the order they are opened
+	sessionIDs []string
+}
+
+// NewServer returns a server handling the OnSessionOpen and OnSessionClose verbs by keeping the session snapshots.
+func NewServer() *Server {
+	s := &Server{
+		mux:      http.NewServeMux(),
+		sessions: map[string]*extender.OnSessionOpenRequest{},
+	}
+	handle(s, OnSessionOpenVerb, func(req *extender.OnSessionOpenRequest) *extender.OnSessionOpenResponse {
+		s.openSession(req)
+		return &extender.OnSessionOpenResponse{}
+	})
+	handle(s, OnSessionCloseVerb, func(req *extender.OnSessionCloseRequest) *extender.OnSessionCloseResponse {
+		s.closeSession(req.SessionID)
+		return &extender.OnSessionCloseResponse{}
+	})
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Session returns the snapshot of the session, nil if the session is not open or the snapshot is not sent.
+func (s *Server) Session(sessionID string) *extender.OnSessionOpenRequest {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.sessions[sessionID]
+}
+
+// HandlePredicate handles the Predicate verb.
+func (s *Server) HandlePredicate(fn func(*extender.PredicateRequest) *extender.PredicateResponse) {
+	handle(s, PredicateVerb, fn)
+}
+
+// HandleBatchPredicate handles the BatchPredicate verb.
+func (s *Server) HandleBatchPredicate(fn func(*extender.BatchPredicateRequest) *extender.BatchPredicateResponse) {
+	handle(s, BatchPredicateVerb, fn)
+}
+
+// HandlePrioritize handles the Prioritize verb.
+func (s *Server) HandlePrioritize(fn func(*extender.PrioritizeRequest) *extender.PrioritizeResponse) {
+	handle(s, PrioritizeVerb, fn)
+}
+
+// HandlePreemptable handles the Preemptable verb.
+func (s *Server) HandlePreemptable(fn func(*extender.PreemptableRequest) *extender.PreemptableResponse) {
+	handle(s, PreemptableVerb, fn)
+}
+
+// HandleReclaimable handles the Reclaimable verb.
+func (s *Server) HandleReclaimable(fn func(*extender.ReclaimableRequest) *extender.ReclaimableResponse) {
+	handle(s, ReclaimableVerb, fn)
+}
+
+// HandleJobEnqueueable handles the JobEnqueueable verb.
+func (s *Server) HandleJobEnqueueable(fn func(*extender.JobEnqueueableRequest) *extender.JobEnqueueableResponse) {
+	handle(s, JobEnqueueableVerb, fn)
+}
+
+// handle registers the handler of the verb, which decodes the request and encodes the response in JSON.
+func handle[Req, Resp any](s *Server, verb string, fn func(*Req) *Resp) {
+	s.mux.HandleFunc("/"+strings.TrimLeft(verb, "/"), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		req := new(Req)
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(req); err != nil {
+			klog.Errorf("Failed to decode the request of verb %s: %v", verb, err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(fn(req)); err != nil {
+			klog.Errorf("Failed to encode the response of verb %s: %v", verb, err)
+		}
+	})
+}
+
+func (s *Server) openSession(req *extender.OnSessionOpenRequest) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, found := s.sessions[req.SessionID]; !found {
+		s.sessionIDs = append(s.sessionIDs, req.SessionID)
+	}
+	s.sessions[req.SessionID] = req
+	for len(s.sessionIDs) > maxSessions {
+		klog.Warningf("Drop the snapshot of session %s which is not closed.", s.sessionIDs[0])
+		delete(s.sessions, s.sessionIDs[0])
+		s.sessionIDs = s.sessionIDs[1:]
+	}
+}
+
+func (s *Server) closeSession(sessionID string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.sessions, sessionID)
+	for i, id := range s.sessionIDs {
+		if id == sessionID {
+			s.sessionIDs = append(s.sessionIDs[:i], s.sessionIDs[i+1:]...)
+			break
+		}
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"volcano.sh/volcano/pkg/scheduler/plugins/extender"
+)
+
+func TestServerSessions(t *testing.T) {
+	s := NewServer()
+	post := func(verb, body string) int {
+		recorder := httptest.NewRecorder()
+		s.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/"+verb, strings.NewReader(body)))
+		return recorder.Code
+	}
+
+	for i := 0; i <= maxSessions; i++ {
+		if code := post(OnSessionOpenVerb, fmt.Sprintf(`{"SessionID": "s%d"}`, i)); code != http.StatusOK {
+			t.Fatalf("expected session s%d opened, got code %d", i, code)
+		}
+	}
+	if s.Session("s0") != nil {
+		t.Errorf("expected the oldest session dropped once more than %d sessions are open", maxSessions)
+	}
+	if s.Session("s1") == nil {
+		t.Errorf("expected session s1 kept")
+	}
+
+	if code := post(OnSessionCloseVerb, `{"sessionID": "s1"}`); code != http.StatusOK {
+		t.Fatalf("expected session s1 closed, got code %d", code)
+	}
+	if s.Session("s1") != nil {
+		t.Errorf("expected session s1 dropped once closed")
+	}
+
+	if code := post(OnSessionOpenVerb, `not json`); code != http.StatusBadRequest {
+		t.Errorf("expected bad request for invalid body, got code %d", code)
+	}
+	recorder := httptest.NewRecorder()
+	s.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/"+OnSessionOpenVerb, nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected method not allowed for GET, got code %d", recorder.Code)
+	}
+}
+
+func TestLookupNodes(t *testing.T) {
+	s := NewReferenceServer("exclude")
+	s.openSession(&extender.OnSessionOpenRequest{SessionID: "s1"})
+	if _, err := s.lookupNodes("s1", nil, []string{"n1"}); err == nil {
+		t.Errorf("expected error for the node absent in the snapshot")
+	}
+	if _, err := s.lookupNodes("s2", nil, []string{"n1"}); err == nil {
+		t.Errorf("expected error for the session not open")
+	}
+}
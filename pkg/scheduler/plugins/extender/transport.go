@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// maxIdleConnsPerHost is the number of kept-alive connections to the extender, which are reused by the calls of
+	// the sessions in parallel
+	maxIdleConnsPerHost = 64
+	idleConnTimeout     = 90 * time.Second
+)
+
+var (
+	transportLock sync.Mutex
+	// transports are the transports shared by the sessions keyed by the TLS configuration, so that the connections
+	// are kept alive across the sessions rather than established for every session.
+	transports = map[tlsConfig]*cachedTransport{}
+)
+
+// cachedTransport is a shared transport with the modification times of the TLS files it is built with, it is rebuilt
+// once the files change, e.g. the certificates are rotated.
+type cachedTransport struct {
+	transport *http.Transport
+	modTimes  [3]time.Time
+}
+
+// tlsConfig is the TLS configuration to connect to the extender, the extender is connected without TLS if the
+// urlPrefix is not https.
+type tlsConfig struct {
+	caFile             string
+	certFile           string
+	keyFile            string
+	insecureSkipVerify bool
+}
+
+// getTransport returns the transport for the TLS configuration, which is shared until the TLS files change. The
+// transport is rebuilt with the changed files, and the previous one is kept if the files fail to load, e.g. while
+// the certificate and the key are being replaced.
+func getTransport(config tlsConfig) (*http.Transport, error) {
+	transportLock.Lock()
+	defer transportLock.Unlock()
+
+	modTimes := config.modTimes()
+	cached, found := transports[config]
+	if found && cached.modTimes == modTimes {
+		return cached.transport, nil
+	}
+
+	tlsClientConfig, err := buildTLSConfig(config)
+	if err != nil {
+		if found {
+			klog.Warningf("Failed to reload the TLS files of extender, keep using the previous ones: %v", err)
+			return cached.transport, nil
+		}
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	transport.IdleConnTimeout = idleConnTimeout
+	transport.TLSClientConfig = tlsClientConfig
+	if found {
+		klog.V(3).Infof("TLS files of extender changed, the transport is rebuilt.")
+		cached.transport.CloseIdleConnections()
+	}
+	transports[config] = &cachedTransport{transport: transport, modTimes: modTimes}
+	return transport, nil
+}
+
+// modTimes returns the modification times of the CA, certificate and key files, zero for the files not configured
+// or not found.
+func (config tlsConfig) modTimes() [3]time.Time {
+	var modTimes [3]time.Time
+	for i, file := range []string{config.caFile, config.certFile, config.keyFile} {
+		if file == "" {
+			continue
+		}
+		if info, err := os.Stat(file); err == nil {
+			modTimes[i] = info.ModTime()
+		}
+	}
+	return modTimes
+}
+
+// buildTLSConfig builds the TLS configuration verifying the extender by the CA, and presenting the client certificate
+// for mTLS if it is configured.
+func buildTLSConfig(config tlsConfig) (*tls.Config, error) {
+	tlsClientConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.insecureSkipVerify,
+	}
+	if config.caFile != "" {
+		ca, err := os.ReadFile(config.caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file %s: %v", config.caFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificate found in CA file %s", config.caFile)
+		}
+		tlsClientConfig.RootCAs = pool
+	}
+	if config.certFile != "" || config.keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.certFile, config.keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate %s and key %s: %v", config.certFile, config.keyFile, err)
+		}
+		tlsClientConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsClientConfig, nil
+}
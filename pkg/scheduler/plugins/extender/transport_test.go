@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestGetTransportReloadsTLSFiles(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	writeFile := func(data []byte, modTime time.Time) {
+		if err := os.WriteFile(caFile, data, 0600); err != nil {
+			t.Fatalf("failed to write CA file: %v", err)
+		}
+		if err := os.Chtimes(caFile, modTime, modTime); err != nil {
+			t.Fatalf("failed to change the modification time of CA file: %v", err)
+		}
+	}
+	config := tlsConfig{caFile: caFile}
+	defer func() {
+		transportLock.Lock()
+		delete(transports, config)
+		transportLock.Unlock()
+	}()
+
+	now := time.Now()
+	writeFile(ca, now.Add(-time.Hour))
+	transport, err := getTransport(config)
+	if err != nil {
+		t.Fatalf("failed to get transport: %v", err)
+	}
+	if reused, _ := getTransport(config); reused != transport {
+		t.Errorf("expected the transport to be reused while the TLS files are not changed")
+	}
+
+	writeFile(ca, now)
+	rebuilt, err := getTransport(config)
+	if err != nil {
+		t.Fatalf("failed to get transport: %v", err)
+	}
+	if rebuilt == transport {
+		t.Errorf("expected the transport to be rebuilt once the TLS files are changed")
+	}
+	if _, err := (&http.Client{Transport: rebuilt}).Get(ts.URL); err != nil {
+		t.Errorf("expected the extender verified by the reloaded CA, got %v", err)
+	}
+
+	writeFile([]byte("invalid"), now.Add(time.Hour))
+	if kept, err := getTransport(config); err != nil || kept != rebuilt {
+		t.Errorf("expected the previous transport kept if the TLS files fail to load, got %v", err)
+	}
+
+	if _, err := getTransport(tlsConfig{caFile: filepath.Join(t.TempDir(), "missing.crt")}); err == nil {
+		t.Errorf("expected the error if the TLS files are not found")
+	}
+}
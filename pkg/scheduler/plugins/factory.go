@@ -26,8 +26,10 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/plugins/capacity"
 	"volcano.sh/volcano/pkg/scheduler/plugins/cdp"
 	"volcano.sh/volcano/pkg/scheduler/plugins/conformance"
+	"volcano.sh/volcano/pkg/scheduler/plugins/datalocality"
 	"volcano.sh/volcano/pkg/scheduler/plugins/deviceshare"
 	"volcano.sh/volcano/pkg/scheduler/plugins/drf"
+	"volcano.sh/volcano/pkg/scheduler/plugins/elastic"
 	"volcano.sh/volcano/pkg/scheduler/plugins/extender"
 	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
 	networktopologyaware "volcano.sh/volcano/pkg/scheduler/plugins/network-topology-aware"
@@ -39,7 +41,9 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/plugins/predicates"
 	"volcano.sh/volcano/pkg/scheduler/plugins/priority"
 	"volcano.sh/volcano/pkg/scheduler/plugins/proportion"
+	"volcano.sh/volcano/pkg/scheduler/plugins/remote"
 	"volcano.sh/volcano/pkg/scheduler/plugins/rescheduling"
+	"volcano.sh/volcano/pkg/scheduler/plugins/reservation"
 	resourcestrategyfit "volcano.sh/volcano/pkg/scheduler/plugins/resource-strategy-fit"
 	"volcano.sh/volcano/pkg/scheduler/plugins/resourcequota"
 	"volcano.sh/volcano/pkg/scheduler/plugins/sla"
@@ -70,6 +74,9 @@ func init() {
 	framework.RegisterPluginBuilder(pdb.PluginName, pdb.New)
 	framework.RegisterPluginBuilder(nodegroup.PluginName, nodegroup.New)
 	framework.RegisterPluginBuilder(networktopologyaware.PluginName, networktopologyaware.New)
+	framework.RegisterPluginBuilder(reservation.PluginName, reservation.New)
+	framework.RegisterPluginBuilder(elastic.PluginName, elastic.New)
+	framework.RegisterPluginBuilder(datalocality.PluginName, datalocality.New)
 
 	// Plugins for Queues
 	framework.RegisterPluginBuilder(proportion.PluginName, proportion.New)
@@ -77,7 +84,22 @@ func init() {
 
 	// Plugins for Extender
 	framework.RegisterPluginBuilder(extender.PluginName, extender.New)
+	framework.RegisterPluginBuilder(remote.PluginName, remote.New)
 
 	// Plugins for ResourceQuota
 	framework.RegisterPluginBuilder(resourcequota.PluginName, resourcequota.New)
+
+	// Validators for the arguments of plugins
+	framework.RegisterPluginArgumentsValidator(binpack.PluginName, binpack.ValidateArguments)
+	framework.RegisterPluginArgumentsValidator(capacity.PluginName, capacity.ValidateArguments)
+	framework.RegisterPluginArgumentsValidator(extender.PluginName, extender.ValidateArguments)
+	framework.RegisterPluginArgumentsValidator(nodeorder.PluginName, nodeorder.ValidateArguments)
+	framework.RegisterPluginArgumentsValidator(overcommit.PluginName, overcommit.ValidateArguments)
+	framework.RegisterPluginArgumentsValidator(proportion.PluginName, proportion.ValidateArguments)
+	framework.RegisterPluginArgumentsValidator(remote.PluginName, remote.ValidateArguments)
+	framework.RegisterPluginArgumentsValidator(reservation.PluginName, reservation.ValidateArguments)
+	framework.RegisterPluginArgumentsValidator(resourcestrategyfit.PluginName, resourcestrategyfit.ValidateArguments)
+
+	// Releasers for the resources kept by plugins across sessions
+	framework.RegisterPluginReleaser(remote.PluginName, remote.CloseConnections)
 }
@@ -106,6 +106,12 @@ func (gp *gangPlugin) OnSessionOpen(ssn *framework.Session) {
 				continue
 			}
 
+			// The gang minimum of the victim jobs is kept by the action in gang preemption, which may evict a job as a whole.
+			if ssn.GangPreemption() {
+				victims = append(victims, preemptee)
+				continue
+			}
+
 			if _, found := jobOccupiedMap[job.UID]; !found {
 				jobOccupiedMap[job.UID] = job.ReadyTaskNum()
 			}
@@ -101,6 +101,17 @@ type priorityWeight struct {
 	podTopologySpreadWeight int
 }
 
+// ValidateArguments validates the weights in the arguments of the nodeorder plugin.
+func ValidateArguments(args framework.Arguments) error {
+	for _, key := range []string{NodeAffinityWeight, PodAffinityWeight, LeastRequestedWeight, MostRequestedWeight,
+		BalancedResourceWeight, TaintTolerationWeight, ImageLocalityWeight, PodTopologySpreadWeight} {
+		if err := args.ValidateInt(key, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // calculateWeight from the provided arguments.
 //
 // Currently only supported priorities are nodeaffinity, podaffinity, leastrequested,
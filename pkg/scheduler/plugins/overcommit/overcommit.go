@@ -57,6 +57,11 @@ func New(arguments framework.Arguments) framework.Plugin {
 	}
 }
 
+// ValidateArguments validates the arguments of the overcommit plugin.
+func ValidateArguments(arguments framework.Arguments) error {
+	return arguments.ValidateFloat64(overCommitFactor, 1.0)
+}
+
 func (op *overcommitPlugin) Name() string {
 	return PluginName
 }
@@ -38,6 +38,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/metrics"
 	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/fairshare"
 )
 
 // PluginName indicates name of volcano scheduler plugin.
@@ -52,6 +53,8 @@ type proportionPlugin struct {
 	queueOpts      map[api.QueueID]*queueAttr
 	// Arguments given for the plugin
 	pluginArguments framework.Arguments
+	// fairShare is the configuration of the historical usage accounting of the queues
+	fairShare *fairshare.Config
 }
 
 type queueAttr struct {
@@ -71,6 +74,8 @@ type queueAttr struct {
 	// realCapability represents the resource limit of the queue, LessEqual capability
 	realCapability *api.Resource
 	guarantee      *api.Resource
+	// usageFactor scales the share of the queue by its historical usage, 1 means it consumed its share of weight
+	usageFactor float64
 }
 
 // New return proportion action
@@ -80,9 +85,15 @@ func New(arguments framework.Arguments) framework.Plugin {
 		totalGuarantee:  api.EmptyResource(),
 		queueOpts:       map[api.QueueID]*queueAttr{},
 		pluginArguments: arguments,
+		fairShare:       fairshare.NewConfig(arguments),
 	}
 }
 
+// ValidateArguments validates the arguments of the proportion plugin.
+func ValidateArguments(arguments framework.Arguments) error {
+	return fairshare.ValidateArguments(arguments)
+}
+
 func (pp *proportionPlugin) Name() string {
 	return PluginName
 }
@@ -116,6 +127,8 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 				elastic:   api.EmptyResource(),
 				inqueue:   api.EmptyResource(),
 				guarantee: api.EmptyResource(),
+
+				usageFactor: 1,
 			}
 			if len(queue.Queue.Spec.Capability) != 0 {
 				attr.capability = api.NewResource(queue.Queue.Spec.Capability)
@@ -263,6 +276,20 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 		}
 	}
 
+	for _, attr := range pp.queueOpts {
+		ssn.SetQueueDeserved(attr.queueID, attr.deserved)
+	}
+
+	weights := make(map[api.QueueID]float64, len(pp.queueOpts))
+	for _, attr := range pp.queueOpts {
+		weights[attr.queueID] = float64(attr.weight)
+	}
+	for queueID, factor := range fairshare.Usage.UsageFactors(ssn, pp.fairShare, weights) {
+		attr := pp.queueOpts[queueID]
+		attr.usageFactor = factor
+		metrics.UpdateQueueUsageFactor(attr.name, factor)
+	}
+
 	ssn.AddQueueOrderFn(pp.Name(), func(l, r interface{}) int {
 		lv := l.(*api.QueueInfo)
 		rv := r.(*api.QueueInfo)
@@ -272,17 +299,29 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 			return int(rv.Queue.Spec.Priority) - int(lv.Queue.Spec.Priority)
 		}
 
-		if pp.queueOpts[lv.UID].share == pp.queueOpts[rv.UID].share {
+		lShare, rShare := pp.queueOpts[lv.UID].fairShare(), pp.queueOpts[rv.UID].fairShare()
+		if lShare == rShare {
 			return 0
 		}
 
-		if pp.queueOpts[lv.UID].share < pp.queueOpts[rv.UID].share {
+		if lShare < rShare {
 			return -1
 		}
 
 		return 1
 	})
 
+	if pp.fairShare.Enabled {
+		// The victims of the queue which consumed more resources historically are reclaimed first.
+		ssn.AddVictimQueueOrderFn(pp.Name(), func(l, r, preemptor interface{}) int {
+			lAttr, rAttr := pp.queueOpts[l.(*api.QueueInfo).UID], pp.queueOpts[r.(*api.QueueInfo).UID]
+			if lAttr == nil || rAttr == nil {
+				return 0
+			}
+			return fairshare.CompareUsageFactor(lAttr.usageFactor, rAttr.usageFactor)
+		})
+	}
+
 	ssn.AddReclaimableFn(pp.Name(), func(reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) ([]*api.TaskInfo, int) {
 		var victims []*api.TaskInfo
 		allocations := map[api.QueueID]*api.Resource{}
@@ -307,7 +346,7 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 			}
 			allocated := allocations[job.Queue]
 
-			if !allocated.LessEqual(attr.deserved, api.Zero) {
+			if !allocated.LessEqual(attr.fairDeserved(), api.Zero) {
 				allocated.Sub(reclaimee.Resreq)
 				victims = append(victims, reclaimee)
 			}
@@ -320,11 +359,12 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 		queue := obj.(*api.QueueInfo)
 		attr := pp.queueOpts[queue.UID]
 
-		overused := attr.deserved.LessEqual(attr.allocated, api.Zero)
+		deserved := attr.fairDeserved()
+		overused := deserved.LessEqual(attr.allocated, api.Zero)
 		metrics.UpdateQueueOverused(attr.name, overused)
 		if overused {
-			klog.V(3).Infof("Queue <%v> is overused: deserved <%v>, allocated <%v>, share <%v>",
-				queue.Name, attr.deserved, attr.allocated, attr.share)
+			klog.V(3).Infof("Queue <%v> is overused: deserved <%v>, allocated <%v>, share <%v>, usage factor <%v>",
+				queue.Name, deserved, attr.allocated, attr.share, attr.usageFactor)
 		}
 
 		return overused
@@ -338,10 +378,11 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 
 		attr := pp.queueOpts[queue.UID]
 		futureUsed := attr.allocated.Clone().Add(candidate.Resreq)
-		allocatable, _ := futureUsed.LessEqualWithDimensionAndResourcesName(attr.deserved, candidate.Resreq)
+		deserved := attr.fairDeserved()
+		allocatable, _ := futureUsed.LessEqualWithDimensionAndResourcesName(deserved, candidate.Resreq)
 		if !allocatable {
 			klog.V(3).Infof("Queue <%v>: deserved <%v>, allocated <%v>; Candidate <%v>: resource request <%v>",
-				queue.Name, attr.deserved, attr.allocated, candidate.Name, candidate.Resreq)
+				queue.Name, deserved, attr.allocated, candidate.Name, candidate.Resreq)
 		}
 
 		return allocatable
@@ -364,10 +405,11 @@ func (pp *proportionPlugin) OnSessionOpen(ssn *framework.Session) {
 		}
 
 		futureUsed := attr.allocated.Clone().Add(candidate.Resreq)
-		allocatable, _ := futureUsed.LessEqualWithDimensionAndResourcesName(attr.deserved, candidate.Resreq)
+		deserved := attr.fairDeserved()
+		allocatable, _ := futureUsed.LessEqualWithDimensionAndResourcesName(deserved, candidate.Resreq)
 		if !allocatable {
 			klog.V(3).Infof("Queue <%v>: deserved <%v>, allocated <%v>; Candidate <%v>: resource request <%v>",
-				queue.Name, attr.deserved, attr.allocated, candidate.Name, candidate.Resreq)
+				queue.Name, deserved, attr.allocated, candidate.Name, candidate.Resreq)
 		}
 
 		return allocatable
@@ -529,6 +571,16 @@ func (pp *proportionPlugin) updateShare(attr *queueAttr) {
 	metrics.UpdateQueueShare(attr.name, attr.share)
 }
 
+// fairShare returns the share of the queue scaled by its historical usage.
+func (qa *queueAttr) fairShare() float64 {
+	return qa.share * qa.usageFactor
+}
+
+// fairDeserved returns the deserved resource of the queue scaled by its historical usage.
+func (qa *queueAttr) fairDeserved() *api.Resource {
+	return fairshare.Deserved(qa.deserved, qa.realCapability, qa.usageFactor)
+}
+
 type proportionState struct {
 	queueAttrs map[api.QueueID]*queueAttr
 }
@@ -553,6 +605,8 @@ func (qa *queueAttr) Clone() *queueAttr {
 		capability:     qa.capability.Clone(),
 		realCapability: qa.realCapability.Clone(),
 		guarantee:      qa.guarantee.Clone(),
+
+		usageFactor: qa.usageFactor,
 	}
 }
 
@@ -627,3 +627,53 @@ func TestNoDoubleCountingForInqueueJobWithBindingTasks(t *testing.T) {
 			"is double-counting inqueue resources for pgA whose tasks are in Binding state", cycle2)
 	}
 }
+
+func TestFairDeserved(t *testing.T) {
+	tests := []struct {
+		name             string
+		usageFactor      float64
+		realCapability   *api.Resource
+		expectedDeserved *api.Resource
+		expectedShare    float64
+	}{
+		{
+			name:             "queue consumed its share of weight historically",
+			usageFactor:      1,
+			expectedDeserved: &api.Resource{MilliCPU: 4000, Memory: 4000},
+			expectedShare:    0.5,
+		},
+		{
+			name:             "queue consumed more than its share of weight historically",
+			usageFactor:      2,
+			expectedDeserved: &api.Resource{MilliCPU: 2000, Memory: 2000},
+			expectedShare:    1,
+		},
+		{
+			name:             "queue consumed less than its share of weight historically is capped by real capability",
+			usageFactor:      0.5,
+			realCapability:   &api.Resource{MilliCPU: 6000, Memory: 10000},
+			expectedDeserved: &api.Resource{MilliCPU: 6000, Memory: 8000},
+			expectedShare:    0.25,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attr := &queueAttr{
+				share:          0.5,
+				deserved:       &api.Resource{MilliCPU: 4000, Memory: 4000},
+				realCapability: test.realCapability,
+				usageFactor:    test.usageFactor,
+			}
+			if deserved := attr.fairDeserved(); !deserved.Equal(test.expectedDeserved, api.Zero) {
+				t.Errorf("expected deserved %v, got %v", test.expectedDeserved, deserved)
+			}
+			if share := attr.fairShare(); share != test.expectedShare {
+				t.Errorf("expected share %v, got %v", test.expectedShare, share)
+			}
+			if attr.deserved.MilliCPU != 4000 {
+				t.Errorf("expected deserved of the queue not to be modified, got %v", attr.deserved)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	"encoding/json"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	pluginv1 "volcano.sh/volcano/pkg/scheduler/plugins/remote/proto/v1"
+)
+
+// newOpenSessionRequest builds the request to open the session with the snapshot of the jobs, nodes and queues.
+func newOpenSessionRequest(sessionID string, ssn *framework.Session) *pluginv1.OpenSessionRequest {
+	req := &pluginv1.OpenSessionRequest{
+		SessionId: sessionID,
+		Jobs:      make([]*pluginv1.Job, 0, len(ssn.Jobs)),
+		Nodes:     make([]*pluginv1.Node, 0, len(ssn.NodeList)),
+		Queues:    make([]*pluginv1.Queue, 0, len(ssn.Queues)),
+	}
+	for _, job := range ssn.Jobs {
+		req.Jobs = append(req.Jobs, newJob(job))
+	}
+	for _, node := range ssn.NodeList {
+		req.Nodes = append(req.Nodes, newNode(node))
+	}
+	for _, queue := range ssn.Queues {
+		req.Queues = append(req.Queues, &pluginv1.Queue{Uid: string(queue.UID), Name: queue.Name, Weight: queue.Weight})
+	}
+	return req
+}
+
+func newTask(task *api.TaskInfo) *pluginv1.Task {
+	return &pluginv1.Task{
+		Uid:       string(task.UID),
+		Namespace: task.Namespace,
+		Name:      task.Name,
+		JobId:     string(task.Job),
+		NodeName:  task.NodeName,
+		Status:    task.Status.String(),
+		Resreq:    newResource(task.Resreq),
+		Pod:       encode(task.Pod),
+	}
+}
+
+func newJob(job *api.JobInfo) *pluginv1.Job {
+	pbJob := &pluginv1.Job{
+		Uid:               string(job.UID),
+		Namespace:         job.Namespace,
+		Name:              job.Name,
+		Queue:             string(job.Queue),
+		Priority:          job.Priority,
+		MinAvailable:      job.MinAvailable,
+		CreationTimestamp: job.CreationTimestamp.Unix(),
+	}
+	if job.PodGroup != nil {
+		pbJob.PodGroup = encode(&job.PodGroup.PodGroup)
+	}
+	return pbJob
+}
+
+func newNode(node *api.NodeInfo) *pluginv1.Node {
+	pbNode := &pluginv1.Node{
+		Name:        node.Name,
+		Allocatable: newResource(node.Allocatable),
+		Idle:        newResource(node.Idle),
+		Used:        newResource(node.Used),
+	}
+	if node.Node != nil {
+		pbNode.Labels = node.Node.Labels
+	}
+	return pbNode
+}
+
+func newResource(resource *api.Resource) *pluginv1.Resource {
+	if resource == nil {
+		return nil
+	}
+	quantities := map[string]float64{"cpu": resource.MilliCPU, "memory": resource.Memory}
+	for name, quantity := range resource.ScalarResources {
+		quantities[string(name)] = quantity
+	}
+	return &pluginv1.Resource{Quantities: quantities}
+}
+
+func encode(obj interface{}) []byte {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		klog.Errorf("Failed to encode %T for the out-of-process plugin: %v", obj, err)
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,1708 @@
+//
+//Copyright 2026 The Volcano Authors.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v5.29.3
+// source: pkg/scheduler/plugins/remote/proto/v1/plugin.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Hook is a session hook which can be implemented by the plugin.
+type Hook int32
+
+const (
+	Hook_HOOK_UNSPECIFIED      Hook = 0
+	Hook_HOOK_PREDICATE        Hook = 1
+	Hook_HOOK_BATCH_NODE_ORDER Hook = 2
+	Hook_HOOK_JOB_ORDER        Hook = 3
+	Hook_HOOK_JOB_ENQUEUEABLE  Hook = 4
+	Hook_HOOK_PREEMPTABLE      Hook = 5
+	Hook_HOOK_RECLAIMABLE      Hook = 6
+)
+
+// Enum value maps for Hook.
+var (
+	Hook_name = map[int32]string{
+		0: "HOOK_UNSPECIFIED",
+		1: "HOOK_PREDICATE",
+		2: "HOOK_BATCH_NODE_ORDER",
+		3: "HOOK_JOB_ORDER",
+		4: "HOOK_JOB_ENQUEUEABLE",
+		5: "HOOK_PREEMPTABLE",
+		6: "HOOK_RECLAIMABLE",
+	}
+	Hook_value = map[string]int32{
+		"HOOK_UNSPECIFIED":      0,
+		"HOOK_PREDICATE":        1,
+		"HOOK_BATCH_NODE_ORDER": 2,
+		"HOOK_JOB_ORDER":        3,
+		"HOOK_JOB_ENQUEUEABLE":  4,
+		"HOOK_PREEMPTABLE":      5,
+		"HOOK_RECLAIMABLE":      6,
+	}
+)
+
+func (x Hook) Enum() *Hook {
+	p := new(Hook)
+	*p = x
+	return p
+}
+
+func (x Hook) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Hook) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_enumTypes[0].Descriptor()
+}
+
+func (Hook) Type() protoreflect.EnumType {
+	return &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_enumTypes[0]
+}
+
+func (x Hook) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Hook.Descriptor instead.
+func (Hook) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+// Vote is the vote of the plugin on a decision of the scheduler.
+type Vote int32
+
+const (
+	Vote_VOTE_ABSTAIN Vote = 0
+	Vote_VOTE_PERMIT  Vote = 1
+	Vote_VOTE_REJECT  Vote = 2
+)
+
+// Enum value maps for Vote.
+var (
+	Vote_name = map[int32]string{
+		0: "VOTE_ABSTAIN",
+		1: "VOTE_PERMIT",
+		2: "VOTE_REJECT",
+	}
+	Vote_value = map[string]int32{
+		"VOTE_ABSTAIN": 0,
+		"VOTE_PERMIT":  1,
+		"VOTE_REJECT":  2,
+	}
+)
+
+func (x Vote) Enum() *Vote {
+	p := new(Vote)
+	*p = x
+	return p
+}
+
+func (x Vote) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Vote) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_enumTypes[1].Descriptor()
+}
+
+func (Vote) Type() protoreflect.EnumType {
+	return &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_enumTypes[1]
+}
+
+func (x Vote) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Vote.Descriptor instead.
+func (Vote) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{1}
+}
+
+// StatusCode is the result of a predicate on a node.
+type StatusCode int32
+
+const (
+	StatusCode_STATUS_CODE_SUCCESS                        StatusCode = 0
+	StatusCode_STATUS_CODE_ERROR                          StatusCode = 1
+	StatusCode_STATUS_CODE_UNSCHEDULABLE                  StatusCode = 2
+	StatusCode_STATUS_CODE_UNSCHEDULABLE_AND_UNRESOLVABLE StatusCode = 3
+)
+
+// Enum value maps for StatusCode.
+var (
+	StatusCode_name = map[int32]string{
+		0: "STATUS_CODE_SUCCESS",
+		1: "STATUS_CODE_ERROR",
+		2: "STATUS_CODE_UNSCHEDULABLE",
+		3: "STATUS_CODE_UNSCHEDULABLE_AND_UNRESOLVABLE",
+	}
+	StatusCode_value = map[string]int32{
+		"STATUS_CODE_SUCCESS":                        0,
+		"STATUS_CODE_ERROR":                          1,
+		"STATUS_CODE_UNSCHEDULABLE":                  2,
+		"STATUS_CODE_UNSCHEDULABLE_AND_UNRESOLVABLE": 3,
+	}
+)
+
+func (x StatusCode) Enum() *StatusCode {
+	p := new(StatusCode)
+	*p = x
+	return p
+}
+
+func (x StatusCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatusCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_enumTypes[2].Descriptor()
+}
+
+func (StatusCode) Type() protoreflect.EnumType {
+	return &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_enumTypes[2]
+}
+
+func (x StatusCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatusCode.Descriptor instead.
+func (StatusCode) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{2}
+}
+
+type GetCapabilitiesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// protocol_version is the version of the protocol of the scheduler, e.g. "v1".
+	ProtocolVersion string `protobuf:"bytes,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCapabilitiesRequest) Reset() {
+	*x = GetCapabilitiesRequest{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesRequest) ProtoMessage() {}
+
+func (x *GetCapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCapabilitiesRequest) GetProtocolVersion() string {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return ""
+}
+
+type GetCapabilitiesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the plugin used in the logs and events of the scheduler.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// hooks are the hooks implemented by the plugin.
+	Hooks []Hook `protobuf:"varint,2,rep,packed,name=hooks,proto3,enum=volcano.scheduler.plugin.v1.Hook" json:"hooks,omitempty"`
+	// protocol_version is the version of the protocol the plugin serves, e.g. "v1".
+	ProtocolVersion string `protobuf:"bytes,3,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCapabilitiesResponse) Reset() {
+	*x = GetCapabilitiesResponse{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesResponse) ProtoMessage() {}
+
+func (x *GetCapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCapabilitiesResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetCapabilitiesResponse) GetHooks() []Hook {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
+func (x *GetCapabilitiesResponse) GetProtocolVersion() string {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return ""
+}
+
+// Resource is a set of resources, cpu is in milli-cores, memory is in bytes, and the others are in milli-units.
+type Resource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quantities    map[string]float64     `protobuf:"bytes,1,rep,name=quantities,proto3" json:"quantities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Resource) GetQuantities() map[string]float64 {
+	if x != nil {
+		return x.Quantities
+	}
+	return nil
+}
+
+type Task struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Uid       string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	JobId     string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	NodeName  string                 `protobuf:"bytes,5,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Status    string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Resreq    *Resource              `protobuf:"bytes,7,opt,name=resreq,proto3" json:"resreq,omitempty"`
+	// pod is the pod of the task encoded in JSON.
+	Pod           []byte `protobuf:"bytes,8,opt,name=pod,proto3" json:"pod,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Task) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Task) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Task) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Task) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *Task) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *Task) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Task) GetResreq() *Resource {
+	if x != nil {
+		return x.Resreq
+	}
+	return nil
+}
+
+func (x *Task) GetPod() []byte {
+	if x != nil {
+		return x.Pod
+	}
+	return nil
+}
+
+type Job struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Uid          string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Namespace    string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name         string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Queue        string                 `protobuf:"bytes,4,opt,name=queue,proto3" json:"queue,omitempty"`
+	Priority     int32                  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	MinAvailable int32                  `protobuf:"varint,6,opt,name=min_available,json=minAvailable,proto3" json:"min_available,omitempty"`
+	// creation_timestamp is the creation time of the job in unix seconds.
+	CreationTimestamp int64 `protobuf:"varint,7,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	// pod_group is the PodGroup of the job encoded in JSON.
+	PodGroup      []byte `protobuf:"bytes,8,opt,name=pod_group,json=podGroup,proto3" json:"pod_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Job) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Job) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Job) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Job) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *Job) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Job) GetMinAvailable() int32 {
+	if x != nil {
+		return x.MinAvailable
+	}
+	return 0
+}
+
+func (x *Job) GetCreationTimestamp() int64 {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return 0
+}
+
+func (x *Job) GetPodGroup() []byte {
+	if x != nil {
+		return x.PodGroup
+	}
+	return nil
+}
+
+type Node struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Allocatable   *Resource              `protobuf:"bytes,2,opt,name=allocatable,proto3" json:"allocatable,omitempty"`
+	Idle          *Resource              `protobuf:"bytes,3,opt,name=idle,proto3" json:"idle,omitempty"`
+	Used          *Resource              `protobuf:"bytes,4,opt,name=used,proto3" json:"used,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Node) GetAllocatable() *Resource {
+	if x != nil {
+		return x.Allocatable
+	}
+	return nil
+}
+
+func (x *Node) GetIdle() *Resource {
+	if x != nil {
+		return x.Idle
+	}
+	return nil
+}
+
+func (x *Node) GetUsed() *Resource {
+	if x != nil {
+		return x.Used
+	}
+	return nil
+}
+
+func (x *Node) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type Queue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Weight        int32                  `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Queue) Reset() {
+	*x = Queue{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Queue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Queue) ProtoMessage() {}
+
+func (x *Queue) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Queue.ProtoReflect.Descriptor instead.
+func (*Queue) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Queue) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Queue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Queue) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type OpenSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Jobs          []*Job                 `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Nodes         []*Node                `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Queues        []*Queue               `protobuf:"bytes,4,rep,name=queues,proto3" json:"queues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenSessionRequest) Reset() {
+	*x = OpenSessionRequest{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenSessionRequest) ProtoMessage() {}
+
+func (x *OpenSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenSessionRequest.ProtoReflect.Descriptor instead.
+func (*OpenSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OpenSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *OpenSessionRequest) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *OpenSessionRequest) GetNodes() []*Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *OpenSessionRequest) GetQueues() []*Queue {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+type OpenSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenSessionResponse) Reset() {
+	*x = OpenSessionResponse{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenSessionResponse) ProtoMessage() {}
+
+func (x *OpenSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenSessionResponse.ProtoReflect.Descriptor instead.
+func (*OpenSessionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{8}
+}
+
+type CloseSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseSessionRequest) Reset() {
+	*x = CloseSessionRequest{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseSessionRequest) ProtoMessage() {}
+
+func (x *CloseSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseSessionRequest.ProtoReflect.Descriptor instead.
+func (*CloseSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CloseSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type CloseSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseSessionResponse) Reset() {
+	*x = CloseSessionResponse{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseSessionResponse) ProtoMessage() {}
+
+func (x *CloseSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseSessionResponse.ProtoReflect.Descriptor instead.
+func (*CloseSessionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{10}
+}
+
+type Status struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          StatusCode             `protobuf:"varint,1,opt,name=code,proto3,enum=volcano.scheduler.plugin.v1.StatusCode" json:"code,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Status) GetCode() StatusCode {
+	if x != nil {
+		return x.Code
+	}
+	return StatusCode_STATUS_CODE_SUCCESS
+}
+
+func (x *Status) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PredicateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Task          *Task                  `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	NodeNames     []string               `protobuf:"bytes,3,rep,name=node_names,json=nodeNames,proto3" json:"node_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PredicateRequest) Reset() {
+	*x = PredicateRequest{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PredicateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PredicateRequest) ProtoMessage() {}
+
+func (x *PredicateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PredicateRequest.ProtoReflect.Descriptor instead.
+func (*PredicateRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PredicateRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PredicateRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *PredicateRequest) GetNodeNames() []string {
+	if x != nil {
+		return x.NodeNames
+	}
+	return nil
+}
+
+type PredicateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// statuses are the results of the nodes keyed by node name, the nodes absent pass the predicate.
+	Statuses      map[string]*Status `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PredicateResponse) Reset() {
+	*x = PredicateResponse{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PredicateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PredicateResponse) ProtoMessage() {}
+
+func (x *PredicateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PredicateResponse.ProtoReflect.Descriptor instead.
+func (*PredicateResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PredicateResponse) GetStatuses() map[string]*Status {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type BatchNodeOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Task          *Task                  `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	NodeNames     []string               `protobuf:"bytes,3,rep,name=node_names,json=nodeNames,proto3" json:"node_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchNodeOrderRequest) Reset() {
+	*x = BatchNodeOrderRequest{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchNodeOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchNodeOrderRequest) ProtoMessage() {}
+
+func (x *BatchNodeOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchNodeOrderRequest.ProtoReflect.Descriptor instead.
+func (*BatchNodeOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchNodeOrderRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *BatchNodeOrderRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *BatchNodeOrderRequest) GetNodeNames() []string {
+	if x != nil {
+		return x.NodeNames
+	}
+	return nil
+}
+
+type BatchNodeOrderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// scores are the scores of the nodes keyed by node name.
+	Scores        map[string]float64 `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchNodeOrderResponse) Reset() {
+	*x = BatchNodeOrderResponse{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchNodeOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchNodeOrderResponse) ProtoMessage() {}
+
+func (x *BatchNodeOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchNodeOrderResponse.ProtoReflect.Descriptor instead.
+func (*BatchNodeOrderResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchNodeOrderResponse) GetScores() map[string]float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type JobOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	JobIds        []string               `protobuf:"bytes,2,rep,name=job_ids,json=jobIds,proto3" json:"job_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobOrderRequest) Reset() {
+	*x = JobOrderRequest{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobOrderRequest) ProtoMessage() {}
+
+func (x *JobOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobOrderRequest.ProtoReflect.Descriptor instead.
+func (*JobOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *JobOrderRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *JobOrderRequest) GetJobIds() []string {
+	if x != nil {
+		return x.JobIds
+	}
+	return nil
+}
+
+type JobOrderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job_ids are the jobs from the highest priority to the lowest, the jobs absent are not ordered by the plugin.
+	JobIds        []string `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"job_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobOrderResponse) Reset() {
+	*x = JobOrderResponse{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobOrderResponse) ProtoMessage() {}
+
+func (x *JobOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobOrderResponse.ProtoReflect.Descriptor instead.
+func (*JobOrderResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *JobOrderResponse) GetJobIds() []string {
+	if x != nil {
+		return x.JobIds
+	}
+	return nil
+}
+
+type JobEnqueueableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Job           *Job                   `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobEnqueueableRequest) Reset() {
+	*x = JobEnqueueableRequest{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobEnqueueableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobEnqueueableRequest) ProtoMessage() {}
+
+func (x *JobEnqueueableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobEnqueueableRequest.ProtoReflect.Descriptor instead.
+func (*JobEnqueueableRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *JobEnqueueableRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *JobEnqueueableRequest) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type JobEnqueueableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vote          Vote                   `protobuf:"varint,1,opt,name=vote,proto3,enum=volcano.scheduler.plugin.v1.Vote" json:"vote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobEnqueueableResponse) Reset() {
+	*x = JobEnqueueableResponse{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobEnqueueableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobEnqueueableResponse) ProtoMessage() {}
+
+func (x *JobEnqueueableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobEnqueueableResponse.ProtoReflect.Descriptor instead.
+func (*JobEnqueueableResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *JobEnqueueableResponse) GetVote() Vote {
+	if x != nil {
+		return x.Vote
+	}
+	return Vote_VOTE_ABSTAIN
+}
+
+type SelectVictimsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// hook is either HOOK_PREEMPTABLE or HOOK_RECLAIMABLE.
+	Hook          Hook    `protobuf:"varint,2,opt,name=hook,proto3,enum=volcano.scheduler.plugin.v1.Hook" json:"hook,omitempty"`
+	Preemptor     *Task   `protobuf:"bytes,3,opt,name=preemptor,proto3" json:"preemptor,omitempty"`
+	Candidates    []*Task `protobuf:"bytes,4,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectVictimsRequest) Reset() {
+	*x = SelectVictimsRequest{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectVictimsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectVictimsRequest) ProtoMessage() {}
+
+func (x *SelectVictimsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectVictimsRequest.ProtoReflect.Descriptor instead.
+func (*SelectVictimsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SelectVictimsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SelectVictimsRequest) GetHook() Hook {
+	if x != nil {
+		return x.Hook
+	}
+	return Hook_HOOK_UNSPECIFIED
+}
+
+func (x *SelectVictimsRequest) GetPreemptor() *Task {
+	if x != nil {
+		return x.Preemptor
+	}
+	return nil
+}
+
+func (x *SelectVictimsRequest) GetCandidates() []*Task {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type SelectVictimsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Vote  Vote                   `protobuf:"varint,1,opt,name=vote,proto3,enum=volcano.scheduler.plugin.v1.Vote" json:"vote,omitempty"`
+	// victim_uids are the uids of the candidates selected as victims.
+	VictimUids    []string `protobuf:"bytes,2,rep,name=victim_uids,json=victimUids,proto3" json:"victim_uids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectVictimsResponse) Reset() {
+	*x = SelectVictimsResponse{}
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectVictimsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectVictimsResponse) ProtoMessage() {}
+
+func (x *SelectVictimsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectVictimsResponse.ProtoReflect.Descriptor instead.
+func (*SelectVictimsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SelectVictimsResponse) GetVote() Vote {
+	if x != nil {
+		return x.Vote
+	}
+	return Vote_VOTE_ABSTAIN
+}
+
+func (x *SelectVictimsResponse) GetVictimUids() []string {
+	if x != nil {
+		return x.VictimUids
+	}
+	return nil
+}
+
+var File_pkg_scheduler_plugins_remote_proto_v1_plugin_proto protoreflect.FileDescriptor
+
+const file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDesc = "" +
+	"\n" +
+	"2pkg/scheduler/plugins/remote/proto/v1/plugin.proto\x12\x1bvolcano.scheduler.plugin.v1\"C\n" +
+	"\x16GetCapabilitiesRequest\x12)\n" +
+	"\x10protocol_version\x18\x01 \x01(\tR\x0fprotocolVersion\"\x91\x01\n" +
+	"\x17GetCapabilitiesResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x127\n" +
+	"\x05hooks\x18\x02 \x03(\x0e2!.volcano.scheduler.plugin.v1.HookR\x05hooks\x12)\n" +
+	"\x10protocol_version\x18\x03 \x01(\tR\x0fprotocolVersion\"\xa0\x01\n" +
+	"\bResource\x12U\n" +
+	"\n" +
+	"quantities\x18\x01 \x03(\v25.volcano.scheduler.plugin.v1.Resource.QuantitiesEntryR\n" +
+	"quantities\x1a=\n" +
+	"\x0fQuantitiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xe7\x01\n" +
+	"\x04Task\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\x12\x1b\n" +
+	"\tnode_name\x18\x05 \x01(\tR\bnodeName\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12=\n" +
+	"\x06resreq\x18\a \x01(\v2%.volcano.scheduler.plugin.v1.ResourceR\x06resreq\x12\x10\n" +
+	"\x03pod\x18\b \x01(\fR\x03pod\"\xec\x01\n" +
+	"\x03Job\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05queue\x18\x04 \x01(\tR\x05queue\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x05R\bpriority\x12#\n" +
+	"\rmin_available\x18\x06 \x01(\x05R\fminAvailable\x12-\n" +
+	"\x12creation_timestamp\x18\a \x01(\x03R\x11creationTimestamp\x12\x1b\n" +
+	"\tpod_group\x18\b \x01(\fR\bpodGroup\"\xdb\x02\n" +
+	"\x04Node\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12G\n" +
+	"\vallocatable\x18\x02 \x01(\v2%.volcano.scheduler.plugin.v1.ResourceR\vallocatable\x129\n" +
+	"\x04idle\x18\x03 \x01(\v2%.volcano.scheduler.plugin.v1.ResourceR\x04idle\x129\n" +
+	"\x04used\x18\x04 \x01(\v2%.volcano.scheduler.plugin.v1.ResourceR\x04used\x12E\n" +
+	"\x06labels\x18\x05 \x03(\v2-.volcano.scheduler.plugin.v1.Node.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"E\n" +
+	"\x05Queue\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x05R\x06weight\"\xde\x01\n" +
+	"\x12OpenSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x124\n" +
+	"\x04jobs\x18\x02 \x03(\v2 .volcano.scheduler.plugin.v1.JobR\x04jobs\x127\n" +
+	"\x05nodes\x18\x03 \x03(\v2!.volcano.scheduler.plugin.v1.NodeR\x05nodes\x12:\n" +
+	"\x06queues\x18\x04 \x03(\v2\".volcano.scheduler.plugin.v1.QueueR\x06queues\"\x15\n" +
+	"\x13OpenSessionResponse\"4\n" +
+	"\x13CloseSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x16\n" +
+	"\x14CloseSessionResponse\"]\n" +
+	"\x06Status\x12;\n" +
+	"\x04code\x18\x01 \x01(\x0e2'.volcano.scheduler.plugin.v1.StatusCodeR\x04code\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x87\x01\n" +
+	"\x10PredicateRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x125\n" +
+	"\x04task\x18\x02 \x01(\v2!.volcano.scheduler.plugin.v1.TaskR\x04task\x12\x1d\n" +
+	"\n" +
+	"node_names\x18\x03 \x03(\tR\tnodeNames\"\xcf\x01\n" +
+	"\x11PredicateResponse\x12X\n" +
+	"\bstatuses\x18\x01 \x03(\v2<.volcano.scheduler.plugin.v1.PredicateResponse.StatusesEntryR\bstatuses\x1a`\n" +
+	"\rStatusesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x129\n" +
+	"\x05value\x18\x02 \x01(\v2#.volcano.scheduler.plugin.v1.StatusR\x05value:\x028\x01\"\x8c\x01\n" +
+	"\x15BatchNodeOrderRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x125\n" +
+	"\x04task\x18\x02 \x01(\v2!.volcano.scheduler.plugin.v1.TaskR\x04task\x12\x1d\n" +
+	"\n" +
+	"node_names\x18\x03 \x03(\tR\tnodeNames\"\xac\x01\n" +
+	"\x16BatchNodeOrderResponse\x12W\n" +
+	"\x06scores\x18\x01 \x03(\v2?.volcano.scheduler.plugin.v1.BatchNodeOrderResponse.ScoresEntryR\x06scores\x1a9\n" +
+	"\vScoresEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"I\n" +
+	"\x0fJobOrderRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\ajob_ids\x18\x02 \x03(\tR\x06jobIds\"+\n" +
+	"\x10JobOrderResponse\x12\x17\n" +
+	"\ajob_ids\x18\x01 \x03(\tR\x06jobIds\"j\n" +
+	"\x15JobEnqueueableRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x122\n" +
+	"\x03job\x18\x02 \x01(\v2 .volcano.scheduler.plugin.v1.JobR\x03job\"O\n" +
+	"\x16JobEnqueueableResponse\x125\n" +
+	"\x04vote\x18\x01 \x01(\x0e2!.volcano.scheduler.plugin.v1.VoteR\x04vote\"\xf0\x01\n" +
+	"\x14SelectVictimsRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x125\n" +
+	"\x04hook\x18\x02 \x01(\x0e2!.volcano.scheduler.plugin.v1.HookR\x04hook\x12?\n" +
+	"\tpreemptor\x18\x03 \x01(\v2!.volcano.scheduler.plugin.v1.TaskR\tpreemptor\x12A\n" +
+	"\n" +
+	"candidates\x18\x04 \x03(\v2!.volcano.scheduler.plugin.v1.TaskR\n" +
+	"candidates\"o\n" +
+	"\x15SelectVictimsResponse\x125\n" +
+	"\x04vote\x18\x01 \x01(\x0e2!.volcano.scheduler.plugin.v1.VoteR\x04vote\x12\x1f\n" +
+	"\vvictim_uids\x18\x02 \x03(\tR\n" +
+	"victimUids*\xa5\x01\n" +
+	"\x04Hook\x12\x14\n" +
+	"\x10HOOK_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eHOOK_PREDICATE\x10\x01\x12\x19\n" +
+	"\x15HOOK_BATCH_NODE_ORDER\x10\x02\x12\x12\n" +
+	"\x0eHOOK_JOB_ORDER\x10\x03\x12\x18\n" +
+	"\x14HOOK_JOB_ENQUEUEABLE\x10\x04\x12\x14\n" +
+	"\x10HOOK_PREEMPTABLE\x10\x05\x12\x14\n" +
+	"\x10HOOK_RECLAIMABLE\x10\x06*:\n" +
+	"\x04Vote\x12\x10\n" +
+	"\fVOTE_ABSTAIN\x10\x00\x12\x0f\n" +
+	"\vVOTE_PERMIT\x10\x01\x12\x0f\n" +
+	"\vVOTE_REJECT\x10\x02*\x8b\x01\n" +
+	"\n" +
+	"StatusCode\x12\x17\n" +
+	"\x13STATUS_CODE_SUCCESS\x10\x00\x12\x15\n" +
+	"\x11STATUS_CODE_ERROR\x10\x01\x12\x1d\n" +
+	"\x19STATUS_CODE_UNSCHEDULABLE\x10\x02\x12.\n" +
+	"*STATUS_CODE_UNSCHEDULABLE_AND_UNRESOLVABLE\x10\x032\xb9\a\n" +
+	"\x0fSchedulerPlugin\x12|\n" +
+	"\x0fGetCapabilities\x123.volcano.scheduler.plugin.v1.GetCapabilitiesRequest\x1a4.volcano.scheduler.plugin.v1.GetCapabilitiesResponse\x12p\n" +
+	"\vOpenSession\x12/.volcano.scheduler.plugin.v1.OpenSessionRequest\x1a0.volcano.scheduler.plugin.v1.OpenSessionResponse\x12s\n" +
+	"\fCloseSession\x120.volcano.scheduler.plugin.v1.CloseSessionRequest\x1a1.volcano.scheduler.plugin.v1.CloseSessionResponse\x12j\n" +
+	"\tPredicate\x12-.volcano.scheduler.plugin.v1.PredicateRequest\x1a..volcano.scheduler.plugin.v1.PredicateResponse\x12y\n" +
+	"\x0eBatchNodeOrder\x122.volcano.scheduler.plugin.v1.BatchNodeOrderRequest\x1a3.volcano.scheduler.plugin.v1.BatchNodeOrderResponse\x12g\n" +
+	"\bJobOrder\x12,.volcano.scheduler.plugin.v1.JobOrderRequest\x1a-.volcano.scheduler.plugin.v1.JobOrderResponse\x12y\n" +
+	"\x0eJobEnqueueable\x122.volcano.scheduler.plugin.v1.JobEnqueueableRequest\x1a3.volcano.scheduler.plugin.v1.JobEnqueueableResponse\x12v\n" +
+	"\rSelectVictims\x121.volcano.scheduler.plugin.v1.SelectVictimsRequest\x1a2.volcano.scheduler.plugin.v1.SelectVictimsResponseB=Z;volcano.sh/volcano/pkg/scheduler/plugins/remote/proto/v1;v1b\x06proto3"
+
+var (
+	file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescOnce sync.Once
+	file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescData []byte
+)
+
+func file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescGZIP() []byte {
+	file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescOnce.Do(func() {
+		file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDesc), len(file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDesc)))
+	})
+	return file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDescData
+}
+
+var file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_goTypes = []any{
+	(Hook)(0),                       // 0: volcano.scheduler.plugin.v1.Hook
+	(Vote)(0),                       // 1: volcano.scheduler.plugin.v1.Vote
+	(StatusCode)(0),                 // 2: volcano.scheduler.plugin.v1.StatusCode
+	(*GetCapabilitiesRequest)(nil),  // 3: volcano.scheduler.plugin.v1.GetCapabilitiesRequest
+	(*GetCapabilitiesResponse)(nil), // 4: volcano.scheduler.plugin.v1.GetCapabilitiesResponse
+	(*Resource)(nil),                // 5: volcano.scheduler.plugin.v1.Resource
+	(*Task)(nil),                    // 6: volcano.scheduler.plugin.v1.Task
+	(*Job)(nil),                     // 7: volcano.scheduler.plugin.v1.Job
+	(*Node)(nil),                    // 8: volcano.scheduler.plugin.v1.Node
+	(*Queue)(nil),                   // 9: volcano.scheduler.plugin.v1.Queue
+	(*OpenSessionRequest)(nil),      // 10: volcano.scheduler.plugin.v1.OpenSessionRequest
+	(*OpenSessionResponse)(nil),     // 11: volcano.scheduler.plugin.v1.OpenSessionResponse
+	(*CloseSessionRequest)(nil),     // 12: volcano.scheduler.plugin.v1.CloseSessionRequest
+	(*CloseSessionResponse)(nil),    // 13: volcano.scheduler.plugin.v1.CloseSessionResponse
+	(*Status)(nil),                  // 14: volcano.scheduler.plugin.v1.Status
+	(*PredicateRequest)(nil),        // 15: volcano.scheduler.plugin.v1.PredicateRequest
+	(*PredicateResponse)(nil),       // 16: volcano.scheduler.plugin.v1.PredicateResponse
+	(*BatchNodeOrderRequest)(nil),   // 17: volcano.scheduler.plugin.v1.BatchNodeOrderRequest
+	(*BatchNodeOrderResponse)(nil),  // 18: volcano.scheduler.plugin.v1.BatchNodeOrderResponse
+	(*JobOrderRequest)(nil),         // 19: volcano.scheduler.plugin.v1.JobOrderRequest
+	(*JobOrderResponse)(nil),        // 20: volcano.scheduler.plugin.v1.JobOrderResponse
+	(*JobEnqueueableRequest)(nil),   // 21: volcano.scheduler.plugin.v1.JobEnqueueableRequest
+	(*JobEnqueueableResponse)(nil),  // 22: volcano.scheduler.plugin.v1.JobEnqueueableResponse
+	(*SelectVictimsRequest)(nil),    // 23: volcano.scheduler.plugin.v1.SelectVictimsRequest
+	(*SelectVictimsResponse)(nil),   // 24: volcano.scheduler.plugin.v1.SelectVictimsResponse
+	nil,                             // 25: volcano.scheduler.plugin.v1.Resource.QuantitiesEntry
+	nil,                             // 26: volcano.scheduler.plugin.v1.Node.LabelsEntry
+	nil,                             // 27: volcano.scheduler.plugin.v1.PredicateResponse.StatusesEntry
+	nil,                             // 28: volcano.scheduler.plugin.v1.BatchNodeOrderResponse.ScoresEntry
+}
+var file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_depIdxs = []int32{
+	0,  // 0: volcano.scheduler.plugin.v1.GetCapabilitiesResponse.hooks:type_name -> volcano.scheduler.plugin.v1.Hook
+	25, // 1: volcano.scheduler.plugin.v1.Resource.quantities:type_name -> volcano.scheduler.plugin.v1.Resource.QuantitiesEntry
+	5,  // 2: volcano.scheduler.plugin.v1.Task.resreq:type_name -> volcano.scheduler.plugin.v1.Resource
+	5,  // 3: volcano.scheduler.plugin.v1.Node.allocatable:type_name -> volcano.scheduler.plugin.v1.Resource
+	5,  // 4: volcano.scheduler.plugin.v1.Node.idle:type_name -> volcano.scheduler.plugin.v1.Resource
+	5,  // 5: volcano.scheduler.plugin.v1.Node.used:type_name -> volcano.scheduler.plugin.v1.Resource
+	26, // 6: volcano.scheduler.plugin.v1.Node.labels:type_name -> volcano.scheduler.plugin.v1.Node.LabelsEntry
+	7,  // 7: volcano.scheduler.plugin.v1.OpenSessionRequest.jobs:type_name -> volcano.scheduler.plugin.v1.Job
+	8,  // 8: volcano.scheduler.plugin.v1.OpenSessionRequest.nodes:type_name -> volcano.scheduler.plugin.v1.Node
+	9,  // 9: volcano.scheduler.plugin.v1.OpenSessionRequest.queues:type_name -> volcano.scheduler.plugin.v1.Queue
+	2,  // 10: volcano.scheduler.plugin.v1.Status.code:type_name -> volcano.scheduler.plugin.v1.StatusCode
+	6,  // 11: volcano.scheduler.plugin.v1.PredicateRequest.task:type_name -> volcano.scheduler.plugin.v1.Task
+	27, // 12: volcano.scheduler.plugin.v1.PredicateResponse.statuses:type_name -> volcano.scheduler.plugin.v1.PredicateResponse.StatusesEntry
+	6,  // 13: volcano.scheduler.plugin.v1.BatchNodeOrderRequest.task:type_name -> volcano.scheduler.plugin.v1.Task
+	28, // 14: volcano.scheduler.plugin.v1.BatchNodeOrderResponse.scores:type_name -> volcano.scheduler.plugin.v1.BatchNodeOrderResponse.ScoresEntry
+	7,  // 15: volcano.scheduler.plugin.v1.JobEnqueueableRequest.job:type_name -> volcano.scheduler.plugin.v1.Job
+	1,  // 16: volcano.scheduler.plugin.v1.JobEnqueueableResponse.vote:type_name -> volcano.scheduler.plugin.v1.Vote
+	0,  // 17: volcano.scheduler.plugin.v1.SelectVictimsRequest.hook:type_name -> volcano.scheduler.plugin.v1.Hook
+	6,  // 18: volcano.scheduler.plugin.v1.SelectVictimsRequest.preemptor:type_name -> volcano.scheduler.plugin.v1.Task
+	6,  // 19: volcano.scheduler.plugin.v1.SelectVictimsRequest.candidates:type_name -> volcano.scheduler.plugin.v1.Task
+	1,  // 20: volcano.scheduler.plugin.v1.SelectVictimsResponse.vote:type_name -> volcano.scheduler.plugin.v1.Vote
+	14, // 21: volcano.scheduler.plugin.v1.PredicateResponse.StatusesEntry.value:type_name -> volcano.scheduler.plugin.v1.Status
+	3,  // 22: volcano.scheduler.plugin.v1.SchedulerPlugin.GetCapabilities:input_type -> volcano.scheduler.plugin.v1.GetCapabilitiesRequest
+	10, // 23: volcano.scheduler.plugin.v1.SchedulerPlugin.OpenSession:input_type -> volcano.scheduler.plugin.v1.OpenSessionRequest
+	12, // 24: volcano.scheduler.plugin.v1.SchedulerPlugin.CloseSession:input_type -> volcano.scheduler.plugin.v1.CloseSessionRequest
+	15, // 25: volcano.scheduler.plugin.v1.SchedulerPlugin.Predicate:input_type -> volcano.scheduler.plugin.v1.PredicateRequest
+	17, // 26: volcano.scheduler.plugin.v1.SchedulerPlugin.BatchNodeOrder:input_type -> volcano.scheduler.plugin.v1.BatchNodeOrderRequest
+	19, // 27: volcano.scheduler.plugin.v1.SchedulerPlugin.JobOrder:input_type -> volcano.scheduler.plugin.v1.JobOrderRequest
+	21, // 28: volcano.scheduler.plugin.v1.SchedulerPlugin.JobEnqueueable:input_type -> volcano.scheduler.plugin.v1.JobEnqueueableRequest
+	23, // 29: volcano.scheduler.plugin.v1.SchedulerPlugin.SelectVictims:input_type -> volcano.scheduler.plugin.v1.SelectVictimsRequest
+	4,  // 30: volcano.scheduler.plugin.v1.SchedulerPlugin.GetCapabilities:output_type -> volcano.scheduler.plugin.v1.GetCapabilitiesResponse
+	11, // 31: volcano.scheduler.plugin.v1.SchedulerPlugin.OpenSession:output_type -> volcano.scheduler.plugin.v1.OpenSessionResponse
+	13, // 32: volcano.scheduler.plugin.v1.SchedulerPlugin.CloseSession:output_type -> volcano.scheduler.plugin.v1.CloseSessionResponse
+	16, // 33: volcano.scheduler.plugin.v1.SchedulerPlugin.Predicate:output_type -> volcano.scheduler.plugin.v1.PredicateResponse
+	18, // 34: volcano.scheduler.plugin.v1.SchedulerPlugin.BatchNodeOrder:output_type -> volcano.scheduler.plugin.v1.BatchNodeOrderResponse
+	20, // 35: volcano.scheduler.plugin.v1.SchedulerPlugin.JobOrder:output_type -> volcano.scheduler.plugin.v1.JobOrderResponse
+	22, // 36: volcano.scheduler.plugin.v1.SchedulerPlugin.JobEnqueueable:output_type -> volcano.scheduler.plugin.v1.JobEnqueueableResponse
+	24, // 37: volcano.scheduler.plugin.v1.SchedulerPlugin.SelectVictims:output_type -> volcano.scheduler.plugin.v1.SelectVictimsResponse
+	30, // [30:38] is the sub-list for method output_type
+	22, // [22:30] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_init() }
+func file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_init() {
+	if File_pkg_scheduler_plugins_remote_proto_v1_plugin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDesc), len(file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_goTypes,
+		DependencyIndexes: file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_depIdxs,
+		EnumInfos:         file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_enumTypes,
+		MessageInfos:      file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_msgTypes,
+	}.Build()
+	File_pkg_scheduler_plugins_remote_proto_v1_plugin_proto = out.File
+	file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_goTypes = nil
+	file_pkg_scheduler_plugins_remote_proto_v1_plugin_proto_depIdxs = nil
+}
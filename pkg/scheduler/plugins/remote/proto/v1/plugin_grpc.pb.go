@@ -0,0 +1,428 @@
+//
+//Copyright 2026 The Volcano Authors.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: pkg/scheduler/plugins/remote/proto/v1/plugin.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SchedulerPlugin_GetCapabilities_FullMethodName = "/volcano.scheduler.plugin.v1.SchedulerPlugin/GetCapabilities"
+	SchedulerPlugin_OpenSession_FullMethodName     = "/volcano.scheduler.plugin.v1.SchedulerPlugin/OpenSession"
+	SchedulerPlugin_CloseSession_FullMethodName    = "/volcano.scheduler.plugin.v1.SchedulerPlugin/CloseSession"
+	SchedulerPlugin_Predicate_FullMethodName       = "/volcano.scheduler.plugin.v1.SchedulerPlugin/Predicate"
+	SchedulerPlugin_BatchNodeOrder_FullMethodName  = "/volcano.scheduler.plugin.v1.SchedulerPlugin/BatchNodeOrder"
+	SchedulerPlugin_JobOrder_FullMethodName        = "/volcano.scheduler.plugin.v1.SchedulerPlugin/JobOrder"
+	SchedulerPlugin_JobEnqueueable_FullMethodName  = "/volcano.scheduler.plugin.v1.SchedulerPlugin/JobEnqueueable"
+	SchedulerPlugin_SelectVictims_FullMethodName   = "/volcano.scheduler.plugin.v1.SchedulerPlugin/SelectVictims"
+)
+
+// SchedulerPluginClient is the client API for SchedulerPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SchedulerPlugin is served by an out-of-process plugin of the Volcano scheduler. The scheduler asks the plugin for
+// the hooks it implements once connected, opens a session on the plugin with a snapshot of the cluster at the start
+// of every scheduling cycle, and only calls the hooks the plugin registered within the session.
+type SchedulerPluginClient interface {
+	// GetCapabilities returns the name of the plugin, the hooks it implements and the protocol version it serves. The
+	// scheduler does not call the plugin serving a protocol version other than its own.
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error)
+	// OpenSession is called at the start of every scheduling cycle with the snapshot of the cluster.
+	OpenSession(ctx context.Context, in *OpenSessionRequest, opts ...grpc.CallOption) (*OpenSessionResponse, error)
+	// CloseSession is called at the end of every scheduling cycle.
+	CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error)
+	// Predicate filters the nodes for a task, it is called once per task for all the nodes.
+	Predicate(ctx context.Context, in *PredicateRequest, opts ...grpc.CallOption) (*PredicateResponse, error)
+	// BatchNodeOrder scores the nodes for a task.
+	BatchNodeOrder(ctx context.Context, in *BatchNodeOrderRequest, opts ...grpc.CallOption) (*BatchNodeOrderResponse, error)
+	// JobOrder orders the jobs of the session, it is called once per session.
+	JobOrder(ctx context.Context, in *JobOrderRequest, opts ...grpc.CallOption) (*JobOrderResponse, error)
+	// JobEnqueueable votes whether a job can be enqueued.
+	JobEnqueueable(ctx context.Context, in *JobEnqueueableRequest, opts ...grpc.CallOption) (*JobEnqueueableResponse, error)
+	// SelectVictims selects the victims among the candidates to be preempted or reclaimed for the preemptor.
+	SelectVictims(ctx context.Context, in *SelectVictimsRequest, opts ...grpc.CallOption) (*SelectVictimsResponse, error)
+}
+
+type schedulerPluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchedulerPluginClient(cc grpc.ClientConnInterface) SchedulerPluginClient {
+	return &schedulerPluginClient{cc}
+}
+
+func (c *schedulerPluginClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, SchedulerPlugin_GetCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPluginClient) OpenSession(ctx context.Context, in *OpenSessionRequest, opts ...grpc.CallOption) (*OpenSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenSessionResponse)
+	err := c.cc.Invoke(ctx, SchedulerPlugin_OpenSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPluginClient) CloseSession(ctx context.Context, in *CloseSessionRequest, opts ...grpc.CallOption) (*CloseSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseSessionResponse)
+	err := c.cc.Invoke(ctx, SchedulerPlugin_CloseSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPluginClient) Predicate(ctx context.Context, in *PredicateRequest, opts ...grpc.CallOption) (*PredicateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PredicateResponse)
+	err := c.cc.Invoke(ctx, SchedulerPlugin_Predicate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPluginClient) BatchNodeOrder(ctx context.Context, in *BatchNodeOrderRequest, opts ...grpc.CallOption) (*BatchNodeOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchNodeOrderResponse)
+	err := c.cc.Invoke(ctx, SchedulerPlugin_BatchNodeOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPluginClient) JobOrder(ctx context.Context, in *JobOrderRequest, opts ...grpc.CallOption) (*JobOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobOrderResponse)
+	err := c.cc.Invoke(ctx, SchedulerPlugin_JobOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPluginClient) JobEnqueueable(ctx context.Context, in *JobEnqueueableRequest, opts ...grpc.CallOption) (*JobEnqueueableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobEnqueueableResponse)
+	err := c.cc.Invoke(ctx, SchedulerPlugin_JobEnqueueable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPluginClient) SelectVictims(ctx context.Context, in *SelectVictimsRequest, opts ...grpc.CallOption) (*SelectVictimsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelectVictimsResponse)
+	err := c.cc.Invoke(ctx, SchedulerPlugin_SelectVictims_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchedulerPluginServer is the server API for SchedulerPlugin service.
+// All implementations must embed UnimplementedSchedulerPluginServer
+// for forward compatibility.
+//
+// SchedulerPlugin is served by an out-of-process plugin of the Volcano scheduler. The scheduler asks the plugin for
+// the hooks it implements once connected, opens a session on the plugin with a snapshot of the cluster at the start
+// of every scheduling cycle, and only calls the hooks the plugin registered within the session.
+type SchedulerPluginServer interface {
+	// GetCapabilities returns the name of the plugin, the hooks it implements and the protocol version it serves. The
+	// scheduler does not call the plugin serving a protocol version other than its own.
+	GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error)
+	// OpenSession is called at the start of every scheduling cycle with the snapshot of the cluster.
+	OpenSession(context.Context, *OpenSessionRequest) (*OpenSessionResponse, error)
+	// CloseSession is called at the end of every scheduling cycle.
+	CloseSession(context.Context, *CloseSessionRequest) (*CloseSessionResponse, error)
+	// Predicate filters the nodes for a task, it is called once per task for all the nodes.
+	Predicate(context.Context, *PredicateRequest) (*PredicateResponse, error)
+	// BatchNodeOrder scores the nodes for a task.
+	BatchNodeOrder(context.Context, *BatchNodeOrderRequest) (*BatchNodeOrderResponse, error)
+	// JobOrder orders the jobs of the session, it is called once per session.
+	JobOrder(context.Context, *JobOrderRequest) (*JobOrderResponse, error)
+	// JobEnqueueable votes whether a job can be enqueued.
+	JobEnqueueable(context.Context, *JobEnqueueableRequest) (*JobEnqueueableResponse, error)
+	// SelectVictims selects the victims among the candidates to be preempted or reclaimed for the preemptor.
+	SelectVictims(context.Context, *SelectVictimsRequest) (*SelectVictimsResponse, error)
+	mustEmbedUnimplementedSchedulerPluginServer()
+}
+
+// UnimplementedSchedulerPluginServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSchedulerPluginServer struct{}
+
+func (UnimplementedSchedulerPluginServer) GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+func (UnimplementedSchedulerPluginServer) OpenSession(context.Context, *OpenSessionRequest) (*OpenSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenSession not implemented")
+}
+func (UnimplementedSchedulerPluginServer) CloseSession(context.Context, *CloseSessionRequest) (*CloseSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSession not implemented")
+}
+func (UnimplementedSchedulerPluginServer) Predicate(context.Context, *PredicateRequest) (*PredicateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Predicate not implemented")
+}
+func (UnimplementedSchedulerPluginServer) BatchNodeOrder(context.Context, *BatchNodeOrderRequest) (*BatchNodeOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchNodeOrder not implemented")
+}
+func (UnimplementedSchedulerPluginServer) JobOrder(context.Context, *JobOrderRequest) (*JobOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JobOrder not implemented")
+}
+func (UnimplementedSchedulerPluginServer) JobEnqueueable(context.Context, *JobEnqueueableRequest) (*JobEnqueueableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JobEnqueueable not implemented")
+}
+func (UnimplementedSchedulerPluginServer) SelectVictims(context.Context, *SelectVictimsRequest) (*SelectVictimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectVictims not implemented")
+}
+func (UnimplementedSchedulerPluginServer) mustEmbedUnimplementedSchedulerPluginServer() {}
+func (UnimplementedSchedulerPluginServer) testEmbeddedByValue()                         {}
+
+// UnsafeSchedulerPluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchedulerPluginServer will
+// result in compilation errors.
+type UnsafeSchedulerPluginServer interface {
+	mustEmbedUnimplementedSchedulerPluginServer()
+}
+
+func RegisterSchedulerPluginServer(s grpc.ServiceRegistrar, srv SchedulerPluginServer) {
+	// If the following call pancis, it indicates UnimplementedSchedulerPluginServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SchedulerPlugin_ServiceDesc, srv)
+}
+
+func _SchedulerPlugin_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerPluginServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerPlugin_GetCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerPluginServer).GetCapabilities(ctx, req.(*GetCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerPlugin_OpenSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerPluginServer).OpenSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerPlugin_OpenSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerPluginServer).OpenSession(ctx, req.(*OpenSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerPlugin_CloseSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerPluginServer).CloseSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerPlugin_CloseSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerPluginServer).CloseSession(ctx, req.(*CloseSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerPlugin_Predicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PredicateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerPluginServer).Predicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerPlugin_Predicate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerPluginServer).Predicate(ctx, req.(*PredicateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerPlugin_BatchNodeOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchNodeOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerPluginServer).BatchNodeOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerPlugin_BatchNodeOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerPluginServer).BatchNodeOrder(ctx, req.(*BatchNodeOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerPlugin_JobOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerPluginServer).JobOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerPlugin_JobOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerPluginServer).JobOrder(ctx, req.(*JobOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerPlugin_JobEnqueueable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobEnqueueableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerPluginServer).JobEnqueueable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerPlugin_JobEnqueueable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerPluginServer).JobEnqueueable(ctx, req.(*JobEnqueueableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerPlugin_SelectVictims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectVictimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerPluginServer).SelectVictims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerPlugin_SelectVictims_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerPluginServer).SelectVictims(ctx, req.(*SelectVictimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SchedulerPlugin_ServiceDesc is the grpc.ServiceDesc for SchedulerPlugin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SchedulerPlugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "volcano.scheduler.plugin.v1.SchedulerPlugin",
+	HandlerType: (*SchedulerPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _SchedulerPlugin_GetCapabilities_Handler,
+		},
+		{
+			MethodName: "OpenSession",
+			Handler:    _SchedulerPlugin_OpenSession_Handler,
+		},
+		{
+			MethodName: "CloseSession",
+			Handler:    _SchedulerPlugin_CloseSession_Handler,
+		},
+		{
+			MethodName: "Predicate",
+			Handler:    _SchedulerPlugin_Predicate_Handler,
+		},
+		{
+			MethodName: "BatchNodeOrder",
+			Handler:    _SchedulerPlugin_BatchNodeOrder_Handler,
+		},
+		{
+			MethodName: "JobOrder",
+			Handler:    _SchedulerPlugin_JobOrder_Handler,
+		},
+		{
+			MethodName: "JobEnqueueable",
+			Handler:    _SchedulerPlugin_JobEnqueueable_Handler,
+		},
+		{
+			MethodName: "SelectVictims",
+			Handler:    _SchedulerPlugin_SelectVictims_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/scheduler/plugins/remote/proto/v1/plugin.proto",
+}
@@ -0,0 +1,455 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package remote implements the scheduler plugin calling an out-of-process plugin over gRPC. The out-of-process plugin
+// serves the SchedulerPlugin service of the versioned protocol in the proto package and registers any subset of the
+// session hooks, so that it neither shares the toolchain nor the dependencies of the scheduler as a Go plugin does.
+package remote
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	pluginv1 "volcano.sh/volcano/pkg/scheduler/plugins/remote/proto/v1"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util"
+	"volcano.sh/volcano/pkg/scheduler/plugins/util/batchpredicate"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "remote"
+
+	// NameKey is the name of the plugin instance, which is required to configure several out-of-process plugins, the
+	// instance is configured as remote/<name> in the scheduler configuration
+	NameKey = "remote.name"
+	// AddressKey is the gRPC target of the out-of-process plugin, e.g. "unix:///var/run/volcano/plugin.sock"
+	AddressKey = "remote.address"
+	// TimeoutKey is the timeout of every call to the out-of-process plugin
+	TimeoutKey = "remote.timeout"
+	// FailurePolicyKey is how the failed calls are handled, either Ignore or Fail
+	FailurePolicyKey = "remote.failurePolicy"
+
+	// FailurePolicyIgnore ignores the failed calls, as if the out-of-process plugin is not configured
+	FailurePolicyIgnore = "Ignore"
+	// FailurePolicyFail rejects the tasks and jobs the failed calls are for
+	FailurePolicyFail = "Fail"
+
+	// protocolVersion is the version of the protocol between the scheduler and the out-of-process plugins
+	protocolVersion = "v1"
+	defaultTimeout  = time.Second
+)
+
+var (
+	connLock sync.Mutex
+	// connections are the connections to the out-of-process plugins keyed by address, shared by the sessions
+	connections = map[string]*grpc.ClientConn{}
+)
+
+type remoteConfig struct {
+	name          string
+	address       string
+	timeout       time.Duration
+	failurePolicy string
+}
+
+type remotePlugin struct {
+	config *remoteConfig
+	client pluginv1.SchedulerPluginClient
+	// err is the error to open the session, which fails all the calls
+	err error
+	// name is the name the out-of-process plugin reported
+	name      string
+	sessionID string
+
+	// predicates are the predicate results of the tasks in the session, the nodes are filtered once per task and
+	// filtered again once the node state changes
+	predicates *batchpredicate.Cache[map[string]*pluginv1.Status]
+
+	jobOrderOnce sync.Once
+	// jobRanks are the ranks of the jobs in the session ordered by the out-of-process plugin
+	jobRanks map[api.JobID]int
+}
+
+func parseConfig(arguments framework.Arguments) *remoteConfig {
+	/*
+	   tiers:
+	   - plugins:
+	     - name: remote
+	       arguments:
+	         remote.address: unix:///var/run/volcano/plugin.sock
+	         remote.timeout: 100ms
+	         remote.failurePolicy: Ignore
+	     - name: remote/gpu
+	       arguments:
+	         remote.name: gpu
+	         remote.address: unix:///var/run/volcano/gpu.sock
+	*/
+	config := &remoteConfig{timeout: defaultTimeout, failurePolicy: FailurePolicyIgnore}
+	arguments.GetString(&config.name, NameKey)
+	arguments.GetString(&config.address, AddressKey)
+	arguments.GetString(&config.failurePolicy, FailurePolicyKey)
+	var timeout string
+	arguments.GetString(&timeout, TimeoutKey)
+	if duration, err := time.ParseDuration(timeout); err == nil && duration > 0 {
+		config.timeout = duration
+	}
+	return config
+}
+
+// ValidateArguments validates the arguments of the remote plugin.
+func ValidateArguments(arguments framework.Arguments) error {
+	if argv, found := arguments[NameKey]; found {
+		if name, _ := argv.(string); name == "" || strings.Contains(name, "/") {
+			return fmt.Errorf("argument %s must be a non-empty name without '/', got %v", NameKey, argv)
+		}
+	}
+	if address, _ := arguments[AddressKey].(string); address == "" {
+		return fmt.Errorf("argument %s is required", AddressKey)
+	}
+	if argv, found := arguments[TimeoutKey]; found {
+		timeout, _ := argv.(string)
+		if duration, err := time.ParseDuration(timeout); err != nil || duration <= 0 {
+			return fmt.Errorf("argument %s must be a positive duration, got %v", TimeoutKey, argv)
+		}
+	}
+	if argv, found := arguments[FailurePolicyKey]; found {
+		if policy, _ := argv.(string); policy != FailurePolicyIgnore && policy != FailurePolicyFail {
+			return fmt.Errorf("argument %s must be %s or %s, got %v", FailurePolicyKey, FailurePolicyIgnore, FailurePolicyFail, argv)
+		}
+	}
+	return nil
+}
+
+// New returns the remote plugin.
+func New(arguments framework.Arguments) framework.Plugin {
+	return &remotePlugin{
+		config:     parseConfig(arguments),
+		predicates: batchpredicate.NewCache[map[string]*pluginv1.Status](),
+	}
+}
+
+// Name returns remote/<name> for the named instance, so that the hooks of the instances are kept apart in the session.
+func (rp *remotePlugin) Name() string {
+	if rp.config.name == "" {
+		return PluginName
+	}
+	return PluginName + "/" + rp.config.name
+}
+
+// connect returns the connection to the out-of-process plugin, which is established lazily by gRPC and reused by
+// the following sessions.
+func connect(address string) (*grpc.ClientConn, error) {
+	connLock.Lock()
+	defer connLock.Unlock()
+
+	if conn, found := connections[address]; found {
+		return conn, nil
+	}
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	connections[address] = conn
+	return conn, nil
+}
+
+// CloseConnections closes the connections to the out-of-process plugins, which are established again by the following
+// sessions on demand.
+func CloseConnections() {
+	connLock.Lock()
+	defer connLock.Unlock()
+
+	for address, conn := range connections {
+		if err := conn.Close(); err != nil {
+			klog.Warningf("Failed to close the connection to the out-of-process plugin at <%s>: %v", address, err)
+		}
+	}
+	connections = map[string]*grpc.ClientConn{}
+}
+
+func (rp *remotePlugin) OnSessionOpen(ssn *framework.Session) {
+	rp.sessionID = string(ssn.UID)
+	conn, err := connect(rp.config.address)
+	if err != nil {
+		klog.Errorf("Failed to connect to the out-of-process plugin at <%s>: %v", rp.config.address, err)
+		return
+	}
+	rp.client = pluginv1.NewSchedulerPluginClient(conn)
+
+	hooks, err := rp.openSession(ssn)
+	if err != nil {
+		klog.Warningf("Failed to open session on the out-of-process plugin at <%s>: %v", rp.config.address, err)
+		if !rp.failClosed() {
+			return
+		}
+		rp.err = err
+		// the hooks of the plugin are unknown, register all of them to reject the tasks and jobs
+		hooks = sets.New(pluginv1.Hook_HOOK_PREDICATE, pluginv1.Hook_HOOK_BATCH_NODE_ORDER, pluginv1.Hook_HOOK_JOB_ORDER,
+			pluginv1.Hook_HOOK_JOB_ENQUEUEABLE, pluginv1.Hook_HOOK_PREEMPTABLE, pluginv1.Hook_HOOK_RECLAIMABLE)
+	}
+	klog.V(4).Infof("Out-of-process plugin <%s> at <%s> registered hooks %v.", rp.name, rp.config.address, sets.List(hooks))
+
+	if hooks.Has(pluginv1.Hook_HOOK_PREDICATE) {
+		ssn.AddPredicateFn(rp.Name(), func(task *api.TaskInfo, node *api.NodeInfo) error {
+			return rp.predicate(ssn, task, node)
+		})
+		// the predicate results are filtered against the node state, drop them once it changes
+		invalidate := func(event *framework.Event) {
+			rp.predicates.Invalidate()
+		}
+		ssn.AddEventHandler(&framework.EventHandler{
+			AllocateFunc:   invalidate,
+			DeallocateFunc: invalidate,
+		})
+	}
+
+	if hooks.Has(pluginv1.Hook_HOOK_BATCH_NODE_ORDER) {
+		ssn.AddBatchNodeOrderFn(rp.Name(), func(task *api.TaskInfo, nodes []*api.NodeInfo) (map[string]float64, error) {
+			return rp.batchNodeOrder(task, nodes)
+		})
+	}
+
+	if hooks.Has(pluginv1.Hook_HOOK_JOB_ORDER) {
+		ssn.AddJobOrderFn(rp.Name(), func(l, r interface{}) int {
+			return rp.jobOrder(ssn, l.(*api.JobInfo), r.(*api.JobInfo))
+		})
+	}
+
+	if hooks.Has(pluginv1.Hook_HOOK_JOB_ENQUEUEABLE) {
+		ssn.AddJobEnqueueableFn(rp.Name(), func(obj interface{}) int {
+			return rp.jobEnqueueable(obj.(*api.JobInfo))
+		})
+	}
+
+	if hooks.Has(pluginv1.Hook_HOOK_PREEMPTABLE) {
+		ssn.AddPreemptableFn(rp.Name(), func(preemptor *api.TaskInfo, preemptees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+			return rp.selectVictims(pluginv1.Hook_HOOK_PREEMPTABLE, preemptor, preemptees)
+		})
+	}
+
+	if hooks.Has(pluginv1.Hook_HOOK_RECLAIMABLE) {
+		ssn.AddReclaimableFn(rp.Name(), func(reclaimer *api.TaskInfo, reclaimees []*api.TaskInfo) ([]*api.TaskInfo, int) {
+			return rp.selectVictims(pluginv1.Hook_HOOK_RECLAIMABLE, reclaimer, reclaimees)
+		})
+	}
+}
+
+func (rp *remotePlugin) OnSessionClose(ssn *framework.Session) {
+	if rp.client == nil || rp.name == "" {
+		return
+	}
+	ctx, cancel := rp.context()
+	defer cancel()
+	if _, err := rp.client.CloseSession(ctx, &pluginv1.CloseSessionRequest{SessionId: rp.sessionID}); err != nil {
+		klog.Warningf("Failed to close session on the out-of-process plugin <%s>: %v", rp.name, err)
+	}
+}
+
+// openSession gets the hooks of the out-of-process plugin, and opens the session on it with the snapshot.
+func (rp *remotePlugin) openSession(ssn *framework.Session) (sets.Set[pluginv1.Hook], error) {
+	ctx, cancel := rp.context()
+	defer cancel()
+	capabilities, err := rp.client.GetCapabilities(ctx, &pluginv1.GetCapabilitiesRequest{ProtocolVersion: protocolVersion})
+	if err != nil {
+		return nil, err
+	}
+	if capabilities.ProtocolVersion != protocolVersion {
+		return nil, fmt.Errorf("plugin <%s> serves protocol version %q, but %q is required",
+			capabilities.Name, capabilities.ProtocolVersion, protocolVersion)
+	}
+
+	ctx, cancel = rp.context()
+	defer cancel()
+	if _, err := rp.client.OpenSession(ctx, newOpenSessionRequest(rp.sessionID, ssn)); err != nil {
+		return nil, err
+	}
+	rp.name = capabilities.Name
+	return sets.New(capabilities.Hooks...), nil
+}
+
+// predicate filters the nodes for the task by the out-of-process plugin, all the nodes of the session are filtered
+// by a single call on the first node, and the results are reused for the other nodes until a task is allocated or
+// deallocated in the session.
+func (rp *remotePlugin) predicate(ssn *framework.Session, task *api.TaskInfo, node *api.NodeInfo) error {
+	statuses, err := rp.predicates.Get(task, func() (map[string]*pluginv1.Status, error) {
+		if rp.err != nil {
+			return nil, rp.err
+		}
+		nodeNames := make([]string, 0, len(ssn.NodeList))
+		for _, node := range ssn.NodeList {
+			nodeNames = append(nodeNames, node.Name)
+		}
+		ctx, cancel := rp.context()
+		defer cancel()
+		resp, err := rp.client.Predicate(ctx, &pluginv1.PredicateRequest{SessionId: rp.sessionID, Task: newTask(task), NodeNames: nodeNames})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Statuses, nil
+	})
+
+	if err != nil {
+		klog.Warningf("Predicate of the out-of-process plugin <%s> failed for task <%s/%s>: %v", rp.name, task.Namespace, task.Name, err)
+		if !rp.failClosed() {
+			return nil
+		}
+		return api.NewFitError(task, node, err.Error())
+	}
+
+	status, found := statuses[node.Name]
+	if !found || status.Code == pluginv1.StatusCode_STATUS_CODE_SUCCESS {
+		return nil
+	}
+	return api.NewFitErrWithStatus(task, node, &api.Status{Code: int(status.Code), Reason: status.Reason, Plugin: PluginName})
+}
+
+func (rp *remotePlugin) batchNodeOrder(task *api.TaskInfo, nodes []*api.NodeInfo) (map[string]float64, error) {
+	if rp.err != nil {
+		return nil, rp.err
+	}
+	nodeNames := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		nodeNames = append(nodeNames, node.Name)
+	}
+	ctx, cancel := rp.context()
+	defer cancel()
+	resp, err := rp.client.BatchNodeOrder(ctx, &pluginv1.BatchNodeOrderRequest{SessionId: rp.sessionID, Task: newTask(task), NodeNames: nodeNames})
+	if err != nil {
+		klog.Warningf("BatchNodeOrder of the out-of-process plugin <%s> failed for task <%s/%s>: %v", rp.name, task.Namespace, task.Name, err)
+		if !rp.failClosed() {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return resp.Scores, nil
+}
+
+// jobOrder orders the jobs by their ranks, which are got by a single call for all the jobs of the session.
+func (rp *remotePlugin) jobOrder(ssn *framework.Session, l, r *api.JobInfo) int {
+	rp.jobOrderOnce.Do(func() {
+		if rp.err != nil {
+			return
+		}
+		jobIDs := make([]string, 0, len(ssn.Jobs))
+		for jobID := range ssn.Jobs {
+			jobIDs = append(jobIDs, string(jobID))
+		}
+		ctx, cancel := rp.context()
+		defer cancel()
+		resp, err := rp.client.JobOrder(ctx, &pluginv1.JobOrderRequest{SessionId: rp.sessionID, JobIds: jobIDs})
+		if err != nil {
+			// the jobs can't be rejected by the order, so they are not ordered by the plugin whatever the failure policy is
+			klog.Warningf("JobOrder of the out-of-process plugin <%s> failed: %v", rp.name, err)
+			return
+		}
+		rp.jobRanks = make(map[api.JobID]int, len(resp.JobIds))
+		for rank, jobID := range resp.JobIds {
+			rp.jobRanks[api.JobID(jobID)] = rank
+		}
+	})
+
+	lRank, lFound := rp.jobRanks[l.UID]
+	rRank, rFound := rp.jobRanks[r.UID]
+	if !lFound || !rFound || lRank == rRank {
+		return 0
+	}
+	if lRank < rRank {
+		return -1
+	}
+	return 1
+}
+
+func (rp *remotePlugin) jobEnqueueable(job *api.JobInfo) int {
+	if rp.err != nil {
+		return rp.failureVote()
+	}
+	ctx, cancel := rp.context()
+	defer cancel()
+	resp, err := rp.client.JobEnqueueable(ctx, &pluginv1.JobEnqueueableRequest{SessionId: rp.sessionID, Job: newJob(job)})
+	if err != nil {
+		klog.Warningf("JobEnqueueable of the out-of-process plugin <%s> failed for job <%s/%s>: %v", rp.name, job.Namespace, job.Name, err)
+		return rp.failureVote()
+	}
+	return toVote(resp.Vote)
+}
+
+func (rp *remotePlugin) selectVictims(hook pluginv1.Hook, preemptor *api.TaskInfo, candidates []*api.TaskInfo) ([]*api.TaskInfo, int) {
+	if rp.err != nil {
+		return nil, rp.failureVote()
+	}
+	req := &pluginv1.SelectVictimsRequest{
+		SessionId:  rp.sessionID,
+		Hook:       hook,
+		Preemptor:  newTask(preemptor),
+		Candidates: make([]*pluginv1.Task, 0, len(candidates)),
+	}
+	for _, candidate := range candidates {
+		req.Candidates = append(req.Candidates, newTask(candidate))
+	}
+	ctx, cancel := rp.context()
+	defer cancel()
+	resp, err := rp.client.SelectVictims(ctx, req)
+	if err != nil {
+		klog.Warningf("SelectVictims of the out-of-process plugin <%s> failed for task <%s/%s>: %v", rp.name, preemptor.Namespace, preemptor.Name, err)
+		return nil, rp.failureVote()
+	}
+
+	victimUIDs := sets.New(resp.VictimUids...)
+	var victims []*api.TaskInfo
+	for _, candidate := range candidates {
+		if victimUIDs.Has(string(candidate.UID)) {
+			victims = append(victims, candidate)
+		}
+	}
+	return victims, toVote(resp.Vote)
+}
+
+func (rp *remotePlugin) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), rp.config.timeout)
+}
+
+// failClosed returns whether the tasks and jobs are rejected if the calls fail.
+func (rp *remotePlugin) failClosed() bool {
+	return rp.config.failurePolicy == FailurePolicyFail
+}
+
+// failureVote returns the vote of the failed calls by the failure policy.
+func (rp *remotePlugin) failureVote() int {
+	if rp.failClosed() {
+		return util.Reject
+	}
+	return util.Abstain
+}
+
+func toVote(vote pluginv1.Vote) int {
+	switch vote {
+	case pluginv1.Vote_VOTE_PERMIT:
+		return util.Permit
+	case pluginv1.Vote_VOTE_REJECT:
+		return util.Reject
+	}
+	return util.Abstain
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+
+	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/actions/allocate"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/gang"
+	pluginv1 "volcano.sh/volcano/pkg/scheduler/plugins/remote/proto/v1"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestMain(m *testing.M) {
+	options.Default()
+	os.Exit(m.Run())
+}
+
+// fakePlugin is an out-of-process plugin rejecting node n1 in predicate and preferring node n1 in node order.
+type fakePlugin struct {
+	pluginv1.UnimplementedSchedulerPluginServer
+
+	hooks           []pluginv1.Hook
+	protocolVersion string
+
+	lock           sync.Mutex
+	openedJobs     int
+	closedSessions int
+	predicateCalls int
+}
+
+func (fp *fakePlugin) GetCapabilities(context.Context, *pluginv1.GetCapabilitiesRequest) (*pluginv1.GetCapabilitiesResponse, error) {
+	return &pluginv1.GetCapabilitiesResponse{Name: "fake", Hooks: fp.hooks, ProtocolVersion: fp.protocolVersion}, nil
+}
+
+func (fp *fakePlugin) OpenSession(_ context.Context, req *pluginv1.OpenSessionRequest) (*pluginv1.OpenSessionResponse, error) {
+	fp.lock.Lock()
+	defer fp.lock.Unlock()
+	fp.openedJobs = len(req.Jobs)
+	return &pluginv1.OpenSessionResponse{}, nil
+}
+
+func (fp *fakePlugin) CloseSession(context.Context, *pluginv1.CloseSessionRequest) (*pluginv1.CloseSessionResponse, error) {
+	fp.lock.Lock()
+	defer fp.lock.Unlock()
+	fp.closedSessions++
+	return &pluginv1.CloseSessionResponse{}, nil
+}
+
+func (fp *fakePlugin) Predicate(_ context.Context, req *pluginv1.PredicateRequest) (*pluginv1.PredicateResponse, error) {
+	fp.lock.Lock()
+	defer fp.lock.Unlock()
+	fp.predicateCalls++
+	return &pluginv1.PredicateResponse{Statuses: map[string]*pluginv1.Status{
+		"n1": {Code: pluginv1.StatusCode_STATUS_CODE_UNSCHEDULABLE, Reason: "reserved for placement"},
+	}}, nil
+}
+
+func (fp *fakePlugin) BatchNodeOrder(_ context.Context, req *pluginv1.BatchNodeOrderRequest) (*pluginv1.BatchNodeOrderResponse, error) {
+	return &pluginv1.BatchNodeOrderResponse{Scores: map[string]float64{"n1": 100}}, nil
+}
+
+func startFakePlugin(t *testing.T, protocolVersion string, hooks ...pluginv1.Hook) (*fakePlugin, string) {
+	socket := filepath.Join(t.TempDir(), "plugin.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("failed to listen on %s: %v", socket, err)
+	}
+	fp := &fakePlugin{hooks: hooks, protocolVersion: protocolVersion}
+	server := grpc.NewServer()
+	pluginv1.RegisterSchedulerPluginServer(server, fp)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return fp, "unix://" + socket
+}
+
+func TestRemotePlugin(t *testing.T) {
+	trueValue := true
+	buildTiers := func(address, failurePolicy string) []conf.Tier {
+		return []conf.Tier{
+			{
+				Plugins: []conf.PluginOption{
+					{
+						Name:            gang.PluginName,
+						EnabledJobReady: &trueValue,
+					},
+					{
+						Name:             PluginName,
+						EnabledPredicate: &trueValue,
+						EnabledNodeOrder: &trueValue,
+						Arguments: framework.Arguments{
+							AddressKey:       address,
+							TimeoutKey:       "200ms",
+							FailurePolicyKey: failurePolicy,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		uthelper.TestCommonStruct
+		hooks []pluginv1.Hook
+		// unreachable means the out-of-process plugin is not served
+		unreachable bool
+		// protocolVersion is the protocol version served by the out-of-process plugin, the one of the scheduler if empty
+		protocolVersion    string
+		failurePolicy      string
+		expectedPredicates int
+	}{
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "predicate of the out-of-process plugin filters the nodes once per task",
+				ExpectBindMap:  map[string]string{"c1/p1": "n2"},
+				ExpectBindsNum: 1,
+			},
+			hooks:              []pluginv1.Hook{pluginv1.Hook_HOOK_PREDICATE},
+			failurePolicy:      FailurePolicyIgnore,
+			expectedPredicates: 1,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "node order of the out-of-process plugin scores the nodes",
+				ExpectBindMap:  map[string]string{"c1/p1": "n1"},
+				ExpectBindsNum: 1,
+			},
+			hooks:         []pluginv1.Hook{pluginv1.Hook_HOOK_BATCH_NODE_ORDER},
+			failurePolicy: FailurePolicyIgnore,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:             "unreachable plugin is ignored with Ignore failure policy",
+				MinimalBindCheck: true,
+				ExpectBindsNum:   1,
+			},
+			unreachable:   true,
+			failurePolicy: FailurePolicyIgnore,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "unreachable plugin rejects the tasks with Fail failure policy",
+				ExpectBindMap:  map[string]string{},
+				ExpectBindsNum: 0,
+			},
+			unreachable:   true,
+			failurePolicy: FailurePolicyFail,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:             "plugin serving another protocol version is ignored with Ignore failure policy",
+				MinimalBindCheck: true,
+				ExpectBindsNum:   1,
+			},
+			hooks:           []pluginv1.Hook{pluginv1.Hook_HOOK_PREDICATE},
+			protocolVersion: "v2",
+			failurePolicy:   FailurePolicyIgnore,
+		},
+		{
+			TestCommonStruct: uthelper.TestCommonStruct{
+				Name:           "plugin serving another protocol version rejects the tasks with Fail failure policy",
+				ExpectBindMap:  map[string]string{},
+				ExpectBindsNum: 0,
+			},
+			hooks:           []pluginv1.Hook{pluginv1.Hook_HOOK_PREDICATE},
+			protocolVersion: "v2",
+			failurePolicy:   FailurePolicyFail,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			address := "unix://" + filepath.Join(t.TempDir(), "missing.sock")
+			var fp *fakePlugin
+			if !test.unreachable {
+				protocolVersion := test.protocolVersion
+				if protocolVersion == "" {
+					protocolVersion = "v1"
+				}
+				fp, address = startFakePlugin(t, protocolVersion, test.hooks...)
+			}
+
+			test.Plugins = map[string]framework.PluginBuilder{gang.PluginName: gang.New, PluginName: New}
+			test.Nodes = []*v1.Node{
+				util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+				util.BuildNode("n2", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			}
+			test.PodGroups = []*schedulingv1.PodGroup{util.BuildPodGroup("pg1", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue)}
+			test.Pods = []*v1.Pod{
+				util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+			}
+			test.Queues = []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)}
+
+			test.RegisterSession(buildTiers(address, test.failurePolicy), nil)
+			test.Run([]framework.Action{allocate.New()})
+			if err := test.CheckAll(i); err != nil {
+				t.Fatal(err)
+			}
+			test.Close()
+
+			if fp == nil || test.protocolVersion != "" {
+				return
+			}
+			fp.lock.Lock()
+			defer fp.lock.Unlock()
+			if fp.openedJobs != 1 || fp.closedSessions != 1 {
+				t.Errorf("expected the session opened with 1 job and closed once, got %d jobs and closed %d times", fp.openedJobs, fp.closedSessions)
+			}
+			if fp.predicateCalls != test.expectedPredicates {
+				t.Errorf("expected %d predicate calls, got %d", test.expectedPredicates, fp.predicateCalls)
+			}
+		})
+	}
+}
+
+func TestSeveralRemotePlugins(t *testing.T) {
+	trueValue := true
+	fpA, addressA := startFakePlugin(t, "v1", pluginv1.Hook_HOOK_PREDICATE)
+	fpB, addressB := startFakePlugin(t, "v1", pluginv1.Hook_HOOK_PREDICATE)
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:            gang.PluginName,
+					EnabledJobReady: &trueValue,
+				},
+				{
+					Name:             PluginName + "/a",
+					EnabledPredicate: &trueValue,
+					Arguments:        framework.Arguments{NameKey: "a", AddressKey: addressA},
+				},
+				{
+					Name:             PluginName + "/b",
+					EnabledPredicate: &trueValue,
+					Arguments:        framework.Arguments{NameKey: "b", AddressKey: addressB},
+				},
+			},
+		},
+	}
+
+	test := uthelper.TestCommonStruct{
+		Name:           "every named instance filters the nodes",
+		ExpectBindMap:  map[string]string{"c1/p1": "n2"},
+		ExpectBindsNum: 1,
+		Plugins:        map[string]framework.PluginBuilder{gang.PluginName: gang.New, PluginName: New},
+		Nodes: []*v1.Node{
+			util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+			util.BuildNode("n2", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+		},
+		PodGroups: []*schedulingv1.PodGroup{util.BuildPodGroup("pg1", "c1", "c1", 1, nil, schedulingv1.PodGroupInqueue)},
+		Pods: []*v1.Pod{
+			util.BuildPod("c1", "p1", "", v1.PodPending, api.BuildResourceList("1", "1G"), "pg1", make(map[string]string), make(map[string]string)),
+		},
+		Queues: []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)},
+	}
+	test.RegisterSession(tiers, nil)
+	test.Run([]framework.Action{allocate.New()})
+	if err := test.CheckAll(0); err != nil {
+		t.Fatal(err)
+	}
+	test.Close()
+
+	for name, fp := range map[string]*fakePlugin{"a": fpA, "b": fpB} {
+		fp.lock.Lock()
+		if fp.predicateCalls != 1 {
+			t.Errorf("expected 1 predicate call on plugin %s, got %d", name, fp.predicateCalls)
+		}
+		fp.lock.Unlock()
+	}
+
+	CloseConnections()
+	connLock.Lock()
+	defer connLock.Unlock()
+	if len(connections) != 0 {
+		t.Errorf("expected the connections to be closed, got %d", len(connections))
+	}
+}
+
+func TestValidateArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments framework.Arguments
+		wantErr   bool
+	}{
+		{name: "valid arguments", arguments: framework.Arguments{AddressKey: "unix:///plugin.sock", TimeoutKey: "100ms", FailurePolicyKey: FailurePolicyFail}},
+		{name: "valid name", arguments: framework.Arguments{NameKey: "gpu", AddressKey: "unix:///gpu.sock"}},
+		{name: "missing address", arguments: framework.Arguments{}, wantErr: true},
+		{name: "empty name", arguments: framework.Arguments{NameKey: "", AddressKey: "127.0.0.1:9000"}, wantErr: true},
+		{name: "name with slash", arguments: framework.Arguments{NameKey: "gpu/a", AddressKey: "127.0.0.1:9000"}, wantErr: true},
+		{name: "invalid timeout", arguments: framework.Arguments{AddressKey: "127.0.0.1:9000", TimeoutKey: "0s"}, wantErr: true},
+		{name: "invalid failure policy", arguments: framework.Arguments{AddressKey: "127.0.0.1:9000", FailurePolicyKey: "Retry"}, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := ValidateArguments(test.arguments); (err != nil) != test.wantErr {
+				t.Errorf("expected error %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reservation
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	// PluginName indicates name of volcano scheduler plugin.
+	PluginName = "reservation"
+	// ReservationTimeout is the maximum duration the nodes are locked for the target job, the nodes are released
+	// when the target job is not ready in time, and the job is not elected again within the same duration.
+	// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	ReservationTimeout = "reservation.timeout"
+	// StarvationTime is the minimum duration a job waits since it is created before it can be elected as the target
+	// job, so that the nodes are not locked for the jobs which may be scheduled soon without reservation.
+	StarvationTime = "reservation.starvationTime"
+	// MinMember is the minimum number of the members of a job to be elected as the target job, the smaller jobs are
+	// less likely to starve and are not worth locking nodes for.
+	MinMember = "reservation.minMember"
+
+	defaultReservationTimeout = time.Hour
+	defaultStarvationTime     = 10 * time.Minute
+	defaultMinMember          = 1
+)
+
+type reservationPlugin struct {
+	// Arguments given for the plugin
+	pluginArguments framework.Arguments
+	timeout         time.Duration
+	starvationTime  time.Duration
+	minMember       int
+}
+
+// New return reservation plugin
+func New(arguments framework.Arguments) framework.Plugin {
+	rp := &reservationPlugin{
+		pluginArguments: arguments,
+		timeout:         defaultReservationTimeout,
+		starvationTime:  defaultStarvationTime,
+		minMember:       defaultMinMember,
+	}
+	if argv, ok := arguments[ReservationTimeout]; ok {
+		value, _ := argv.(string)
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			klog.Warningf("Invalid input %v for %s, using default value: %v.", argv, ReservationTimeout, defaultReservationTimeout)
+		} else {
+			rp.timeout = timeout
+		}
+	}
+	if argv, ok := arguments[StarvationTime]; ok {
+		value, _ := argv.(string)
+		starvationTime, err := time.ParseDuration(value)
+		if err != nil || starvationTime < 0 {
+			klog.Warningf("Invalid input %v for %s, using default value: %v.", argv, StarvationTime, defaultStarvationTime)
+		} else {
+			rp.starvationTime = starvationTime
+		}
+	}
+	arguments.GetInt(&rp.minMember, MinMember)
+	if rp.minMember < 1 {
+		klog.Warningf("Invalid input %v for %s, using default value: %v.", rp.minMember, MinMember, defaultMinMember)
+		rp.minMember = defaultMinMember
+	}
+	return rp
+}
+
+// ValidateArguments validates the arguments of reservation plugin.
+func ValidateArguments(arguments framework.Arguments) error {
+	if argv, ok := arguments[ReservationTimeout]; ok {
+		value, _ := argv.(string)
+		if timeout, err := time.ParseDuration(value); err != nil || timeout <= 0 {
+			return fmt.Errorf("argument %s must be a positive duration, got %v", ReservationTimeout, argv)
+		}
+	}
+	if argv, ok := arguments[StarvationTime]; ok {
+		value, _ := argv.(string)
+		if starvationTime, err := time.ParseDuration(value); err != nil || starvationTime < 0 {
+			return fmt.Errorf("argument %s must be a non-negative duration, got %v", StarvationTime, argv)
+		}
+	}
+	if _, ok := arguments[MinMember]; ok {
+		minMember := 0
+		arguments.GetInt(&minMember, MinMember)
+		if minMember < 1 {
+			return fmt.Errorf("argument %s must be a positive integer, got %v", MinMember, arguments[MinMember])
+		}
+	}
+	return nil
+}
+
+func (rp *reservationPlugin) Name() string {
+	return PluginName
+}
+
+/*
+User should enable the reserve action and the reservation plugin together as format below:
+
+actions: "reserve, enqueue, allocate, backfill"
+tiers:
+- plugins:
+  - name: reservation
+    arguments:
+    reservation.timeout: 2h
+    reservation.starvationTime: 10m
+    reservation.minMember: 2
+*/
+func (rp *reservationPlugin) OnSessionOpen(ssn *framework.Session) {
+	// elect the starving job with the highest priority which waits for the longest time
+	ssn.AddTargetJobFn(rp.Name(), func(jobs []*api.JobInfo) *api.JobInfo {
+		return rp.electTargetJob(jobs, time.Now())
+	})
+
+	// lock the nodes for the target job as they free up, until the locked nodes can hold the job
+	ssn.AddReservedNodesFn(rp.Name(), func() {
+		rp.lockNodes(ssn, time.Now())
+	})
+}
+
+func (rp *reservationPlugin) OnSessionClose(ssn *framework.Session) {}
+
+// electTargetJob returns the starving job with the highest priority which waits for the longest time, the jobs whose
+// reservation timed out recently are skipped.
+func (rp *reservationPlugin) electTargetJob(jobs []*api.JobInfo, now time.Time) *api.JobInfo {
+	var target *api.JobInfo
+	for _, job := range jobs {
+		if !rp.isStarving(job, now) {
+			continue
+		}
+		if util.Reservation.Expired(job.UID, rp.timeout, now) {
+			klog.V(4).Infof("Reservation for job <%s/%s> timed out recently, skip it.", job.Namespace, job.Name)
+			continue
+		}
+		if target == nil || job.Priority > target.Priority ||
+			(job.Priority == target.Priority && job.CreationTimestamp.Before(&target.CreationTimestamp)) {
+			target = job
+		}
+	}
+	return target
+}
+
+// isStarving checks whether the job is large enough and has waited long enough to lock nodes for.
+func (rp *reservationPlugin) isStarving(job *api.JobInfo, now time.Time) bool {
+	if int(job.MinAvailable) < rp.minMember {
+		klog.V(4).Infof("Job <%s/%s> has %d min members less than %d, skip reservation.",
+			job.Namespace, job.Name, job.MinAvailable, rp.minMember)
+		return false
+	}
+	if waiting := now.Sub(job.CreationTimestamp.Time); waiting < rp.starvationTime {
+		klog.V(4).Infof("Job <%s/%s> has waited for %v less than %v, skip reservation.",
+			job.Namespace, job.Name, waiting, rp.starvationTime)
+		return false
+	}
+	return true
+}
+
+// lockNodes releases the reservation on timeout, otherwise locks the unlocked nodes which can hold at least one
+// pending task of the target job, the nodes holding more tasks are locked first.
+func (rp *reservationPlugin) lockNodes(ssn *framework.Session, now time.Time) {
+	job, found := ssn.Jobs[util.Reservation.TargetJob()]
+	if !found {
+		return
+	}
+
+	if waiting := now.Sub(util.Reservation.ElectedTime()); waiting > rp.timeout {
+		klog.V(3).Infof("Reservation for job <%s/%s> timed out after %v, release the locked nodes.",
+			job.Namespace, job.Name, waiting)
+		util.Reservation.Release(true, now)
+		return
+	}
+
+	tasks := pendingTasks(ssn, job)
+	request := api.EmptyResource()
+	for _, task := range tasks {
+		request.Add(task.InitResreq)
+	}
+
+	lockedNodes := util.Reservation.LockedNodes()
+	lockedIdle := api.EmptyResource()
+	for name := range lockedNodes {
+		if node, found := ssn.Nodes[name]; found {
+			lockedIdle.Add(node.FutureIdle())
+		}
+	}
+
+	taskNums := map[string]int{}
+	var candidates []*api.NodeInfo
+	for _, node := range ssn.NodeList {
+		if lockedNodes.Has(node.Name) || !node.Ready() {
+			continue
+		}
+		if num := fitTaskNum(ssn, tasks, node); num > 0 {
+			taskNums[node.Name] = num
+			candidates = append(candidates, node)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return taskNums[candidates[i].Name] > taskNums[candidates[j].Name]
+	})
+
+	for _, node := range candidates {
+		if request.LessEqual(lockedIdle, api.Zero) {
+			break
+		}
+		klog.V(3).Infof("Lock node <%s> for job <%s/%s>.", node.Name, job.Namespace, job.Name)
+		util.Reservation.LockNode(node.Name)
+		lockedIdle.Add(node.FutureIdle())
+	}
+}
+
+// pendingTasks returns the pending tasks which are needed for the job to be ready.
+func pendingTasks(ssn *framework.Session, job *api.JobInfo) []*api.TaskInfo {
+	var tasks []*api.TaskInfo
+	for _, task := range job.TaskStatusIndex[api.Pending] {
+		if task.BestEffort || task.SchGated {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return ssn.TaskOrderFn(tasks[i], tasks[j])
+	})
+
+	if needed := int(job.MinAvailable - job.ReadyTaskNum() - job.WaitingTaskNum()); needed > 0 && needed < len(tasks) {
+		tasks = tasks[:needed]
+	}
+	return tasks
+}
+
+// fitTaskNum returns the number of tasks the node can hold with its future idle resources.
+func fitTaskNum(ssn *framework.Session, tasks []*api.TaskInfo, node *api.NodeInfo) int {
+	idle := node.FutureIdle()
+	num := 0
+	for _, task := range tasks {
+		if !task.InitResreq.LessEqual(idle, api.Zero) {
+			continue
+		}
+		if err := ssn.PrePredicateFn(task); err != nil {
+			continue
+		}
+		if err := ssn.PredicateForPreemptAction(task, node); err != nil {
+			continue
+		}
+		idle.Sub(task.InitResreq)
+		num++
+	}
+	return num
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reservation
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/plugins/predicates"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestMain(m *testing.M) {
+	options.Default()
+	os.Exit(m.Run())
+}
+
+func TestValidateArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments framework.Arguments
+		wantErr   bool
+	}{
+		{name: "no arguments", arguments: framework.Arguments{}},
+		{name: "valid arguments", arguments: framework.Arguments{ReservationTimeout: "2h", StarvationTime: "0s", MinMember: 2}},
+		{name: "invalid timeout", arguments: framework.Arguments{ReservationTimeout: "0s"}, wantErr: true},
+		{name: "invalid starvation time", arguments: framework.Arguments{StarvationTime: "-1m"}, wantErr: true},
+		{name: "invalid min member", arguments: framework.Arguments{MinMember: 0}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := ValidateArguments(test.arguments); (err != nil) != test.wantErr {
+				t.Errorf("expected error %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	rp := New(framework.Arguments{ReservationTimeout: "2h", StarvationTime: "30m", MinMember: 4}).(*reservationPlugin)
+	if rp.timeout != 2*time.Hour || rp.starvationTime != 30*time.Minute || rp.minMember != 4 {
+		t.Errorf("unexpected plugin %+v", rp)
+	}
+
+	rp = New(framework.Arguments{ReservationTimeout: "invalid", StarvationTime: "invalid", MinMember: 0}).(*reservationPlugin)
+	if rp.timeout != defaultReservationTimeout || rp.starvationTime != defaultStarvationTime || rp.minMember != defaultMinMember {
+		t.Errorf("expected default arguments for invalid inputs, got %+v", rp)
+	}
+}
+
+func TestElectTargetJob(t *testing.T) {
+	now := time.Now()
+	buildJob := func(name string, minAvailable, priority int32, waiting time.Duration) *api.JobInfo {
+		return &api.JobInfo{
+			UID:               api.JobID("c1/" + name),
+			Namespace:         "c1",
+			Name:              name,
+			MinAvailable:      minAvailable,
+			Priority:          priority,
+			CreationTimestamp: metav1.NewTime(now.Add(-waiting)),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		jobs        []*api.JobInfo
+		expiredJobs []api.JobID
+		expected    api.JobID
+	}{
+		{
+			name: "elect the longest waiting job",
+			jobs: []*api.JobInfo{
+				buildJob("pg1", 2, 0, 20*time.Minute),
+				buildJob("pg2", 2, 0, 30*time.Minute),
+			},
+			expected: "c1/pg2",
+		},
+		{
+			name: "elect the job with the highest priority",
+			jobs: []*api.JobInfo{
+				buildJob("pg1", 2, 10, 20*time.Minute),
+				buildJob("pg2", 2, 0, 30*time.Minute),
+			},
+			expected: "c1/pg1",
+		},
+		{
+			name: "skip the job not starving long enough",
+			jobs: []*api.JobInfo{
+				buildJob("pg1", 2, 10, 5*time.Minute),
+				buildJob("pg2", 2, 0, 30*time.Minute),
+			},
+			expected: "c1/pg2",
+		},
+		{
+			name: "skip the job with less members than the threshold",
+			jobs: []*api.JobInfo{
+				buildJob("pg1", 1, 10, time.Hour),
+				buildJob("pg2", 2, 0, 30*time.Minute),
+			},
+			expected: "c1/pg2",
+		},
+		{
+			name: "skip the job whose reservation timed out recently",
+			jobs: []*api.JobInfo{
+				buildJob("pg1", 2, 0, time.Hour),
+				buildJob("pg2", 2, 0, 30*time.Minute),
+			},
+			expiredJobs: []api.JobID{"c1/pg1"},
+			expected:    "c1/pg2",
+		},
+		{
+			name: "elect no job if none is starving",
+			jobs: []*api.JobInfo{
+				buildJob("pg1", 1, 0, time.Hour),
+				buildJob("pg2", 2, 0, time.Minute),
+			},
+			expected: "",
+		},
+	}
+
+	rp := New(framework.Arguments{StarvationTime: "10m", MinMember: 2}).(*reservationPlugin)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			util.Reservation = util.NewResourceReservation()
+			defer func() { util.Reservation = util.NewResourceReservation() }()
+			for _, uid := range test.expiredJobs {
+				util.Reservation.SetTargetJob(&api.JobInfo{UID: uid}, now)
+				util.Reservation.Release(true, now)
+			}
+
+			var elected api.JobID
+			if target := rp.electTargetJob(test.jobs, now); target != nil {
+				elected = target.UID
+			}
+			if elected != test.expected {
+				t.Errorf("expected target job %q, got %q", test.expected, elected)
+			}
+		})
+	}
+}
+
+func TestLockNodes(t *testing.T) {
+	plugins := map[string]framework.PluginBuilder{
+		predicates.PluginName: predicates.New,
+	}
+	trueValue := true
+	tiers := []conf.Tier{
+		{
+			Plugins: []conf.PluginOption{
+				{
+					Name:             predicates.PluginName,
+					EnabledPredicate: &trueValue,
+				},
+			},
+		},
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name           string
+		selector       map[string]string
+		electedTime    time.Time
+		expectedTarget api.JobID
+		expectedLocked sets.Set[string]
+	}{
+		{
+			name:           "lock the nodes which can hold the pending tasks",
+			electedTime:    now,
+			expectedTarget: "c1/pg-big",
+			expectedLocked: sets.New("n2", "n3"),
+		},
+		{
+			name:           "skip the nodes failing the predicates",
+			selector:       map[string]string{"zone": "a"},
+			electedTime:    now,
+			expectedTarget: "c1/pg-big",
+			expectedLocked: sets.New("n2"),
+		},
+		{
+			name:           "release the locked nodes on timeout",
+			electedTime:    now.Add(-2 * time.Hour),
+			expectedTarget: "",
+			expectedLocked: sets.New[string](),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// n1 has 2 cpu left, n2 and n3 are free, so only n2 and n3 can hold the 4 cpu tasks
+			testStruct := uthelper.TestCommonStruct{
+				Name:    test.name,
+				Plugins: plugins,
+				Nodes: []*v1.Node{
+					util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), map[string]string{"zone": "a"}),
+					util.BuildNode("n2", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), map[string]string{"zone": "a"}),
+					util.BuildNode("n3", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), map[string]string{"zone": "b"}),
+				},
+				PodGroups: []*schedulingv1.PodGroup{
+					util.BuildPodGroup("pg-running", "c1", "c1", 1, nil, schedulingv1.PodGroupRunning),
+					util.BuildPodGroup("pg-big", "c1", "c1", 2, nil, schedulingv1.PodGroupInqueue),
+				},
+				Pods: []*v1.Pod{
+					util.BuildPod("c1", "running", "n1", v1.PodRunning, api.BuildResourceList("2", "2G"), "pg-running", make(map[string]string), make(map[string]string)),
+					util.BuildPod("c1", "big-1", "", v1.PodPending, api.BuildResourceList("4", "4G"), "pg-big", make(map[string]string), test.selector),
+					util.BuildPod("c1", "big-2", "", v1.PodPending, api.BuildResourceList("4", "4G"), "pg-big", make(map[string]string), test.selector),
+				},
+				Queues: []*schedulingv1.Queue{util.BuildQueue("c1", 1, nil)},
+			}
+			ssn := testStruct.RegisterSession(tiers, nil)
+			defer testStruct.Close()
+
+			util.Reservation = util.NewResourceReservation()
+			defer func() { util.Reservation = util.NewResourceReservation() }()
+			util.Reservation.SetTargetJob(ssn.Jobs["c1/pg-big"], test.electedTime)
+
+			rp := New(framework.Arguments{ReservationTimeout: "1h"}).(*reservationPlugin)
+			rp.lockNodes(ssn, now)
+
+			if targetJob := util.Reservation.TargetJob(); targetJob != test.expectedTarget {
+				t.Errorf("expected target job %q, got %q", test.expectedTarget, targetJob)
+			}
+			if lockedNodes := util.Reservation.LockedNodes(); !lockedNodes.Equal(test.expectedLocked) {
+				t.Errorf("expected locked nodes %v, got %v", sets.List(test.expectedLocked), sets.List(lockedNodes))
+			}
+		})
+	}
+}
@@ -97,6 +97,21 @@ func New(arguments framework.Arguments) framework.Plugin {
 	return &rsf
 }
 
+// ValidateArguments validates the arguments of the resource-strategy-fit plugin, so that they can be converted to the
+// expected types when the plugin is built.
+func ValidateArguments(args framework.Arguments) error {
+	if err := framework.Validate[int](args, "resourceStrategyFitWeight"); err != nil {
+		return err
+	}
+	if err := framework.Validate[map[v1.ResourceName]ResourcesType](args, "resources"); err != nil {
+		return err
+	}
+	if err := framework.Validate[proportionalConfig](args, "proportional"); err != nil {
+		return err
+	}
+	return framework.Validate[sraConfig](args, "sra")
+}
+
 func calculateWeight(args framework.Arguments) ResourceStrategyFit {
 	/*
 		actions: "enqueue, allocate, backfill, reclaim, preempt"
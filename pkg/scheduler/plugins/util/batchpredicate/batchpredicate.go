@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package batchpredicate caches the results of the predicates which filter all the nodes of the session for a task by
+// a single call, e.g. to an out-of-process plugin, while the session calls the predicates node by node.
+package batchpredicate
+
+import (
+	"sync"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// Cache keeps the batched predicate results of the tasks in a session, the nodes are filtered once per task until
+// the cache is invalidated by a change of the node state, e.g. a task allocated or deallocated on a node.
+type Cache[T any] struct {
+	lock    sync.Mutex
+	results map[api.TaskID]*result[T]
+}
+
+type result[T any] struct {
+	once  sync.Once
+	value T
+	err   error
+}
+
+// NewCache creates an empty cache, which is expected to live no longer than the session.
+func NewCache[T any]() *Cache[T] {
+	return &Cache[T]{results: map[api.TaskID]*result[T]{}}
+}
+
+// Get returns the result of the task, which is filtered by the filter on the first call for the task and reused by
+// the following calls, including the concurrent ones. The failed result is only shared by the concurrent calls, and
+// the following calls filter the nodes again, as the failure may be transient.
+func (c *Cache[T]) Get(task *api.TaskInfo, filter func() (T, error)) (T, error) {
+	c.lock.Lock()
+	r, found := c.results[task.UID]
+	if !found {
+		r = &result[T]{}
+		c.results[task.UID] = r
+	}
+	c.lock.Unlock()
+
+	r.once.Do(func() {
+		r.value, r.err = filter()
+		if r.err != nil {
+			c.forget(task.UID, r)
+		}
+	})
+	return r.value, r.err
+}
+
+// Invalidate drops the results of all the tasks, it is called once the node state changes in the session, so that
+// the nodes are filtered against the latest state.
+func (c *Cache[T]) Invalidate() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.results = map[api.TaskID]*result[T]{}
+}
+
+// forget drops the result of the task unless it has been replaced already.
+func (c *Cache[T]) forget(uid api.TaskID, r *result[T]) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.results[uid] == r {
+		delete(c.results, uid)
+	}
+}
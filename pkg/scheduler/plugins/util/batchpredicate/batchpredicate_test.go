@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batchpredicate
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestCache(t *testing.T) {
+	cache := NewCache[map[string]bool]()
+	task1, task2 := &api.TaskInfo{UID: "task1"}, &api.TaskInfo{UID: "task2"}
+
+	var calls int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := cache.Get(task1, func() (map[string]bool, error) {
+				atomic.AddInt32(&calls, 1)
+				return map[string]bool{"n1": true}, nil
+			})
+			if err != nil || !value["n1"] {
+				t.Errorf("unexpected result %v, %v", value, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("expected the nodes to be filtered once for task1, got %d calls", calls)
+	}
+
+	failure := errors.New("failure")
+	for i := 0; i < 2; i++ {
+		if _, err := cache.Get(task2, func() (map[string]bool, error) {
+			atomic.AddInt32(&calls, 1)
+			return nil, failure
+		}); !errors.Is(err, failure) {
+			t.Errorf("expected the failure of task2, got %v", err)
+		}
+	}
+	if calls != 3 {
+		t.Errorf("expected the failed result of task2 not to be reused, got %d calls", calls)
+	}
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	cache := NewCache[int]()
+	task := &api.TaskInfo{UID: "task1"}
+
+	calls := 0
+	filter := func() (int, error) {
+		calls++
+		return calls, nil
+	}
+	if value, _ := cache.Get(task, filter); value != 1 {
+		t.Errorf("expected the first result, got %d", value)
+	}
+	if value, _ := cache.Get(task, filter); value != 1 {
+		t.Errorf("expected the cached result, got %d", value)
+	}
+	cache.Invalidate()
+	if value, _ := cache.Get(task, filter); value != 2 {
+		t.Errorf("expected the nodes to be filtered again after invalidation, got %d", value)
+	}
+}
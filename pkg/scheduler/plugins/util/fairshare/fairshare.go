@@ -0,0 +1,361 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fairshare accounts the historical resource usage of the queues with exponential decay, so that the queue
+// plugins can balance the share of the queues over time rather than only by the current snapshot.
+package fairshare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+)
+
+const (
+	// EnableKey is the plugin argument whether the share of the queues considers their decayed historical usage
+	EnableKey = "fairShare.enable"
+	// HalfLifeKey is the plugin argument of the half-life of the historical usage, e.g. "168h"
+	HalfLifeKey = "fairShare.halfLife"
+	// WeightKey is the plugin argument of how much the historical usage affects the share of the queues, from 0 to 1
+	WeightKey = "fairShare.weight"
+	// ConfigMapKey is the plugin argument of the name of the ConfigMap persisting the historical usage, in the
+	// namespace of the scheduler
+	ConfigMapKey = "fairShare.configMap"
+
+	defaultHalfLife  = 7 * 24 * time.Hour
+	defaultWeight    = 0.5
+	defaultConfigMap = "volcano-scheduler-fair-share"
+
+	// usageDataKey is the key of the historical usage in the data of the ConfigMap
+	usageDataKey = "usage.json"
+	// persistInterval is the minimum interval between two updates of the ConfigMap
+	persistInterval = time.Minute
+	// minUsageFactor and maxUsageFactor bound the usage factor of the queues
+	minUsageFactor = 0.1
+	maxUsageFactor = 10
+)
+
+// Config is the fair-share configuration of a queue plugin.
+type Config struct {
+	Enabled   bool
+	HalfLife  time.Duration
+	Weight    float64
+	ConfigMap string
+}
+
+// NewConfig parses the fair-share configuration from the arguments of the plugin.
+func NewConfig(arguments framework.Arguments) *Config {
+	config := &Config{HalfLife: defaultHalfLife, Weight: defaultWeight, ConfigMap: defaultConfigMap}
+	arguments.GetBool(&config.Enabled, EnableKey)
+	arguments.GetFloat64(&config.Weight, WeightKey)
+	arguments.GetString(&config.ConfigMap, ConfigMapKey)
+	var halfLife string
+	arguments.GetString(&halfLife, HalfLifeKey)
+	if duration, err := time.ParseDuration(halfLife); err == nil && duration > 0 {
+		config.HalfLife = duration
+	}
+	config.Weight = math.Min(math.Max(config.Weight, 0), 1)
+	return config
+}
+
+// ValidateArguments validates the fair-share arguments of the plugin.
+func ValidateArguments(arguments framework.Arguments) error {
+	if argv, found := arguments[HalfLifeKey]; found {
+		value, _ := argv.(string)
+		if duration, err := time.ParseDuration(value); err != nil || duration <= 0 {
+			return fmt.Errorf("argument %s must be a positive duration, got %v", HalfLifeKey, argv)
+		}
+	}
+	if _, found := arguments[WeightKey]; found {
+		var weight float64 = -1
+		arguments.GetFloat64(&weight, WeightKey)
+		if weight < 0 || weight > 1 {
+			return fmt.Errorf("argument %s must be a number from 0 to 1, got %v", WeightKey, arguments[WeightKey])
+		}
+	}
+	return nil
+}
+
+// usageRecord is the persisted historical usage of the queues.
+type usageRecord struct {
+	LastUpdate time.Time                 `json:"lastUpdate"`
+	Queues     map[string]*resourceUsage `json:"queues"`
+}
+
+// resourceUsage is the persisted decayed resource-seconds of a queue.
+type resourceUsage struct {
+	MilliCPU float64                     `json:"milliCPU,omitempty"`
+	Memory   float64                     `json:"memory,omitempty"`
+	Scalars  map[v1.ResourceName]float64 `json:"scalars,omitempty"`
+}
+
+// Tracker accumulates the resource-seconds consumed by the queues, which decay by half in every half-life.
+type Tracker struct {
+	sync.Mutex
+
+	// usage is the decayed resource-seconds of the queues keyed by queue name
+	usage       map[string]*api.Resource
+	lastUpdate  time.Time
+	lastPersist time.Time
+	loaded      bool
+}
+
+// Usage is the tracker shared by the queue plugins of the scheduler.
+var Usage = NewTracker()
+
+// NewTracker creates a tracker without historical usage.
+func NewTracker() *Tracker {
+	return &Tracker{usage: map[string]*api.Resource{}}
+}
+
+// UsageFactors accounts the resources allocated to the queues in the session since the last update, and returns the
+// usage factor of the queues with the targets, e.g. their weights or the shares of their deserved resources, which are
+// normalized by their sum: 1 means the queue consumed its target share historically, while a greater one means it
+// consumed more. The factor is 1 for all the queues if fair-share is disabled.
+func (t *Tracker) UsageFactors(ssn *framework.Session, config *Config, targets map[api.QueueID]float64) map[api.QueueID]float64 {
+	factors := make(map[api.QueueID]float64, len(targets))
+	for queueID := range targets {
+		factors[queueID] = 1
+	}
+	if !config.Enabled {
+		return factors
+	}
+
+	allocated := map[string]*api.Resource{}
+	for _, job := range ssn.Jobs {
+		queue, found := ssn.Queues[job.Queue]
+		if !found {
+			continue
+		}
+		if _, found := allocated[queue.Name]; !found {
+			allocated[queue.Name] = api.EmptyResource()
+		}
+		for status, tasks := range job.TaskStatusIndex {
+			if !api.AllocatedStatus(status) {
+				continue
+			}
+			for _, task := range tasks {
+				allocated[queue.Name].Add(task.Resreq)
+			}
+		}
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	t.load(ssn.KubeClient(), config)
+	now := time.Now()
+	t.update(allocated, config.HalfLife, now)
+	if now.Sub(t.lastPersist) >= persistInterval {
+		t.lastPersist = now
+		go persist(ssn.KubeClient(), config.ConfigMap, t.record())
+	}
+
+	totalTarget := 0.0
+	for _, target := range targets {
+		if target > 0 {
+			totalTarget += target
+		}
+	}
+	total := api.EmptyResource()
+	for _, usage := range t.usage {
+		total.Add(usage)
+	}
+	if totalTarget <= 0 || total.IsEmpty() {
+		return factors
+	}
+
+	for queueID, target := range targets {
+		if target <= 0 {
+			continue
+		}
+		queue, found := ssn.Queues[queueID]
+		if !found {
+			continue
+		}
+		ratio := 0.0
+		if usage, found := t.usage[queue.Name]; found {
+			ratio = DominantShare(usage, total) / (target / totalTarget)
+		}
+		factor := 1 + config.Weight*(ratio-1)
+		factors[queueID] = math.Min(math.Max(factor, minUsageFactor), maxUsageFactor)
+		klog.V(4).Infof("Queue <%s> usage ratio <%0.2f>, usage factor <%0.2f>.", queue.Name, ratio, factors[queueID])
+	}
+	return factors
+}
+
+// Deserved returns the deserved resource scaled down by the usage factor, so that the queue which consumed more than
+// its target share historically deserves less, and vice versa. The scaled resource is capped by the real capability
+// if it is set, and the deserved resource itself is not modified.
+func Deserved(deserved, realCapability *api.Resource, usageFactor float64) *api.Resource {
+	if usageFactor == 1 || usageFactor <= 0 {
+		return deserved
+	}
+	scaled := deserved.Clone().Multi(1 / usageFactor)
+	if realCapability != nil {
+		scaled.MinDimensionResource(realCapability, api.Infinity)
+	}
+	return scaled
+}
+
+// CompareUsageFactor orders the queue with the larger usage factor first, that is the queue which consumed more
+// resources historically, so that its victims are reclaimed first.
+func CompareUsageFactor(lFactor, rFactor float64) int {
+	if lFactor == rFactor {
+		return 0
+	}
+	if lFactor > rFactor {
+		return -1
+	}
+	return 1
+}
+
+// update decays the historical usage since the last update, and accumulates the usage of the allocated resources.
+func (t *Tracker) update(allocated map[string]*api.Resource, halfLife time.Duration, now time.Time) {
+	if t.lastUpdate.IsZero() || now.Before(t.lastUpdate) {
+		t.lastUpdate = now
+	}
+	elapsed := now.Sub(t.lastUpdate)
+	t.lastUpdate = now
+	if elapsed <= 0 {
+		return
+	}
+
+	decay := math.Pow(0.5, elapsed.Seconds()/halfLife.Seconds())
+	for name, usage := range t.usage {
+		usage.Multi(decay)
+		if usage.IsEmpty() {
+			delete(t.usage, name)
+		}
+	}
+	for name, resource := range allocated {
+		if resource.IsEmpty() {
+			continue
+		}
+		if _, found := t.usage[name]; !found {
+			t.usage[name] = api.EmptyResource()
+		}
+		t.usage[name].Add(resource.Clone().Multi(elapsed.Seconds()))
+	}
+}
+
+// DominantShare returns the largest share of the usage among the resource dimensions of the total.
+func DominantShare(usage, total *api.Resource) float64 {
+	share := 0.0
+	for _, name := range total.ResourceNames().FilteredIgnoredScalarResources() {
+		if value := total.Get(name); value > 0 {
+			share = math.Max(share, usage.Get(name)/value)
+		}
+	}
+	return share
+}
+
+// load restores the historical usage from the ConfigMap once, the usage is accounted from scratch if it is not found.
+func (t *Tracker) load(kubeClient kubernetes.Interface, config *Config) {
+	if t.loaded {
+		return
+	}
+	t.loaded = true
+
+	namespace := schedulerNamespace()
+	if kubeClient == nil || namespace == "" {
+		return
+	}
+	cm, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(context.TODO(), config.ConfigMap, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.Warningf("Failed to load the historical usage of the queues from ConfigMap <%s/%s>: %v", namespace, config.ConfigMap, err)
+		}
+		return
+	}
+
+	record := &usageRecord{}
+	if err := json.Unmarshal([]byte(cm.Data[usageDataKey]), record); err != nil {
+		klog.Warningf("Failed to parse the historical usage of the queues in ConfigMap <%s/%s>: %v", namespace, config.ConfigMap, err)
+		return
+	}
+	for name, usage := range record.Queues {
+		if usage != nil {
+			t.usage[name] = &api.Resource{MilliCPU: usage.MilliCPU, Memory: usage.Memory, ScalarResources: usage.Scalars}
+		}
+	}
+	t.lastUpdate = record.LastUpdate
+	t.lastPersist = time.Now()
+	klog.V(3).Infof("Loaded the historical usage of <%d> queues updated at %v.", len(t.usage), record.LastUpdate)
+}
+
+// record returns a copy of the historical usage to be persisted.
+func (t *Tracker) record() *usageRecord {
+	record := &usageRecord{LastUpdate: t.lastUpdate, Queues: map[string]*resourceUsage{}}
+	for name, usage := range t.usage {
+		usage = usage.Clone()
+		record.Queues[name] = &resourceUsage{MilliCPU: usage.MilliCPU, Memory: usage.Memory, Scalars: usage.ScalarResources}
+	}
+	return record
+}
+
+// persist saves the historical usage in the ConfigMap in the namespace of the scheduler.
+func persist(kubeClient kubernetes.Interface, name string, record *usageRecord) {
+	namespace := schedulerNamespace()
+	if kubeClient == nil || namespace == "" {
+		return
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		klog.Errorf("Failed to marshal the historical usage of the queues: %v", err)
+		return
+	}
+
+	cms := kubeClient.CoreV1().ConfigMaps(namespace)
+	cm, err := cms.Get(context.TODO(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		cm = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Data:       map[string]string{usageDataKey: string(data)},
+		}
+		_, err = cms.Create(context.TODO(), cm, metav1.CreateOptions{})
+	} else if err == nil {
+		cm = cm.DeepCopy()
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[usageDataKey] = string(data)
+		_, err = cms.Update(context.TODO(), cm, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.Errorf("Failed to persist the historical usage of the queues in ConfigMap <%s/%s>: %v", namespace, name, err)
+	}
+}
+
+// schedulerNamespace returns the namespace of the scheduler, the historical usage is not persisted if it is unknown.
+func schedulerNamespace() string {
+	if options.ServerOpts == nil {
+		return ""
+	}
+	return options.ServerOpts.KubePodNamespace
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fairshare
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	schedulingv1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	"volcano.sh/volcano/cmd/scheduler/app/options"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/uthelper"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+func TestValidateArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments framework.Arguments
+		wantErr   bool
+	}{
+		{name: "empty arguments", arguments: framework.Arguments{}},
+		{name: "valid arguments", arguments: framework.Arguments{HalfLifeKey: "24h", WeightKey: 0.3}},
+		{name: "invalid half-life", arguments: framework.Arguments{HalfLifeKey: "-1h"}, wantErr: true},
+		{name: "weight out of range", arguments: framework.Arguments{WeightKey: 1.5}, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := ValidateArguments(test.arguments); (err != nil) != test.wantErr {
+				t.Errorf("expected error %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	now := time.Now()
+	tracker := NewTracker()
+	tracker.usage["q1"] = &api.Resource{MilliCPU: 1000}
+	tracker.lastUpdate = now.Add(-time.Hour)
+
+	tracker.update(map[string]*api.Resource{"q2": {MilliCPU: 2}}, time.Hour, now)
+	if usage := tracker.usage["q1"].MilliCPU; usage != 500 {
+		t.Errorf("expected usage of q1 to decay by half in a half-life, got %v", usage)
+	}
+	if usage := tracker.usage["q2"].MilliCPU; usage != 7200 {
+		t.Errorf("expected usage of q2 to be accumulated for an hour, got %v", usage)
+	}
+}
+
+func TestUsageFactors(t *testing.T) {
+	test := uthelper.TestCommonStruct{
+		Name: "the queue consumed more than its target share has a greater factor",
+		Nodes: []*v1.Node{
+			util.BuildNode("n1", api.BuildResourceList("4", "4G", []api.ScalarResource{{Name: "pods", Value: "10"}}...), make(map[string]string)),
+		},
+		PodGroups: []*schedulingv1.PodGroup{
+			util.BuildPodGroup("pg1", "c1", "q1", 1, nil, schedulingv1.PodGroupRunning),
+		},
+		Pods: []*v1.Pod{
+			util.BuildPod("c1", "p1", "n1", v1.PodRunning, api.BuildResourceList("2", "2G"), "pg1", make(map[string]string), make(map[string]string)),
+		},
+		Queues: []*schedulingv1.Queue{
+			util.BuildQueue("q1", 1, nil),
+			util.BuildQueue("q2", 1, nil),
+		},
+	}
+	ssn := test.RegisterSession(nil, nil)
+	defer test.Close()
+
+	targets := map[api.QueueID]float64{"q1": 1, "q2": 1}
+	tracker := NewTracker()
+	tracker.loaded = true
+	tracker.lastUpdate = time.Now().Add(-time.Hour)
+
+	factors := tracker.UsageFactors(ssn, &Config{HalfLife: time.Hour}, targets)
+	if factors["q1"] != 1 || factors["q2"] != 1 {
+		t.Errorf("expected factors to be 1 if fair-share is disabled, got %v", factors)
+	}
+	if len(tracker.usage) != 0 {
+		t.Errorf("expected no usage accounted if fair-share is disabled, got %v", tracker.usage)
+	}
+
+	factors = tracker.UsageFactors(ssn, &Config{Enabled: true, HalfLife: time.Hour, Weight: 0.5}, targets)
+	if math.Abs(factors["q1"]-1.5) > 1e-6 || math.Abs(factors["q2"]-0.5) > 1e-6 {
+		t.Errorf("expected factors 1.5 of q1 and 0.5 of q2, got %v", factors)
+	}
+
+	// q1 deserves 3/4 of the cluster, so consuming all of the usage is 4/3 of its target share
+	factors = tracker.UsageFactors(ssn, &Config{Enabled: true, HalfLife: time.Hour, Weight: 0.5}, map[api.QueueID]float64{"q1": 0.75, "q2": 0.25})
+	if math.Abs(factors["q1"]-7.0/6) > 1e-6 || math.Abs(factors["q2"]-0.5) > 1e-6 {
+		t.Errorf("expected factors 7/6 of q1 and 0.5 of q2, got %v", factors)
+	}
+}
+
+func TestDeserved(t *testing.T) {
+	deserved := &api.Resource{MilliCPU: 4000, Memory: 4000}
+	tests := []struct {
+		name           string
+		realCapability *api.Resource
+		usageFactor    float64
+		expected       *api.Resource
+	}{
+		{name: "no usage history", usageFactor: 1, expected: deserved},
+		{name: "invalid factor", usageFactor: 0, expected: deserved},
+		{name: "over used", usageFactor: 2, expected: &api.Resource{MilliCPU: 2000, Memory: 2000}},
+		{name: "under used", usageFactor: 0.5, expected: &api.Resource{MilliCPU: 8000, Memory: 8000}},
+		{name: "under used capped by capability", realCapability: &api.Resource{MilliCPU: 6000, Memory: 10000}, usageFactor: 0.5, expected: &api.Resource{MilliCPU: 6000, Memory: 8000}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Deserved(deserved, test.realCapability, test.usageFactor)
+			if !got.Equal(test.expected, api.Zero) {
+				t.Errorf("expected deserved %v, got %v", test.expected, got)
+			}
+		})
+	}
+	if deserved.MilliCPU != 4000 || deserved.Memory != 4000 {
+		t.Errorf("expected deserved not to be modified, got %v", deserved)
+	}
+}
+
+func TestCompareUsageFactor(t *testing.T) {
+	tests := []struct {
+		name             string
+		lFactor, rFactor float64
+		expected         int
+	}{
+		{name: "equal factors", lFactor: 1, rFactor: 1, expected: 0},
+		{name: "left consumed more", lFactor: 1.5, rFactor: 0.8, expected: -1},
+		{name: "right consumed more", lFactor: 0.8, rFactor: 1.5, expected: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := CompareUsageFactor(test.lFactor, test.rFactor); got != test.expected {
+				t.Errorf("expected %d, got %d", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestPersistAndLoad(t *testing.T) {
+	options.ServerOpts = options.NewServerOption()
+	options.ServerOpts.KubePodNamespace = "volcano-system"
+	defer func() { options.ServerOpts = nil }()
+
+	kubeClient := fake.NewSimpleClientset()
+	config := &Config{ConfigMap: defaultConfigMap}
+	lastUpdate := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+	tracker := NewTracker()
+	tracker.usage["q1"] = &api.Resource{MilliCPU: 1000, Memory: 2000, ScalarResources: map[v1.ResourceName]float64{"nvidia.com/gpu": 3000}}
+	tracker.lastUpdate = lastUpdate
+	// persist twice to both create and update the ConfigMap
+	persist(kubeClient, config.ConfigMap, tracker.record())
+	persist(kubeClient, config.ConfigMap, tracker.record())
+
+	loaded := NewTracker()
+	loaded.load(kubeClient, config)
+	usage, found := loaded.usage["q1"]
+	if !found || usage.MilliCPU != 1000 || usage.Memory != 2000 || usage.ScalarResources["nvidia.com/gpu"] != 3000 {
+		t.Fatalf("expected usage of q1 to be loaded, got %v", loaded.usage)
+	}
+	if !loaded.lastUpdate.Equal(lastUpdate) {
+		t.Errorf("expected last update %v, got %v", lastUpdate, loaded.lastUpdate)
+	}
+}
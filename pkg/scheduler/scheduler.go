@@ -22,6 +22,7 @@ limitations under the License.
 package scheduler
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -30,6 +31,8 @@ import (
 	"time"
 
 	"github.com/fsnotify/fsnotify"
+	"go.opentelemetry.io/otel/attribute"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/rest"
@@ -43,6 +46,7 @@ import (
 	"volcano.sh/volcano/pkg/scheduler/framework"
 	"volcano.sh/volcano/pkg/scheduler/gate"
 	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/tracing"
 )
 
 // Scheduler represents a "Volcano Scheduler".
@@ -55,6 +59,11 @@ type Scheduler struct {
 	schedulePeriod time.Duration
 	once           sync.Once
 
+	// eventDriven runs early scheduling cycles when the cache signals changes, see scheduleLoop
+	eventDriven         bool
+	triggerDebounce     time.Duration
+	minScheduleInterval time.Duration
+
 	mutex              sync.Mutex
 	actions            []framework.Action
 	plugins            []conf.Tier
@@ -62,6 +71,13 @@ type Scheduler struct {
 	metricsConf        map[string]string
 	dumper             schedcache.Dumper
 	disableDefaultConf bool
+	// confHash is the hash of the scheduler config in use, which is the last good one
+	confHash string
+	// releasePlugins is set once another scheduler config is loaded, the resources kept by the plugins of the former
+	// one are released before the next session
+	releasePlugins bool
+	// podRef refers to the pod of the scheduler, which the events of the scheduler config are recorded on
+	podRef *v1.ObjectReference
 
 	// schGateManager is used for async scheduling gate removal.
 	schGateManager *gate.SchGateManager
@@ -81,12 +97,23 @@ func NewScheduler(config *rest.Config, opt *options.ServerOption) (*Scheduler, e
 
 	cache := schedcache.New(config, opt.SchedulerNames, opt.DefaultQueue, opt.NodeSelector, opt.NodeWorkerThreads, opt.IgnoredCSIProvisioners, opt.ResyncPeriod, opt.ResourceSyncTimeout)
 	scheduler := &Scheduler{
-		schedulerConf:      opt.SchedulerConf,
-		fileWatcher:        watcher,
-		cache:              cache,
-		schedulePeriod:     opt.SchedulePeriod,
-		dumper:             schedcache.Dumper{Cache: cache, RootDir: opt.CacheDumpFileDir},
-		disableDefaultConf: opt.DisableDefaultSchedulerConfig,
+		schedulerConf:       opt.SchedulerConf,
+		fileWatcher:         watcher,
+		cache:               cache,
+		schedulePeriod:      opt.SchedulePeriod,
+		eventDriven:         opt.EnableEventDrivenScheduling,
+		triggerDebounce:     opt.ScheduleTriggerDebounce,
+		minScheduleInterval: opt.MinScheduleInterval,
+		dumper:              schedcache.Dumper{Cache: cache, RootDir: opt.CacheDumpFileDir},
+		disableDefaultConf:  opt.DisableDefaultSchedulerConfig,
+	}
+	if opt.KubePodName != "" && opt.KubePodNamespace != "" {
+		scheduler.podRef = &v1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Pod",
+			Namespace:  opt.KubePodNamespace,
+			Name:       opt.KubePodName,
+		}
 	}
 
 	return scheduler, nil
@@ -108,17 +135,56 @@ func (pc *Scheduler) Run(stopCh <-chan struct{}) {
 	}
 
 	go pc.watchSchedulerConf(stopCh)
+	go func() {
+		<-stopCh
+		framework.ReleasePlugins()
+	}()
 	// Start cache for policy.
 	pc.cache.SetMetricsConf(pc.metricsConf)
 	pc.cache.Run(stopCh)
 	klog.V(2).Infof("Scheduler completes Initialization and start to run")
-	go wait.Until(pc.runOnce, pc.schedulePeriod, stopCh)
+	if pc.eventDriven {
+		go scheduleLoop(pc.runOnce, pc.cache.ScheduleTrigger(), pc.schedulePeriod, pc.triggerDebounce, pc.minScheduleInterval, stopCh)
+	} else {
+		go wait.Until(pc.runOnce, pc.schedulePeriod, stopCh)
+	}
 	if options.ServerOpts.EnableCacheDumper {
 		pc.dumper.ListenForSignal(stopCh)
 	}
 	go runSchedulerSocket()
 }
 
+// scheduleLoop runs the scheduling cycles until stopCh is closed. A cycle runs once the period elapses since the
+// last one, or earlier when the trigger is signaled: the early cycle is delayed by the debounce to coalesce a burst
+// of changes, and runs no earlier than the minimum interval since the last one.
+func scheduleLoop(runOnce func(), trigger <-chan struct{}, period, debounce, minInterval time.Duration, stopCh <-chan struct{}) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	next := time.Now()
+	var lastEnd time.Time
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-trigger:
+			at := time.Now().Add(debounce)
+			if earliest := lastEnd.Add(minInterval); at.Before(earliest) {
+				at = earliest
+			}
+			if at.Before(next) {
+				klog.V(4).Infof("Scheduling cycle is triggered early in %v", time.Until(at))
+				next = at
+				timer.Reset(time.Until(at))
+			}
+		case <-timer.C:
+			runOnce()
+			lastEnd = time.Now()
+			next = lastEnd.Add(period)
+			timer.Reset(period)
+		}
+	}
+}
+
 // runOnce executes a single scheduling cycle. This function is called periodically
 // as defined by the Scheduler's schedule period.
 func (pc *Scheduler) runOnce() {
@@ -130,26 +196,52 @@ func (pc *Scheduler) runOnce() {
 	actions := pc.actions
 	plugins := pc.plugins
 	configurations := pc.configurations
+	releasePlugins := pc.releasePlugins
+	pc.releasePlugins = false
 	pc.mutex.Unlock()
 
+	if releasePlugins {
+		framework.ReleasePlugins()
+	}
+
 	// Load ConfigMap to check which action is enabled.
 	conf.EnabledActionMap = make(map[string]bool)
 	for _, action := range actions {
 		conf.EnabledActionMap[action.Name()] = true
 	}
 
+	ctx, cycleSpan := tracing.Tracer().Start(context.Background(), "scheduling-cycle")
+	_, openSpan := tracing.Tracer().Start(ctx, "open-session")
 	ssn := framework.OpenSession(pc.cache, plugins, configurations)
 	ssn.SetSchGateManager(pc.schGateManager)
+	// The nodes are only locked by the reserve action, release the reservation left over once the action is removed.
+	if !conf.EnabledActionMap["reserve"] {
+		ssn.ReleaseReservation()
+	}
+	openSpan.End()
+	cycleSpan.SetAttributes(
+		attribute.String("session.uid", string(ssn.UID)),
+		attribute.Int("session.jobs", len(ssn.Jobs)),
+		attribute.Int("session.nodes", len(ssn.Nodes)),
+		attribute.Int("session.queues", len(ssn.Queues)),
+	)
 	defer func() {
+		_, closeSpan := tracing.Tracer().Start(ctx, "close-session")
 		framework.CloseSession(ssn)
+		closeSpan.End()
+		cycleSpan.End()
 		metrics.UpdateE2eDuration(metrics.Duration(scheduleStartTime))
 	}()
 
 	for _, action := range actions {
 		actionStartTime := time.Now()
+		actionCtx, actionSpan := tracing.Tracer().Start(ctx, "action/"+action.Name())
+		ssn.SetTraceContext(actionCtx)
 		action.Execute(ssn)
+		actionSpan.End()
 		metrics.UpdateActionDuration(action.Name(), metrics.Duration(actionStartTime))
 	}
+	ssn.SetTraceContext(ctx)
 }
 
 func (pc *Scheduler) loadSchedulerConf() {
@@ -170,29 +262,41 @@ func (pc *Scheduler) loadSchedulerConf() {
 			if err != nil {
 				klog.Fatalf("Invalid default configuration: unmarshal Scheduler config %s failed: %v", DefaultSchedulerConf, err)
 			}
+			pc.setSchedulerConfHash(SchedulerConfHash(DefaultSchedulerConf))
 		})
 	}
 
-	var config string
-	if len(pc.schedulerConf) != 0 {
-		confData, err := os.ReadFile(pc.schedulerConf)
-		if err != nil {
-			if pc.disableDefaultConf {
-				klog.Fatalf("Failed to read scheduler config and default configuration fallback is disabled")
-			}
-			klog.Errorf("Failed to read the Scheduler config in '%s', using previous configuration: %v",
-				pc.schedulerConf, err)
-			return
+	if len(pc.schedulerConf) == 0 {
+		return
+	}
+
+	confData, err := os.ReadFile(pc.schedulerConf)
+	if err != nil {
+		if pc.disableDefaultConf && pc.confHash == "" {
+			klog.Fatalf("Failed to read scheduler config and default configuration fallback is disabled")
 		}
-		config = strings.TrimSpace(string(confData))
+		klog.Errorf("Failed to read the Scheduler config in '%s', using previous configuration: %v",
+			pc.schedulerConf, err)
+		pc.rollbackSchedulerConf("", err)
+		return
 	}
+	config := strings.TrimSpace(string(confData))
+	hash := SchedulerConfHash(config)
 
+	// The config is validated and its plugins are built as a dry run before it is applied, the last good config is
+	// kept in use if it is invalid.
+	if err := ValidateSchedulerConf(config); err != nil {
+		if pc.disableDefaultConf && pc.confHash == "" {
+			klog.Fatalf("Invalid scheduler configuration and default configuration fallback is disabled: %v", err)
+		}
+		klog.Errorf("Scheduler config %s is invalid: %v", config, err)
+		pc.rollbackSchedulerConf(hash, err)
+		return
+	}
 	actions, plugins, configurations, metricsConf, err := UnmarshalSchedulerConf(config)
 	if err != nil {
-		if pc.disableDefaultConf {
-			klog.Fatalf("Invalid scheduler configuration and default configuration fallback is disabled")
-		}
 		klog.Errorf("Scheduler config %s is invalid: %v", config, err)
+		pc.rollbackSchedulerConf(hash, err)
 		return
 	}
 
@@ -201,7 +305,39 @@ func (pc *Scheduler) loadSchedulerConf() {
 	pc.plugins = plugins
 	pc.configurations = configurations
 	pc.metricsConf = metricsConf
+	pc.releasePlugins = pc.releasePlugins || hash != pc.confHash
 	pc.mutex.Unlock()
+
+	metrics.RegisterSchedulerConfigReload(metrics.ConfigReloadSucceeded)
+	if hash != pc.confHash {
+		pc.setSchedulerConfHash(hash)
+		pc.recordSchedulerConfEvent(v1.EventTypeNormal, "SchedulerConfigLoaded", fmt.Sprintf("Loaded scheduler config %s", hash))
+	}
+}
+
+// setSchedulerConfHash records the hash of the scheduler config in use.
+func (pc *Scheduler) setSchedulerConfHash(hash string) {
+	klog.V(2).Infof("Scheduler config %s is in use", hash)
+	pc.confHash = hash
+	metrics.UpdateSchedulerConfigHash(hash)
+}
+
+// rollbackSchedulerConf keeps the last good scheduler config in use as the config failed to load.
+func (pc *Scheduler) rollbackSchedulerConf(hash string, err error) {
+	metrics.RegisterSchedulerConfigReload(metrics.ConfigReloadRolledBack)
+	msg := fmt.Sprintf("Failed to load scheduler config %s, rolled back to the last good config %s: %v", hash, pc.confHash, err)
+	if hash == "" {
+		msg = fmt.Sprintf("Failed to read scheduler config, rolled back to the last good config %s: %v", pc.confHash, err)
+	}
+	pc.recordSchedulerConfEvent(v1.EventTypeWarning, "SchedulerConfigRolledBack", msg)
+}
+
+// recordSchedulerConfEvent records the event of the scheduler config on the pod of the scheduler if it is known.
+func (pc *Scheduler) recordSchedulerConfEvent(eventType, reason, msg string) {
+	if pc.podRef == nil {
+		return
+	}
+	pc.cache.EventRecorder().Event(pc.podRef, eventType, reason, msg)
 }
 
 func (pc *Scheduler) getSchedulerConf() (actions []string, plugins []string) {
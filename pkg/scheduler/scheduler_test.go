@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	schedcache "volcano.sh/volcano/pkg/scheduler/cache"
+)
+
+func TestLoadSchedulerConfRollback(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "volcano-scheduler.conf")
+	recorder := record.NewFakeRecorder(10)
+	sched := &Scheduler{
+		cache:         schedcache.NewCustomMockSchedulerCache("volcano", nil, nil, nil, nil, recorder),
+		schedulerConf: confFile,
+		podRef:        &v1.ObjectReference{Kind: "Pod", Namespace: "volcano-system", Name: "volcano-scheduler"},
+	}
+
+	goodConf := `
+actions: "enqueue, allocate"
+tiers:
+- plugins:
+  - name: gang
+`
+	if err := os.WriteFile(confFile, []byte(goodConf), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	sched.loadSchedulerConf()
+	goodHash := SchedulerConfHash(goodConf)
+	if sched.confHash != goodHash || len(sched.actions) != 2 {
+		t.Fatalf("expected config %s to be loaded, got %s with actions %v", goodHash, sched.confHash, sched.actions)
+	}
+	if event := <-recorder.Events; !strings.Contains(event, "SchedulerConfigLoaded") {
+		t.Errorf("expected SchedulerConfigLoaded event, got %s", event)
+	}
+
+	badConf := `
+actions: "enqueue, allocate, backfill"
+tiers:
+- plugins:
+  - name: gang
+    enableJobReadyy: false
+`
+	if err := os.WriteFile(confFile, []byte(badConf), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	sched.loadSchedulerConf()
+	if sched.confHash != goodHash || len(sched.actions) != 2 {
+		t.Errorf("expected config to be rolled back to %s, got %s with actions %v", goodHash, sched.confHash, sched.actions)
+	}
+	if event := <-recorder.Events; !strings.Contains(event, "SchedulerConfigRolledBack") || !strings.Contains(event, goodHash) {
+		t.Errorf("expected SchedulerConfigRolledBack event, got %s", event)
+	}
+}
+
+func TestScheduleLoop(t *testing.T) {
+	runs := make(chan time.Time, 10)
+	trigger := make(chan struct{}, 1)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go scheduleLoop(func() { runs <- time.Now() }, trigger, time.Hour, 20*time.Millisecond, 100*time.Millisecond, stopCh)
+
+	waitRun := func(timeout time.Duration) (time.Time, bool) {
+		select {
+		case at := <-runs:
+			return at, true
+		case <-time.After(timeout):
+			return time.Time{}, false
+		}
+	}
+
+	first, ok := waitRun(time.Second)
+	if !ok {
+		t.Fatal("expected the first scheduling cycle to run immediately")
+	}
+
+	// a burst of changes triggers one early cycle no earlier than the minimum interval
+	for i := 0; i < 3; i++ {
+		trigger <- struct{}{}
+		time.Sleep(5 * time.Millisecond)
+	}
+	second, ok := waitRun(time.Second)
+	if !ok {
+		t.Fatal("expected an early scheduling cycle to be triggered")
+	}
+	if interval := second.Sub(first); interval < 100*time.Millisecond {
+		t.Errorf("expected the early cycle to run no earlier than the minimum interval, got %v", interval)
+	}
+	if _, ok := waitRun(300 * time.Millisecond); ok {
+		t.Error("expected the burst of changes to be coalesced into one early cycle")
+	}
+
+	// the early cycle is delayed by the debounce once the minimum interval elapses
+	triggered := time.Now()
+	trigger <- struct{}{}
+	third, ok := waitRun(time.Second)
+	if !ok {
+		t.Fatal("expected an early scheduling cycle to be triggered")
+	}
+	if delay := third.Sub(triggered); delay < 20*time.Millisecond {
+		t.Errorf("expected the early cycle to be delayed by the debounce, got %v", delay)
+	}
+}
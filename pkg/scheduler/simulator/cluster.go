@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingscheme "volcano.sh/apis/pkg/apis/scheduling/scheme"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	topologyv1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
+)
+
+// Cluster is the cluster to simulate, which is described in YAML or loaded from a snapshot of the scheduler cache.
+type Cluster struct {
+	Nodes           []*v1.Node                    `json:"nodes,omitempty"`
+	HyperNodes      []*topologyv1alpha1.HyperNode `json:"hyperNodes,omitempty"`
+	Queues          []*schedulingv1beta1.Queue    `json:"queues,omitempty"`
+	PodGroups       []*schedulingv1beta1.PodGroup `json:"podGroups,omitempty"`
+	Pods            []*v1.Pod                     `json:"pods,omitempty"`
+	PriorityClasses []*schedulingv1.PriorityClass `json:"priorityClasses,omitempty"`
+}
+
+// LoadCluster loads the synthetic cluster described in YAML.
+func LoadCluster(data []byte) (*Cluster, error) {
+	cluster := &Cluster{}
+	if err := yaml.UnmarshalStrict(data, cluster); err != nil {
+		return nil, fmt.Errorf("failed to parse cluster: %v", err)
+	}
+	return cluster, nil
+}
+
+// The objects are dumped as a stream of JSON values by the Dumper of the scheduler cache in the order of: nodes,
+// hyperNodes by tier, hyperNode tier names, real nodes of hyperNodes, hyperNodes, jobs, queues and reservation,
+// the reservation is not replayed.
+type snapshotNode struct {
+	Node *v1.Node
+}
+
+type snapshotHyperNode struct {
+	HyperNode *topologyv1alpha1.HyperNode
+}
+
+type snapshotTask struct {
+	Pod *v1.Pod
+}
+
+type snapshotJob struct {
+	Queue    string
+	PodGroup *scheduling.PodGroup
+	Tasks    map[string]*snapshotTask
+}
+
+type snapshotQueue struct {
+	Queue *scheduling.Queue
+}
+
+// LoadSnapshot loads the cluster from the snapshot dumped by the scheduler cache. The queues are only dumped by the
+// recent versions, the missing queues referred by the jobs are created with the default settings.
+func LoadSnapshot(r io.Reader) (*Cluster, error) {
+	var (
+		nodes      map[string]*snapshotNode
+		hyperNodes map[string]*snapshotHyperNode
+		jobs       map[string]*snapshotJob
+		queues     map[string]*snapshotQueue
+		ignored    json.RawMessage
+	)
+
+	decoder := json.NewDecoder(r)
+	for i, v := range []interface{}{&nodes, &ignored, &ignored, &ignored, &hyperNodes, &jobs, &queues} {
+		if err := decoder.Decode(v); err != nil {
+			if errors.Is(err, io.EOF) && v == &queues {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode the snapshot at value %d: %v", i, err)
+		}
+	}
+
+	cluster := &Cluster{}
+	for _, node := range nodes {
+		if node != nil && node.Node != nil {
+			cluster.Nodes = append(cluster.Nodes, node.Node)
+		}
+	}
+	for _, hyperNode := range hyperNodes {
+		if hyperNode != nil && hyperNode.HyperNode != nil {
+			cluster.HyperNodes = append(cluster.HyperNodes, hyperNode.HyperNode)
+		}
+	}
+	for _, queue := range queues {
+		if queue == nil || queue.Queue == nil {
+			continue
+		}
+		q := &schedulingv1beta1.Queue{}
+		if err := schedulingscheme.Scheme.Convert(queue.Queue, q, nil); err != nil {
+			return nil, fmt.Errorf("failed to convert queue %s: %v", queue.Queue.Name, err)
+		}
+		cluster.Queues = append(cluster.Queues, q)
+	}
+	for _, job := range jobs {
+		if job == nil || job.PodGroup == nil {
+			continue
+		}
+		pg := &schedulingv1beta1.PodGroup{}
+		if err := schedulingscheme.Scheme.Convert(job.PodGroup, pg, nil); err != nil {
+			return nil, fmt.Errorf("failed to convert PodGroup %s/%s: %v", job.PodGroup.Namespace, job.PodGroup.Name, err)
+		}
+		cluster.PodGroups = append(cluster.PodGroups, pg)
+		for _, task := range job.Tasks {
+			if task != nil && task.Pod != nil {
+				cluster.Pods = append(cluster.Pods, task.Pod)
+			}
+		}
+	}
+
+	cluster.sort()
+	return cluster, nil
+}
+
+// Merge merges the objects of the other cluster into the cluster, the ones with the same key are replaced.
+func (c *Cluster) Merge(other *Cluster) {
+	c.Nodes = mergeObjects(c.Nodes, other.Nodes)
+	c.HyperNodes = mergeObjects(c.HyperNodes, other.HyperNodes)
+	c.Queues = mergeObjects(c.Queues, other.Queues)
+	c.PodGroups = mergeObjects(c.PodGroups, other.PodGroups)
+	c.Pods = mergeObjects(c.Pods, other.Pods)
+	c.PriorityClasses = mergeObjects(c.PriorityClasses, other.PriorityClasses)
+	c.sort()
+}
+
+// complete sets the UIDs of the pods which are not set in the synthetic cluster, since the tasks are identified by
+// them, and creates the queues which are referred by the PodGroups but not defined.
+func (c *Cluster) complete() {
+	for _, pod := range c.Pods {
+		if pod.UID == "" {
+			pod.UID = types.UID(objectKey(pod))
+		}
+	}
+
+	defined := map[string]bool{}
+	for _, queue := range c.Queues {
+		defined[queue.Name] = true
+	}
+	for _, pg := range c.PodGroups {
+		name := pg.Spec.Queue
+		if name == "" {
+			name = schedulingv1beta1.DefaultQueue
+		}
+		if defined[name] {
+			continue
+		}
+		defined[name] = true
+		c.Queues = append(c.Queues, &schedulingv1beta1.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       schedulingv1beta1.QueueSpec{Weight: 1},
+			Status:     schedulingv1beta1.QueueStatus{State: schedulingv1beta1.QueueStateOpen},
+		})
+	}
+}
+
+func (c *Cluster) sort() {
+	sortObjects(c.Nodes)
+	sortObjects(c.HyperNodes)
+	sortObjects(c.Queues)
+	sortObjects(c.PodGroups)
+	sortObjects(c.Pods)
+	sortObjects(c.PriorityClasses)
+}
+
+func objectKey(obj metav1.Object) string {
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+func mergeObjects[T metav1.Object](objs, others []T) []T {
+	index := map[string]int{}
+	for i, obj := range objs {
+		index[objectKey(obj)] = i
+	}
+	for _, other := range others {
+		if i, found := index[objectKey(other)]; found {
+			objs[i] = other
+			continue
+		}
+		index[objectKey(other)] = len(objs)
+		objs = append(objs, other)
+	}
+	return objs
+}
+
+func sortObjects[T metav1.Object](objs []T) {
+	sort.Slice(objs, func(i, j int) bool {
+		return objectKey(objs[i]) < objectKey(objs[j])
+	})
+}
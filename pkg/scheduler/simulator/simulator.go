@@ -0,0 +1,358 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulator replays a cluster through the actions and plugins of the scheduler offline, the placements and
+// evictions are applied to the cache of the simulator only, so that the scheduler config can be evaluated without
+// touching a cluster.
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	listerv1 "k8s.io/client-go/listers/core/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingscheme "volcano.sh/apis/pkg/apis/scheduling/scheme"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	nodeshardv1alpha1 "volcano.sh/apis/pkg/apis/shard/v1alpha1"
+	"volcano.sh/volcano/pkg/scheduler"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	"volcano.sh/volcano/pkg/scheduler/cache"
+	"volcano.sh/volcano/pkg/scheduler/conf"
+	"volcano.sh/volcano/pkg/scheduler/framework"
+	"volcano.sh/volcano/pkg/scheduler/metrics"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const (
+	simulatorName = "volcano"
+
+	// settleTimeout is the max time to wait for the binding and evicting tasks of a cycle to be applied to the cache
+	settleTimeout = 30 * time.Second
+)
+
+// Options are the options of the simulation.
+type Options struct {
+	// SchedulerConf is the scheduler config to simulate, the default one is used if it is empty.
+	SchedulerConf string
+}
+
+// Placement is a task placed onto a node.
+type Placement struct {
+	Task string `json:"task"`
+	Node string `json:"node"`
+}
+
+// Eviction is a task evicted from a node.
+type Eviction struct {
+	Task   string `json:"task"`
+	Node   string `json:"node"`
+	Reason string `json:"reason"`
+}
+
+// QueueShare is the deserved and allocated resource of a queue at the end of a cycle.
+type QueueShare struct {
+	Queue     string          `json:"queue"`
+	Deserved  v1.ResourceList `json:"deserved,omitempty"`
+	Allocated v1.ResourceList `json:"allocated,omitempty"`
+}
+
+// CycleResult is the result of a scheduling cycle.
+type CycleResult struct {
+	Cycle       int          `json:"cycle"`
+	Placements  []Placement  `json:"placements,omitempty"`
+	Evictions   []Eviction   `json:"evictions,omitempty"`
+	QueueShares []QueueShare `json:"queueShares,omitempty"`
+}
+
+// Report is the result of the simulation.
+type Report struct {
+	Cycles []CycleResult `json:"cycles"`
+	// PodGroupPhases are the phases of the PodGroups at the end of the simulation.
+	PodGroupPhases map[string]scheduling.PodGroupPhase `json:"podGroupPhases"`
+}
+
+// Simulator runs the scheduling cycles against the cache loaded with the cluster.
+type Simulator struct {
+	cache *cache.SchedulerCache
+	stop  chan struct{}
+
+	actions        []framework.Action
+	tiers          []conf.Tier
+	configurations []conf.Configuration
+
+	mutex      sync.Mutex
+	placements []Placement
+	evictions  []Eviction
+}
+
+// New creates the simulator with the cluster and the scheduler config.
+func New(cluster *Cluster, opts Options) (*Simulator, error) {
+	schedulerConf := opts.SchedulerConf
+	if schedulerConf == "" {
+		schedulerConf = scheduler.DefaultSchedulerConf
+	}
+	actions, tiers, configurations, _, err := scheduler.UnmarshalSchedulerConf(schedulerConf)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scheduler config: %v", err)
+	}
+
+	// The kube-scheduler plugins called by the predicates require the metrics to be initialized.
+	metrics.InitKubeSchedulerRelatedMetrics()
+
+	sim := &Simulator{
+		stop:           make(chan struct{}),
+		actions:        actions,
+		tiers:          tiers,
+		configurations: configurations,
+	}
+	sim.cache = cache.NewCustomMockSchedulerCache(simulatorName, &binder{sim: sim}, &evictor{sim: sim}, &statusUpdater{sim: sim}, nil, &record.FakeRecorder{})
+	sim.cache.Run(sim.stop)
+
+	if err := sim.load(cluster); err != nil {
+		sim.Close()
+		return nil, err
+	}
+	return sim, nil
+}
+
+// load adds the objects of the cluster into the cache.
+func (sim *Simulator) load(cluster *Cluster) error {
+	cluster.complete()
+
+	for _, pc := range cluster.PriorityClasses {
+		sim.cache.AddPriorityClass(pc)
+	}
+	for _, queue := range cluster.Queues {
+		sim.cache.AddQueueV1beta1(queue)
+	}
+	nodeIndexer := toolscache.NewIndexer(toolscache.MetaNamespaceKeyFunc, toolscache.Indexers{})
+	for _, node := range cluster.Nodes {
+		if err := sim.cache.AddOrUpdateNode(node); err != nil {
+			return fmt.Errorf("failed to add node %s: %v", node.Name, err)
+		}
+		if err := nodeIndexer.Add(node); err != nil {
+			return err
+		}
+	}
+	for _, pg := range cluster.PodGroups {
+		sim.cache.AddPodGroupV1beta1(pg)
+	}
+	for _, pod := range cluster.Pods {
+		sim.cache.AddPod(pod)
+	}
+
+	// The members of the hyperNodes are resolved by the node lister, the parents are updated before the children.
+	hyperNodesInfo := api.NewHyperNodesInfo(listerv1.NewNodeLister(nodeIndexer))
+	hyperNodes := append(cluster.HyperNodes[:0:0], cluster.HyperNodes...)
+	sort.SliceStable(hyperNodes, func(i, j int) bool {
+		return hyperNodes[i].Spec.Tier > hyperNodes[j].Spec.Tier
+	})
+	for _, hn := range hyperNodes {
+		if err := hyperNodesInfo.UpdateHyperNode(hn); err != nil {
+			return fmt.Errorf("failed to add hyperNode %s: %v", hn.Name, err)
+		}
+	}
+	sim.cache.HyperNodesInfo = hyperNodesInfo
+	return nil
+}
+
+// Run runs the scheduling cycles and reports the results.
+func (sim *Simulator) Run(cycles int) (*Report, error) {
+	report := &Report{}
+	for i := 1; i <= cycles; i++ {
+		result, err := sim.runOnce()
+		if err != nil {
+			return report, fmt.Errorf("cycle %d: %v", i, err)
+		}
+		result.Cycle = i
+		report.Cycles = append(report.Cycles, *result)
+	}
+
+	report.PodGroupPhases = map[string]scheduling.PodGroupPhase{}
+	for _, job := range sim.cache.Snapshot().Jobs {
+		if job.PodGroup != nil {
+			report.PodGroupPhases[job.Namespace+"/"+job.Name] = job.PodGroup.Status.Phase
+		}
+	}
+	return report, nil
+}
+
+// Close stops the cache of the simulator.
+func (sim *Simulator) Close() {
+	close(sim.stop)
+}
+
+// runOnce runs a scheduling cycle as the scheduler does, and waits for its decisions to be applied to the cache.
+func (sim *Simulator) runOnce() (*CycleResult, error) {
+	conf.EnabledActionMap = make(map[string]bool)
+	for _, action := range sim.actions {
+		conf.EnabledActionMap[action.Name()] = true
+	}
+
+	ssn := framework.OpenSession(sim.cache, sim.tiers, sim.configurations)
+	for _, action := range sim.actions {
+		action.Execute(ssn)
+	}
+	queueShares := sim.queueShares(ssn)
+	framework.CloseSession(ssn)
+
+	if err := sim.settle(); err != nil {
+		return nil, err
+	}
+
+	sim.mutex.Lock()
+	defer sim.mutex.Unlock()
+	result := &CycleResult{
+		Placements:  sim.placements,
+		Evictions:   sim.evictions,
+		QueueShares: queueShares,
+	}
+	sort.Slice(result.Placements, func(i, j int) bool { return result.Placements[i].Task < result.Placements[j].Task })
+	sort.Slice(result.Evictions, func(i, j int) bool { return result.Evictions[i].Task < result.Evictions[j].Task })
+	sim.placements, sim.evictions = nil, nil
+	return result, nil
+}
+
+// queueShares returns the deserved and allocated resource of the queues in the session.
+func (sim *Simulator) queueShares(ssn *framework.Session) []QueueShare {
+	allocated := map[api.QueueID]*api.Resource{}
+	for _, job := range ssn.Jobs {
+		if _, found := allocated[job.Queue]; !found {
+			allocated[job.Queue] = api.EmptyResource()
+		}
+		for status, tasks := range job.TaskStatusIndex {
+			if !api.AllocatedStatus(status) {
+				continue
+			}
+			for _, task := range tasks {
+				allocated[job.Queue].Add(task.Resreq)
+			}
+		}
+	}
+
+	shares := make([]QueueShare, 0, len(ssn.Queues))
+	for _, queue := range ssn.Queues {
+		share := QueueShare{Queue: queue.Name}
+		if deserved := ssn.QueueDeserved(queue.UID); deserved != nil {
+			share.Deserved = util.ConvertRes2ResList(deserved)
+		}
+		if res, found := allocated[queue.UID]; found {
+			share.Allocated = util.ConvertRes2ResList(res)
+		}
+		shares = append(shares, share)
+	}
+	sort.Slice(shares, func(i, j int) bool { return shares[i].Queue < shares[j].Queue })
+	return shares
+}
+
+// settle waits until no task is binding or evicting in the cache, the pods already being deleted in the cluster are
+// kept as releasing.
+func (sim *Simulator) settle() error {
+	err := wait.PollUntilContextTimeout(wait.ContextForChannel(sim.stop), 10*time.Millisecond, settleTimeout, true, func(_ context.Context) (bool, error) {
+		sim.cache.Mutex.Lock()
+		defer sim.cache.Mutex.Unlock()
+		for _, job := range sim.cache.Jobs {
+			if len(job.TaskStatusIndex[api.Binding]) > 0 {
+				return false, nil
+			}
+			for _, task := range job.TaskStatusIndex[api.Releasing] {
+				if task.Pod.DeletionTimestamp == nil {
+					return false, nil
+				}
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to wait for the decisions to be applied: %v", err)
+	}
+	return nil
+}
+
+// binder applies the placements to the cache as if the pods were bound and started.
+type binder struct {
+	sim *Simulator
+}
+
+func (b *binder) Bind(_ kubernetes.Interface, tasks []*api.TaskInfo) map[api.TaskID]string {
+	for _, task := range tasks {
+		pod := task.Pod.DeepCopy()
+		pod.Spec.NodeName = task.NodeName
+		pod.Status.Phase = v1.PodRunning
+		b.sim.cache.UpdatePod(task.Pod, pod)
+
+		b.sim.mutex.Lock()
+		b.sim.placements = append(b.sim.placements, Placement{Task: task.Namespace + "/" + task.Name, Node: task.NodeName})
+		b.sim.mutex.Unlock()
+		klog.V(3).Infof("Simulated binding of task <%s/%s> to node <%s>.", task.Namespace, task.Name, task.NodeName)
+	}
+	return nil
+}
+
+// evictor removes the evicted pods from the cache as if they were deleted.
+type evictor struct {
+	sim *Simulator
+}
+
+func (e *evictor) Evict(pod *v1.Pod, reason string) error {
+	e.sim.cache.DeletePod(pod)
+
+	e.sim.mutex.Lock()
+	e.sim.evictions = append(e.sim.evictions, Eviction{Task: pod.Namespace + "/" + pod.Name, Node: pod.Spec.NodeName, Reason: reason})
+	e.sim.mutex.Unlock()
+	klog.V(3).Infof("Simulated eviction of task <%s/%s> from node <%s>.", pod.Namespace, pod.Name, pod.Spec.NodeName)
+	return nil
+}
+
+// statusUpdater applies the PodGroup status to the cache, so that it progresses between the cycles.
+type statusUpdater struct {
+	sim *Simulator
+}
+
+func (su *statusUpdater) UpdatePodStatus(pod *v1.Pod) (*v1.Pod, error) {
+	return pod, nil
+}
+
+func (su *statusUpdater) UpdatePodGroup(pg *api.PodGroup) (*api.PodGroup, error) {
+	podgroup := &schedulingv1beta1.PodGroup{}
+	if err := schedulingscheme.Scheme.Convert(&pg.PodGroup, podgroup, nil); err != nil {
+		return nil, err
+	}
+	// The cache ignores the updates with the same resource version.
+	podgroup.ResourceVersion = strconv.FormatInt(time.Now().UnixNano(), 10)
+	su.sim.cache.UpdatePodGroupV1beta1(&schedulingv1beta1.PodGroup{}, podgroup)
+	return pg, nil
+}
+
+func (su *statusUpdater) UpdateQueueStatus(_ *api.QueueInfo) error {
+	return nil
+}
+
+func (su *statusUpdater) UpdateNodeShardStatus(nodeShard *nodeshardv1alpha1.NodeShard) (*nodeshardv1alpha1.NodeShard, error) {
+	return nodeShard, nil
+}
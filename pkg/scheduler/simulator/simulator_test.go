@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"volcano.sh/apis/pkg/apis/scheduling"
+	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+	_ "volcano.sh/volcano/pkg/scheduler/actions"
+	"volcano.sh/volcano/pkg/scheduler/api"
+	_ "volcano.sh/volcano/pkg/scheduler/plugins"
+	"volcano.sh/volcano/pkg/scheduler/util"
+)
+
+const clusterYAML = `
+nodes:
+- metadata:
+    name: n1
+  status:
+    allocatable: {cpu: "4", memory: 8Gi, pods: "110"}
+    capacity: {cpu: "4", memory: 8Gi, pods: "110"}
+queues:
+- metadata:
+    name: q1
+  spec:
+    weight: 1
+  status:
+    state: Open
+podGroups:
+- metadata:
+    name: pg1
+    namespace: ns1
+  spec:
+    minMember: 2
+    queue: q1
+- metadata:
+    name: pg2
+    namespace: ns1
+  spec:
+    minMember: 1
+    queue: q2
+pods:
+- metadata:
+    name: p1
+    namespace: ns1
+    annotations: {scheduling.k8s.io/group-name: pg1}
+  spec:
+    schedulerName: volcano
+    containers:
+    - name: main
+      resources:
+        requests: {cpu: "1", memory: 2Gi}
+  status:
+    phase: Pending
+- metadata:
+    name: p2
+    namespace: ns1
+    annotations: {scheduling.k8s.io/group-name: pg1}
+  spec:
+    schedulerName: volcano
+    containers:
+    - name: main
+      resources:
+        requests: {cpu: "1", memory: 2Gi}
+  status:
+    phase: Pending
+- metadata:
+    name: p3
+    namespace: ns1
+    annotations: {scheduling.k8s.io/group-name: pg2}
+  spec:
+    schedulerName: volcano
+    containers:
+    - name: main
+      resources:
+        requests: {cpu: "1", memory: 1Gi}
+  status:
+    phase: Pending
+`
+
+func TestSimulateCluster(t *testing.T) {
+	cluster, err := LoadCluster([]byte(clusterYAML))
+	if err != nil {
+		t.Fatalf("failed to load cluster: %v", err)
+	}
+
+	sim, err := New(cluster, Options{})
+	if err != nil {
+		t.Fatalf("failed to create simulator: %v", err)
+	}
+	defer sim.Close()
+
+	report, err := sim.Run(2)
+	if err != nil {
+		t.Fatalf("failed to run simulation: %v", err)
+	}
+	if len(report.Cycles) != 2 {
+		t.Fatalf("expected 2 cycles, got %d", len(report.Cycles))
+	}
+
+	placed := map[string]string{}
+	for _, cycle := range report.Cycles {
+		for _, placement := range cycle.Placements {
+			placed[placement.Task] = placement.Node
+		}
+	}
+	if len(placed) != 3 || placed["ns1/p1"] != "n1" || placed["ns1/p2"] != "n1" || placed["ns1/p3"] != "n1" {
+		t.Errorf("expected all the tasks to be placed onto n1, got %v", placed)
+	}
+	for _, pg := range []string{"ns1/pg1", "ns1/pg2"} {
+		if report.PodGroupPhases[pg] != scheduling.PodGroupRunning {
+			t.Errorf("expected %s to be running, got %v", pg, report.PodGroupPhases)
+		}
+	}
+
+	// The queue q2 referred by pg2 is created with the default settings.
+	shares := report.Cycles[1].QueueShares
+	if len(shares) != 2 || shares[0].Queue != "q1" || shares[1].Queue != "q2" {
+		t.Fatalf("expected shares of queues q1 and q2, got %+v", shares)
+	}
+	if cpu := shares[0].Allocated[v1.ResourceCPU]; cpu.Cmp(resource.MustParse("2")) != 0 {
+		t.Errorf("expected 2 cpu allocated in q1, got %v", cpu.String())
+	}
+	if cpu := shares[0].Deserved[v1.ResourceCPU]; cpu.Cmp(resource.MustParse("2")) != 0 {
+		t.Errorf("expected 2 cpu deserved by q1, got %v", cpu.String())
+	}
+}
+
+func TestLoadSnapshot(t *testing.T) {
+	node := util.BuildNode("n1", api.BuildResourceList("4", "8Gi", []api.ScalarResource{{Name: "pods", Value: "110"}}...), nil)
+	pod := util.BuildPod("ns1", "p1", "n1", v1.PodRunning, api.BuildResourceList("1", "1Gi"), "pg1", nil, nil)
+	pg := &api.PodGroup{PodGroup: scheduling.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "pg1", Namespace: "ns1"},
+		Spec:       scheduling.PodGroupSpec{MinMember: 1, Queue: "q1"},
+		Status:     scheduling.PodGroupStatus{Phase: scheduling.PodGroupRunning},
+	}}
+	job := api.NewJobInfo("ns1/pg1", api.NewTaskInfo(pod))
+	job.SetPodGroup(pg)
+	queue := api.NewQueueInfo(&scheduling.Queue{ObjectMeta: metav1.ObjectMeta{Name: "q1"}, Spec: scheduling.QueueSpec{Weight: 2}})
+
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	for _, v := range []interface{}{
+		map[string]*api.NodeInfo{"n1": api.NewNodeInfo(node)},
+		map[int]interface{}{},
+		map[string]string{},
+		map[string]interface{}{},
+		map[string]*api.HyperNodeInfo{},
+		map[api.JobID]*api.JobInfo{job.UID: job},
+		map[api.QueueID]*api.QueueInfo{queue.UID: queue},
+	} {
+		if err := encoder.Encode(v); err != nil {
+			t.Fatalf("failed to encode snapshot: %v", err)
+		}
+	}
+
+	cluster, err := LoadSnapshot(buf)
+	if err != nil {
+		t.Fatalf("failed to load snapshot: %v", err)
+	}
+	if len(cluster.Nodes) != 1 || len(cluster.Pods) != 1 || len(cluster.PodGroups) != 1 || len(cluster.Queues) != 1 {
+		t.Fatalf("unexpected cluster loaded from snapshot: %+v", cluster)
+	}
+	if cluster.PodGroups[0].Spec.Queue != "q1" || cluster.PodGroups[0].Status.Phase != schedulingv1beta1.PodGroupRunning {
+		t.Errorf("unexpected PodGroup loaded from snapshot: %+v", cluster.PodGroups[0])
+	}
+	if cluster.Queues[0].Spec.Weight != 2 {
+		t.Errorf("unexpected queue loaded from snapshot: %+v", cluster.Queues[0])
+	}
+
+	cluster.Merge(&Cluster{Queues: []*schedulingv1beta1.Queue{{ObjectMeta: metav1.ObjectMeta{Name: "q1"}, Spec: schedulingv1beta1.QueueSpec{Weight: 3}}}})
+	if len(cluster.Queues) != 1 || cluster.Queues[0].Spec.Weight != 3 {
+		t.Errorf("expected queue q1 to be replaced by merge, got %+v", cluster.Queues)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// SpanRecord is a span written by the file exporter, the field names follow the OTLP JSON encoding.
+type SpanRecord struct {
+	TraceID           string                 `json:"traceId"`
+	SpanID            string                 `json:"spanId"`
+	ParentSpanID      string                 `json:"parentSpanId,omitempty"`
+	Name              string                 `json:"name"`
+	StartTimeUnixNano int64                  `json:"startTimeUnixNano"`
+	EndTimeUnixNano   int64                  `json:"endTimeUnixNano"`
+	Attributes        map[string]interface{} `json:"attributes,omitempty"`
+	Status            string                 `json:"status,omitempty"`
+	StatusMessage     string                 `json:"statusMessage,omitempty"`
+}
+
+// FileExporter appends the spans to a file, one JSON SpanRecord per line.
+type FileExporter struct {
+	mu      sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+}
+
+var _ sdktrace.SpanExporter = &FileExporter{}
+
+// NewFileExporter opens the file to append the spans to, the file is created if it does not exist.
+func NewFileExporter(path string) (*FileExporter, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the trace file %s: %v", path, err)
+	}
+	return &FileExporter{file: file, encoder: json.NewEncoder(file)}, nil
+}
+
+// ExportSpans writes the spans to the file.
+func (e *FileExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.file == nil {
+		return nil
+	}
+	for _, span := range spans {
+		if err := e.encoder.Encode(newSpanRecord(span)); err != nil {
+			return fmt.Errorf("failed to write span %s to the trace file: %v", span.Name(), err)
+		}
+	}
+	return nil
+}
+
+// Shutdown closes the file.
+func (e *FileExporter) Shutdown(_ context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.file == nil {
+		return nil
+	}
+	err := e.file.Close()
+	e.file = nil
+	return err
+}
+
+func newSpanRecord(span sdktrace.ReadOnlySpan) *SpanRecord {
+	record := &SpanRecord{
+		TraceID:           span.SpanContext().TraceID().String(),
+		SpanID:            span.SpanContext().SpanID().String(),
+		Name:              span.Name(),
+		StartTimeUnixNano: span.StartTime().UnixNano(),
+		EndTimeUnixNano:   span.EndTime().UnixNano(),
+		StatusMessage:     span.Status().Description,
+	}
+	if span.Parent().IsValid() {
+		record.ParentSpanID = span.Parent().SpanID().String()
+	}
+	if code := span.Status().Code; code != 0 {
+		record.Status = code.String()
+	}
+	if attrs := span.Attributes(); len(attrs) > 0 {
+		record.Attributes = make(map[string]interface{}, len(attrs))
+		for _, attr := range attrs {
+			record.Attributes[string(attr.Key)] = attr.Value.AsInterface()
+		}
+	}
+	return record
+}
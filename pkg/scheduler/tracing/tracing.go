@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing exports the scheduling cycles as OpenTelemetry traces: a span per cycle, a span per action in the
+// cycle, and a span per job attempted in the action with the time spent by each plugin.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// InstrumentationName is the name of the tracer of the scheduler.
+	InstrumentationName = "volcano.sh/volcano/pkg/scheduler"
+	// serviceName is the service name in the resource of the exported spans.
+	serviceName = "volcano-scheduler"
+)
+
+// Options is the configuration of the trace export.
+type Options struct {
+	// Endpoint is the address of the OTLP gRPC collector, e.g. "otel-collector:4317".
+	Endpoint string
+	// Insecure disables the TLS of the connection to the collector.
+	Insecure bool
+	// File is the path of the file the spans are appended to as JSON lines for offline analysis.
+	File string
+	// SamplingRatio is the ratio of the scheduling cycles traced, from 0 to 1.
+	SamplingRatio float64
+}
+
+// Enabled checks whether any exporter is configured.
+func (o Options) Enabled() bool {
+	return o.Endpoint != "" || o.File != ""
+}
+
+// Validate validates the options.
+func (o Options) Validate() error {
+	if o.SamplingRatio < 0 || o.SamplingRatio > 1 {
+		return fmt.Errorf("the tracing sampling ratio must be from 0 to 1, got %v", o.SamplingRatio)
+	}
+	return nil
+}
+
+var (
+	mu       sync.RWMutex
+	provider trace.TracerProvider = noop.NewTracerProvider()
+	enabled  bool
+)
+
+// Init starts the exporters configured in the options and traces the scheduling cycles with them. The returned
+// function flushes the pending spans and stops the exporters.
+func Init(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if !opts.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	tpOpts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SamplingRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	}
+	if opts.Endpoint != "" {
+		grpcOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.Endpoint)}
+		if opts.Insecure {
+			grpcOpts = append(grpcOpts, otlptracegrpc.WithInsecure())
+		} else {
+			grpcOpts = append(grpcOpts, otlptracegrpc.WithTLSCredentials(credentials.NewClientTLSFromCert(nil, "")))
+		}
+		exporter, err := otlptracegrpc.New(ctx, grpcOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the OTLP exporter of %s: %v", opts.Endpoint, err)
+		}
+		tpOpts = append(tpOpts, sdktrace.WithBatcher(exporter))
+	}
+	if opts.File != "" {
+		exporter, err := NewFileExporter(opts.File)
+		if err != nil {
+			return nil, err
+		}
+		tpOpts = append(tpOpts, sdktrace.WithBatcher(exporter))
+	}
+
+	tp := sdktrace.NewTracerProvider(tpOpts...)
+	SetTracerProvider(tp)
+	return func(ctx context.Context) error {
+		SetTracerProvider(nil)
+		return tp.Shutdown(ctx)
+	}, nil
+}
+
+// SetTracerProvider sets the provider the scheduling cycles are traced with, nil disables the tracing.
+func SetTracerProvider(tp trace.TracerProvider) {
+	mu.Lock()
+	defer mu.Unlock()
+	if tp == nil {
+		provider, enabled = noop.NewTracerProvider(), false
+		return
+	}
+	provider, enabled = tp, true
+}
+
+// Enabled checks whether the scheduling cycles are traced.
+func Enabled() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return enabled
+}
+
+// Tracer returns the tracer of the scheduler, which does nothing if the tracing is disabled.
+func Tracer() trace.Tracer {
+	mu.RLock()
+	defer mu.RUnlock()
+	return provider.Tracer(InstrumentationName)
+}
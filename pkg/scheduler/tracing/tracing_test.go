@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestInitWithFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	shutdown, err := Init(context.Background(), Options{File: path, SamplingRatio: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Enabled() {
+		t.Fatal("expected the tracing to be enabled")
+	}
+
+	ctx, cycle := Tracer().Start(context.Background(), "scheduling-cycle")
+	_, action := Tracer().Start(ctx, "action/allocate", trace.WithAttributes(attribute.Int("jobs", 2)))
+	action.End()
+	cycle.End()
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if Enabled() {
+		t.Fatal("expected the tracing to be disabled after shutdown")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	records := map[string]*SpanRecord{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := &SpanRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			t.Fatalf("invalid span record %q: %v", scanner.Text(), err)
+		}
+		records[record.Name] = record
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 spans in the file, got %d", len(records))
+	}
+	cycleRecord, actionRecord := records["scheduling-cycle"], records["action/allocate"]
+	if cycleRecord == nil || actionRecord == nil {
+		t.Fatalf("expected the spans of the cycle and the action, got %v", records)
+	}
+	if actionRecord.TraceID != cycleRecord.TraceID || actionRecord.ParentSpanID != cycleRecord.SpanID {
+		t.Errorf("expected the span of the action to be a child of the span of the cycle")
+	}
+	if actionRecord.Attributes["jobs"] != float64(2) {
+		t.Errorf("expected attribute jobs of the action to be 2, got %v", actionRecord.Attributes["jobs"])
+	}
+	if actionRecord.EndTimeUnixNano < actionRecord.StartTimeUnixNano {
+		t.Errorf("expected the span of the action to end after its start")
+	}
+}
+
+func TestOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    Options
+		enabled bool
+		wantErr bool
+	}{
+		{name: "no exporter", opts: Options{SamplingRatio: 1}},
+		{name: "otlp endpoint", opts: Options{Endpoint: "collector:4317", SamplingRatio: 0.1}, enabled: true},
+		{name: "sampling ratio out of range", opts: Options{File: "trace.jsonl", SamplingRatio: 2}, enabled: true, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if enabled := test.opts.Enabled(); enabled != test.enabled {
+				t.Errorf("expected enabled %v, got %v", test.enabled, enabled)
+			}
+			if err := test.opts.Validate(); (err != nil) != test.wantErr {
+				t.Errorf("expected error %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
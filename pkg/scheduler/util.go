@@ -22,12 +22,15 @@ limitations under the License.
 package scheduler
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"flag"
 	"fmt"
 	"os"
 	"strings"
 
 	"gopkg.in/yaml.v2"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"volcano.sh/volcano/pkg/scheduler/conf"
 	"volcano.sh/volcano/pkg/scheduler/framework"
@@ -55,7 +58,11 @@ func UnmarshalSchedulerConf(confStr string) ([]framework.Action, []conf.Tier, []
 
 	schedulerConf := &conf.SchedulerConfiguration{}
 
-	if err := yaml.Unmarshal([]byte(confStr), schedulerConf); err != nil {
+	// Unknown fields, e.g. misspelled enable* keys, are rejected rather than ignored.
+	if err := yaml.UnmarshalStrict([]byte(confStr), schedulerConf); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if err := validateSchedulerConf(schedulerConf); err != nil {
 		return nil, nil, nil, nil, err
 	}
 	// Set default settings for each plugin if not set
@@ -92,6 +99,76 @@ func UnmarshalSchedulerConf(confStr string) ([]framework.Action, []conf.Tier, []
 	return actions, schedulerConf.Tiers, schedulerConf.Configurations, schedulerConf.MetricsConfiguration, nil
 }
 
+// validateSchedulerConf validates the plugins and the configurations of actions in the scheduler configuration, all
+// the errors found are returned together.
+func validateSchedulerConf(schedulerConf *conf.SchedulerConfiguration) error {
+	var errs []error
+
+	enabled := map[string]bool{}
+	for i, tier := range schedulerConf.Tiers {
+		if len(tier.Plugins) == 0 {
+			errs = append(errs, fmt.Errorf("tier %d has no plugins", i))
+		}
+		for _, plugin := range tier.Plugins {
+			if _, found := framework.GetPluginBuilder(plugin.Name); !found {
+				errs = append(errs, fmt.Errorf("failed to find Plugin %q in tier %d", plugin.Name, i))
+				continue
+			}
+			if enabled[plugin.Name] {
+				errs = append(errs, fmt.Errorf("plugin %s is configured more than once", plugin.Name))
+			}
+			enabled[plugin.Name] = true
+			if err := framework.ValidatePluginArguments(plugin.Name, plugin.Arguments); err != nil {
+				errs = append(errs, fmt.Errorf("invalid arguments of plugin %s: %v", plugin.Name, err))
+			}
+		}
+	}
+
+	for _, configuration := range schedulerConf.Configurations {
+		if _, found := framework.GetAction(configuration.Name); !found {
+			errs = append(errs, fmt.Errorf("failed to find Action %q of configuration", configuration.Name))
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// ValidateSchedulerConf validates the scheduler configuration and builds all its plugins with their arguments as a
+// dry run, so that the configuration is known to be loadable before it is applied.
+func ValidateSchedulerConf(confStr string) error {
+	_, tiers, _, _, err := UnmarshalSchedulerConf(confStr)
+	if err != nil {
+		return err
+	}
+
+	for _, tier := range tiers {
+		for _, plugin := range tier.Plugins {
+			if err := dryRunPluginBuilder(plugin); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func dryRunPluginBuilder(plugin conf.PluginOption) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to build plugin %s: %v", plugin.Name, r)
+		}
+	}()
+
+	pb, _ := framework.GetPluginBuilder(plugin.Name)
+	pb(plugin.Arguments)
+	return nil
+}
+
+// SchedulerConfHash returns the hash identifying the version of the scheduler configuration.
+func SchedulerConfHash(confStr string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(confStr)))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
 func runSchedulerSocket() {
 	fs := flag.CommandLine
 	startKlogLevel := fs.Lookup("v").Value.String()
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+// Reservation is the reservation of nodes for the target job elected by the reserve action,
+// it is kept across sessions until the target job is ready or the reservation times out.
+var Reservation = NewResourceReservation()
+
+// ResourceReservation records the target job and the nodes locked for it, the nodes locked
+// can only be allocated to the target job.
+type ResourceReservation struct {
+	mutex sync.RWMutex
+
+	targetJob   api.JobID
+	targetName  string
+	electedTime time.Time
+	lockedNodes sets.Set[string]
+	// expiredJobs are the jobs whose reservation timed out, with the time the reservation was released
+	expiredJobs map[api.JobID]time.Time
+}
+
+// ReservationInfo is the snapshot of the reservation, which is dumped with the scheduler cache.
+type ReservationInfo struct {
+	TargetJob   string    `json:"targetJob,omitempty"`
+	ElectedTime time.Time `json:"electedTime"`
+	LockedNodes []string  `json:"lockedNodes,omitempty"`
+}
+
+// NewResourceReservation creates an empty reservation.
+func NewResourceReservation() *ResourceReservation {
+	return &ResourceReservation{
+		lockedNodes: sets.New[string](),
+		expiredJobs: map[api.JobID]time.Time{},
+	}
+}
+
+// TargetJob returns the target job of the reservation, empty means no target job.
+func (r *ResourceReservation) TargetJob() api.JobID {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.targetJob
+}
+
+// ElectedTime returns the time when the target job was elected.
+func (r *ResourceReservation) ElectedTime() time.Time {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.electedTime
+}
+
+// SetTargetJob sets the target job of the reservation, the nodes locked for the previous target job are released.
+func (r *ResourceReservation) SetTargetJob(job *api.JobInfo, now time.Time) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.targetJob = job.UID
+	r.targetName = job.Namespace + "/" + job.Name
+	r.electedTime = now
+	r.lockedNodes = sets.New[string]()
+}
+
+// LockNode locks the node for the target job.
+func (r *ResourceReservation) LockNode(nodeName string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.targetJob != "" {
+		r.lockedNodes.Insert(nodeName)
+	}
+}
+
+// LockedNodes returns the nodes locked for the target job.
+func (r *ResourceReservation) LockedNodes() sets.Set[string] {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.lockedNodes.Clone()
+}
+
+// IsLockedForOther checks whether the node is locked for a job other than the given one.
+func (r *ResourceReservation) IsLockedForOther(nodeName string, job api.JobID) bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.targetJob != "" && r.targetJob != job && r.lockedNodes.Has(nodeName)
+}
+
+// Release resets the target job and unlocks the nodes, the target job is recorded as expired if the reservation timed out.
+func (r *ResourceReservation) Release(expired bool, now time.Time) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if expired && r.targetJob != "" {
+		r.expiredJobs[r.targetJob] = now
+	}
+	r.targetJob = ""
+	r.targetName = ""
+	r.electedTime = time.Time{}
+	r.lockedNodes = sets.New[string]()
+}
+
+// Expired checks whether the reservation of the job timed out within the cooldown, expired records older than
+// the cooldown are dropped.
+func (r *ResourceReservation) Expired(job api.JobID, cooldown time.Duration, now time.Time) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for uid, releasedTime := range r.expiredJobs {
+		if now.Sub(releasedTime) >= cooldown {
+			delete(r.expiredJobs, uid)
+		}
+	}
+	_, found := r.expiredJobs[job]
+	return found
+}
+
+// Info returns the snapshot of the reservation.
+func (r *ResourceReservation) Info() ReservationInfo {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return ReservationInfo{
+		TargetJob:   r.targetName,
+		ElectedTime: r.electedTime,
+		LockedNodes: sets.List(r.lockedNodes),
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"volcano.sh/volcano/pkg/scheduler/api"
+)
+
+func TestResourceReservation(t *testing.T) {
+	now := time.Now()
+	reservation := NewResourceReservation()
+
+	reservation.LockNode("n1")
+	if reservation.LockedNodes().Len() != 0 {
+		t.Errorf("expected no node locked without target job, got %v", sets.List(reservation.LockedNodes()))
+	}
+
+	reservation.SetTargetJob(&api.JobInfo{UID: "ns/pg1", Namespace: "ns", Name: "pg1"}, now)
+	reservation.LockNode("n1")
+	if !reservation.IsLockedForOther("n1", "ns/pg2") {
+		t.Errorf("expected n1 locked for jobs other than the target job")
+	}
+	if reservation.IsLockedForOther("n1", "ns/pg1") || reservation.IsLockedForOther("n2", "ns/pg2") {
+		t.Errorf("expected n1 not locked for the target job and n2 not locked")
+	}
+	if info := reservation.Info(); info.TargetJob != "ns/pg1" || len(info.LockedNodes) != 1 || info.LockedNodes[0] != "n1" {
+		t.Errorf("unexpected reservation info %+v", info)
+	}
+
+	reservation.Release(true, now)
+	if reservation.TargetJob() != "" || reservation.IsLockedForOther("n1", "ns/pg2") {
+		t.Errorf("expected the reservation to be released")
+	}
+	if !reservation.Expired("ns/pg1", time.Hour, now.Add(time.Minute)) {
+		t.Errorf("expected ns/pg1 expired within the cooldown")
+	}
+	if reservation.Expired("ns/pg1", time.Hour, now.Add(2*time.Hour)) {
+		t.Errorf("expected ns/pg1 not expired after the cooldown")
+	}
+}
@@ -478,6 +478,13 @@ func BuildQueue(qname string, weight int32, cap v1.ResourceList) *schedulingv1be
 	}
 }
 
+// BuildQueueWithDequeueStrategy builds a open queue with the given dequeue strategy
+func BuildQueueWithDequeueStrategy(qname string, weight int32, cap v1.ResourceList, strategy schedulingv1beta1.DequeueStrategy) *schedulingv1beta1.Queue {
+	queue := BuildQueue(qname, weight, cap)
+	queue.Spec.DequeueStrategy = strategy
+	return queue
+}
+
 func BuildQueueWithState(qname string, weight int32, cap v1.ResourceList, state schedulingv1beta1.QueueState) *schedulingv1beta1.Queue {
 	return &schedulingv1beta1.Queue{
 		ObjectMeta: metav1.ObjectMeta{
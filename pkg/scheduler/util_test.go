@@ -21,6 +21,7 @@ limitations under the License.
 package scheduler
 
 import (
+	"strings"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -253,3 +254,130 @@ tiers:
 			expectedConfigurations, configurations)
 	}
 }
+
+func TestUnmarshalSchedulerConfValidation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      string
+		expectedErr string
+	}{
+		{
+			name: "valid config",
+			config: `
+actions: "enqueue, allocate"
+tiers:
+- plugins:
+  - name: gang
+  - name: binpack
+    arguments:
+      binpack.weight: 10
+      binpack.resources: nvidia.com/gpu
+      binpack.resources.nvidia.com/gpu: 2
+configurations:
+- name: allocate
+  arguments:
+    enablePredicateErrorCache: true
+`,
+		},
+		{
+			name: "misspelled enable key",
+			config: `
+actions: "allocate"
+tiers:
+- plugins:
+  - name: gang
+    enabledJobReady: false
+`,
+			expectedErr: "field enabledJobReady not found",
+		},
+		{
+			name: "unknown plugin",
+			config: `
+actions: "allocate"
+tiers:
+- plugins:
+  - name: gangg
+`,
+			expectedErr: `failed to find Plugin "gangg"`,
+		},
+		{
+			name: "plugin configured more than once",
+			config: `
+actions: "allocate"
+tiers:
+- plugins:
+  - name: gang
+- plugins:
+  - name: gang
+`,
+			expectedErr: "plugin gang is configured more than once",
+		},
+		{
+			name: "invalid plugin arguments",
+			config: `
+actions: "allocate"
+tiers:
+- plugins:
+  - name: overcommit
+    arguments:
+      overcommit-factor: 0.5
+  - name: nodeorder
+    arguments:
+      leastrequested.weight: "1"
+`,
+			expectedErr: "invalid arguments of plugin overcommit",
+		},
+		{
+			name: "configuration of unknown action",
+			config: `
+actions: "allocate"
+tiers:
+- plugins:
+  - name: gang
+configurations:
+- name: alocate
+`,
+			expectedErr: `failed to find Action "alocate"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, _, _, err := UnmarshalSchedulerConf(tc.config)
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Errorf("expected valid config, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Errorf("expected error containing %q, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateSchedulerConf(t *testing.T) {
+	if err := ValidateSchedulerConf(DefaultSchedulerConf); err != nil {
+		t.Errorf("expected default config to be valid, got %v", err)
+	}
+
+	config := `
+actions: "allocate"
+tiers:
+- plugins:
+  - name: resource-strategy-fit
+    arguments:
+      resources: invalid
+`
+	if err := ValidateSchedulerConf(config); err == nil || !strings.Contains(err.Error(), "invalid arguments of plugin resource-strategy-fit") {
+		t.Errorf("expected invalid arguments of resource-strategy-fit, got %v", err)
+	}
+
+	if SchedulerConfHash(DefaultSchedulerConf) != SchedulerConfHash(strings.TrimSpace(DefaultSchedulerConf)) {
+		t.Errorf("expected the hash of config to ignore the leading and trailing spaces")
+	}
+	if SchedulerConfHash(DefaultSchedulerConf) == SchedulerConfHash(config) {
+		t.Errorf("expected different hashes of different configs")
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	whv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+
+	datav1alpha1 "volcano.sh/apis/pkg/apis/datadependency/v1alpha1"
+	"volcano.sh/volcano/pkg/webhooks/router"
+	"volcano.sh/volcano/pkg/webhooks/schema"
+	"volcano.sh/volcano/pkg/webhooks/util"
+)
+
+var config = &router.AdmissionServiceConfig{}
+
+var service = &router.AdmissionService{
+	Path: "/datasourceclaims/validate",
+	Func: AdmitDataSourceClaim,
+
+	Config: config,
+
+	ValidatingConfig: &whv1.ValidatingWebhookConfiguration{
+		Webhooks: []whv1.ValidatingWebhook{{
+			Name: "validatedatasourceclaim.volcano.sh",
+			Rules: []whv1.RuleWithOperations{
+				{
+					Operations: []whv1.OperationType{whv1.Create, whv1.Update},
+					Rule: whv1.Rule{
+						APIGroups:   []string{datav1alpha1.SchemeGroupVersion.Group},
+						APIVersions: []string{datav1alpha1.SchemeGroupVersion.Version},
+						Resources:   []string{"datasourceclaims"},
+					},
+				},
+			},
+		}},
+	},
+}
+
+func init() {
+	router.RegisterAdmission(service)
+}
+
+// AdmitDataSourceClaim is to admit datasourceclaim and return response.
+func AdmitDataSourceClaim(ar admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	klog.V(3).Infof("admitting datasourceclaim -- %s", ar.Request.Operation)
+
+	claim, err := schema.DecodeDataSourceClaim(ar.Request.Object, ar.Request.Resource)
+	if err != nil {
+		return util.ToAdmissionResponse(err)
+	}
+
+	switch ar.Request.Operation {
+	case admissionv1.Create, admissionv1.Update:
+		if err := validateDataSourceClaim(claim, ar.Request.Namespace); err != nil {
+			return util.ToAdmissionResponse(err)
+		}
+	}
+	return &admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+}
+
+// validateDataSourceClaim is to validate datasourceclaim. The workload must live in the namespace of the claim,
+// otherwise the claim would set the locality of a workload in a namespace its creator may not access.
+func validateDataSourceClaim(claim *datav1alpha1.DataSourceClaim, namespace string) error {
+	errs := field.ErrorList{}
+	workloadPath := field.NewPath("spec").Child("workload")
+	if claim.Spec.Workload.Namespace != "" && claim.Spec.Workload.Namespace != namespace {
+		errs = append(errs, field.Invalid(workloadPath.Child("namespace"), claim.Spec.Workload.Namespace,
+			"workload must be in the namespace of the claim"))
+	}
+
+	if len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	datav1alpha1 "volcano.sh/apis/pkg/apis/datadependency/v1alpha1"
+)
+
+func TestAdmitDataSourceClaim(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		WorkloadNamespace string
+		ExpectAllowed     bool
+	}{
+		{
+			Name:              "workload defaults to the namespace of the claim",
+			WorkloadNamespace: "",
+			ExpectAllowed:     true,
+		},
+		{
+			Name:              "workload in the namespace of the claim",
+			WorkloadNamespace: "team-a",
+			ExpectAllowed:     true,
+		},
+		{
+			Name:              "workload in another namespace",
+			WorkloadNamespace: "team-b",
+			ExpectAllowed:     false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			claim := datav1alpha1.DataSourceClaim{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: datav1alpha1.SchemeGroupVersion.String(),
+					Kind:       "DataSourceClaim",
+				},
+				ObjectMeta: metav1.ObjectMeta{Name: "claim-1"},
+				Spec: datav1alpha1.DataSourceClaimSpec{
+					System:         "hive",
+					DataSourceType: "table",
+					DataSourceName: "sales",
+					Workload: datav1alpha1.WorkloadRef{
+						APIVersion: "batch.volcano.sh/v1alpha1",
+						Kind:       "Job",
+						Name:       "job-1",
+						Namespace:  testCase.WorkloadNamespace,
+					},
+				},
+			}
+			raw, err := json.Marshal(claim)
+			if err != nil {
+				t.Fatalf("failed to marshal claim: %v", err)
+			}
+
+			ar := admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Namespace: "team-a",
+					Resource: metav1.GroupVersionResource{
+						Group:    datav1alpha1.SchemeGroupVersion.Group,
+						Version:  datav1alpha1.SchemeGroupVersion.Version,
+						Resource: "datasourceclaims",
+					},
+					Object: runtime.RawExtension{Raw: raw},
+				},
+			}
+			response := AdmitDataSourceClaim(ar)
+			if response.Allowed != testCase.ExpectAllowed {
+				t.Errorf("expected allowed %v, got %v: %v", testCase.ExpectAllowed, response.Allowed, response.Result)
+			}
+		})
+	}
+}
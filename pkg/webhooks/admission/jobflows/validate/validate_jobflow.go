@@ -18,13 +18,17 @@ package validate
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	whv1 "k8s.io/api/admissionregistration/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog/v2"
 
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	flowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
 	"volcano.sh/volcano/pkg/webhooks/router"
 	"volcano.sh/volcano/pkg/webhooks/schema"
@@ -35,8 +39,14 @@ var (
 	VertexNotDefinedError      = errors.New("vertex is not defined")
 	FlowNotDAGError            = errors.New("jobflow Flow is not DAG")
 	OperationNotCreateOrUpdate = errors.New("expect operation to be 'CREATE' or 'UPDATE'")
+	FlowParametersInvalidError = errors.New("jobflow Flow parameters are invalid")
+	FlowConditionInvalidError  = errors.New("jobflow Flow condition is invalid")
 )
 
+// finishedJobPhases are the phases a job may finish in, which the condition of a flow can be gated on.
+var finishedJobPhases = sets.New[batchv1alpha1.JobPhase](
+	batchv1alpha1.Completed, batchv1alpha1.Failed, batchv1alpha1.Terminated, batchv1alpha1.Aborted)
+
 func init() {
 	router.RegisterAdmission(service)
 }
@@ -80,6 +90,9 @@ func AdmitJobFlows(ar admissionv1.AdmissionReview) *admissionv1.AdmissionRespons
 	switch ar.Request.Operation {
 	case admissionv1.Create, admissionv1.Update:
 		msg = validateJobFlowDAG(jobFlow, &reviewResponse)
+		if reviewResponse.Allowed {
+			msg = validateFlows(jobFlow, &reviewResponse)
+		}
 	default:
 		err := OperationNotCreateOrUpdate
 		return util.ToAdmissionResponse(err)
@@ -120,3 +133,95 @@ func validateJobFlowDAG(jobflow *flowv1alpha1.JobFlow, reviewResponse *admission
 	}
 	return msg
 }
+
+// validateFlows validates the parameters and conditions of the flows.
+func validateFlows(jobflow *flowv1alpha1.JobFlow, reviewResponse *admissionv1.AdmissionResponse) string {
+	var msgs []string
+
+	// the fan-out jobs must not share names with the jobs of the other flows
+	jobNames := sets.New[string]()
+	for _, flow := range jobflow.Spec.Flows {
+		if len(flow.Parameters) == 0 {
+			jobNames.Insert(jobflow.Name + "-" + flow.Name)
+		}
+	}
+	for _, flow := range jobflow.Spec.Flows {
+		msgs = append(msgs, validateFlowParameters(jobflow.Name, flow, jobNames)...)
+		msgs = append(msgs, validateFlowCondition(flow)...)
+	}
+
+	if len(msgs) > 0 {
+		reviewResponse.Allowed = false
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func validateFlowParameters(jobFlowName string, flow flowv1alpha1.Flow, jobNames sets.Set[string]) []string {
+	var msgs []string
+	names := sets.New[string]()
+	for _, parameter := range flow.Parameters {
+		if errs := validation.IsDNS1123Label(parameter.Name); len(errs) > 0 {
+			msgs = append(msgs, fmt.Sprintf("%s: name %q of flow %s: %s",
+				FlowParametersInvalidError.Error(), parameter.Name, flow.Name, strings.Join(errs, ", ")))
+			continue
+		}
+		if names.Has(parameter.Name) {
+			msgs = append(msgs, fmt.Sprintf("%s: duplicated name %s in flow %s",
+				FlowParametersInvalidError.Error(), parameter.Name, flow.Name))
+			continue
+		}
+		names.Insert(parameter.Name)
+
+		jobName := jobFlowName + "-" + flow.Name + "-" + parameter.Name
+		if errs := validation.IsDNS1123Label(jobName); len(errs) > 0 {
+			msgs = append(msgs, fmt.Sprintf("%s: job name %s of flow %s: %s",
+				FlowParametersInvalidError.Error(), jobName, flow.Name, strings.Join(errs, ", ")))
+		}
+		if jobNames.Has(jobName) {
+			msgs = append(msgs, fmt.Sprintf("%s: job name %s of flow %s conflicts with another job",
+				FlowParametersInvalidError.Error(), jobName, flow.Name))
+		}
+		jobNames.Insert(jobName)
+
+		for key := range parameter.Values {
+			if errs := validation.IsEnvVarName(key); len(errs) > 0 {
+				msgs = append(msgs, fmt.Sprintf("%s: value %q of parameter %s in flow %s: %s",
+					FlowParametersInvalidError.Error(), key, parameter.Name, flow.Name, strings.Join(errs, ", ")))
+			}
+		}
+	}
+	return msgs
+}
+
+func validateFlowCondition(flow flowv1alpha1.Flow) []string {
+	if flow.When == nil {
+		return nil
+	}
+
+	var msgs []string
+	if len(flow.When.Requirements) == 0 {
+		msgs = append(msgs, fmt.Sprintf("%s: no requirement in flow %s", FlowConditionInvalidError.Error(), flow.Name))
+	}
+
+	targets := sets.New[string]()
+	if flow.DependsOn != nil {
+		targets.Insert(flow.DependsOn.Targets...)
+	}
+	for _, requirement := range flow.When.Requirements {
+		if !targets.Has(requirement.Target) {
+			msgs = append(msgs, fmt.Sprintf("%s: target %s of flow %s is not depended on",
+				FlowConditionInvalidError.Error(), requirement.Target, flow.Name))
+		}
+		if requirement.Phase != "" && !finishedJobPhases.Has(requirement.Phase) {
+			msgs = append(msgs, fmt.Sprintf("%s: phase %s of target %s in flow %s is not one of %v",
+				FlowConditionInvalidError.Error(), requirement.Phase, requirement.Target, flow.Name, sets.List(finishedJobPhases)))
+		}
+		for _, exitCode := range requirement.ExitCodes {
+			if exitCode < 0 || exitCode > 255 {
+				msgs = append(msgs, fmt.Sprintf("%s: exit code %d of target %s in flow %s is out of range [0, 255]",
+					FlowConditionInvalidError.Error(), exitCode, requirement.Target, flow.Name))
+			}
+		}
+	}
+	return msgs
+}
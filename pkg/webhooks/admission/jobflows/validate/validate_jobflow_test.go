@@ -24,6 +24,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 	flowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
 	schedulingv1beta2 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	fakeclient "volcano.sh/apis/pkg/client/clientset/versioned/fake"
@@ -313,3 +314,127 @@ func TestValidateJobFlowCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFlows(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Flows     []flowv1alpha1.Flow
+		ExpectErr bool
+		ret       string
+	}{
+		{
+			Name: "validate fan-out flow and conditional flow",
+			Flows: []flowv1alpha1.Flow{
+				{
+					Name: "sweep",
+					Parameters: []flowv1alpha1.FlowParameter{
+						{Name: "lr-1", Values: map[string]string{"LR": "0.1"}},
+						{Name: "lr-2", Values: map[string]string{"LR": "0.01"}},
+					},
+				},
+				{
+					Name:      "alert",
+					DependsOn: &flowv1alpha1.DependsOn{Targets: []string{"sweep"}},
+					When: &flowv1alpha1.FlowCondition{Requirements: []flowv1alpha1.ConditionRequirement{
+						{Target: "sweep", Phase: batchv1alpha1.Failed, ExitCodes: []int32{137}},
+					}},
+				},
+			},
+			ExpectErr: false,
+		},
+		{
+			Name: "validate duplicated parameter name",
+			Flows: []flowv1alpha1.Flow{
+				{Name: "sweep", Parameters: []flowv1alpha1.FlowParameter{{Name: "a"}, {Name: "a"}}},
+			},
+			ExpectErr: true,
+			ret:       "duplicated name a in flow sweep",
+		},
+		{
+			Name: "validate invalid parameter name",
+			Flows: []flowv1alpha1.Flow{
+				{Name: "sweep", Parameters: []flowv1alpha1.FlowParameter{{Name: "A_1"}}},
+			},
+			ExpectErr: true,
+			ret:       FlowParametersInvalidError.Error(),
+		},
+		{
+			Name: "validate invalid parameter value name",
+			Flows: []flowv1alpha1.Flow{
+				{Name: "sweep", Parameters: []flowv1alpha1.FlowParameter{{Name: "a", Values: map[string]string{"1LR": "0.1"}}}},
+			},
+			ExpectErr: true,
+			ret:       "value \"1LR\" of parameter a in flow sweep",
+		},
+		{
+			Name: "validate fan-out job name not a DNS label",
+			Flows: []flowv1alpha1.Flow{
+				{Name: "sweep.v1", Parameters: []flowv1alpha1.FlowParameter{{Name: "a"}}},
+			},
+			ExpectErr: true,
+			ret:       "job name jobflow-sweep.v1-a of flow sweep.v1",
+		},
+		{
+			Name: "validate fan-out job name conflict",
+			Flows: []flowv1alpha1.Flow{
+				{Name: "sweep", Parameters: []flowv1alpha1.FlowParameter{{Name: "a"}}},
+				{Name: "sweep-a"},
+			},
+			ExpectErr: true,
+			ret:       "job name jobflow-sweep-a of flow sweep conflicts with another job",
+		},
+		{
+			Name: "validate condition target not depended on",
+			Flows: []flowv1alpha1.Flow{
+				{Name: "a"},
+				{Name: "b", When: &flowv1alpha1.FlowCondition{Requirements: []flowv1alpha1.ConditionRequirement{{Target: "a"}}}},
+			},
+			ExpectErr: true,
+			ret:       "target a of flow b is not depended on",
+		},
+		{
+			Name: "validate condition with unfinished phase and invalid exit code",
+			Flows: []flowv1alpha1.Flow{
+				{Name: "a"},
+				{
+					Name:      "b",
+					DependsOn: &flowv1alpha1.DependsOn{Targets: []string{"a"}},
+					When: &flowv1alpha1.FlowCondition{Requirements: []flowv1alpha1.ConditionRequirement{
+						{Target: "a", Phase: batchv1alpha1.Running, ExitCodes: []int32{256}},
+					}},
+				},
+			},
+			ExpectErr: true,
+			ret:       "phase Running of target a in flow b",
+		},
+		{
+			Name: "validate condition without requirement",
+			Flows: []flowv1alpha1.Flow{
+				{Name: "a"},
+				{Name: "b", DependsOn: &flowv1alpha1.DependsOn{Targets: []string{"a"}}, When: &flowv1alpha1.FlowCondition{}},
+			},
+			ExpectErr: true,
+			ret:       "no requirement in flow b",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			jobFlow := &flowv1alpha1.JobFlow{
+				ObjectMeta: metav1.ObjectMeta{Name: "jobflow", Namespace: "test"},
+				Spec:       flowv1alpha1.JobFlowSpec{Flows: testCase.Flows},
+			}
+			reviewResponse := admissionv1.AdmissionResponse{Allowed: true}
+			ret := validateFlows(jobFlow, &reviewResponse)
+			if testCase.ExpectErr {
+				if reviewResponse.Allowed || !strings.Contains(ret, testCase.ret) {
+					t.Errorf("Expect error msg :%s, but got %v, allowed %v", testCase.ret, ret, reviewResponse.Allowed)
+				}
+				return
+			}
+			if ret != "" || !reviewResponse.Allowed {
+				t.Errorf("Expect no error, but got error %v", ret)
+			}
+		})
+	}
+}
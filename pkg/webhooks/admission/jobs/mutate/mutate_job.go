@@ -27,6 +27,9 @@ import (
 	"k8s.io/klog/v2"
 
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/deepspeed"
+	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/horovod"
+	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/jax"
 	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/mpi"
 	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/pytorch"
 	"volcano.sh/volcano/pkg/controllers/job/plugins/distributed-framework/ray"
@@ -237,19 +240,23 @@ func patchDefaultPlugins(job *v1alpha1.Job) *patchOperation {
 		plugins[k] = v
 	}
 
-	// Because the tensorflow-plugin, mpi-plugin and pytorch-plugin depend on svc-plugin.
-	// If the svc-plugin is not defined, we should add it.
+	// Because the tensorflow-plugin, mpi-plugin, pytorch-plugin and the other distributed framework plugins
+	// depend on svc-plugin. If the svc-plugin is not defined, we should add it.
 	_, hasTf := job.Spec.Plugins[tensorflow.TFPluginName]
 	_, hasMPI := job.Spec.Plugins[mpi.MPIPluginName]
 	_, hasPytorch := job.Spec.Plugins[pytorch.PytorchPluginName]
 	_, hasRay := job.Spec.Plugins[ray.RayPluginName]
-	if hasTf || hasMPI || hasPytorch || hasRay {
+	_, hasJAX := job.Spec.Plugins[jax.JAXPluginName]
+	_, hasDeepSpeed := job.Spec.Plugins[deepspeed.DeepSpeedPluginName]
+	_, hasHorovod := job.Spec.Plugins[horovod.HorovodPluginName]
+	if hasTf || hasMPI || hasPytorch || hasRay || hasJAX || hasDeepSpeed || hasHorovod {
 		if _, ok := plugins["svc"]; !ok {
 			plugins["svc"] = []string{}
 		}
 	}
 
-	if _, ok := job.Spec.Plugins["mpi"]; ok {
+	// The launchers of mpi, deepspeed and horovod reach the workers over ssh.
+	if hasMPI || hasDeepSpeed || hasHorovod {
 		if _, ok := plugins["ssh"]; !ok {
 			plugins["ssh"] = []string{}
 		}
@@ -434,3 +434,59 @@ func TestMutateSpecPartitionPolicyDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestPatchDefaultPlugins(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		Plugins         map[string][]string
+		ExpectedPlugins []string
+	}{
+		{
+			Name:            "jax plugin depends on svc plugin",
+			Plugins:         map[string][]string{"jax": {}},
+			ExpectedPlugins: []string{"jax", "svc"},
+		},
+		{
+			Name:            "deepspeed plugin depends on svc and ssh plugins",
+			Plugins:         map[string][]string{"deepspeed": {}},
+			ExpectedPlugins: []string{"deepspeed", "svc", "ssh"},
+		},
+		{
+			Name:            "horovod plugin depends on svc and ssh plugins",
+			Plugins:         map[string][]string{"horovod": {"--slots=4"}, "ssh": {"--no-root"}},
+			ExpectedPlugins: []string{"horovod", "svc", "ssh"},
+		},
+		{
+			Name:            "env plugin has no dependency",
+			Plugins:         map[string][]string{"env": {}},
+			ExpectedPlugins: []string{"env"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			job := &v1alpha1.Job{Spec: v1alpha1.JobSpec{Plugins: testCase.Plugins}}
+			ret := patchDefaultPlugins(job)
+			if ret == nil {
+				t.Fatalf("expected patch operation of plugins, but got nil")
+			}
+			plugins, ok := ret.Value.(map[string][]string)
+			if !ok {
+				t.Fatalf("expected plugins in patch value, but got %v", ret.Value)
+			}
+			if len(plugins) != len(testCase.ExpectedPlugins) {
+				t.Errorf("expected plugins %v, but got %v", testCase.ExpectedPlugins, plugins)
+			}
+			for _, name := range testCase.ExpectedPlugins {
+				if _, found := plugins[name]; !found {
+					t.Errorf("expected plugin %s in %v", name, plugins)
+				}
+			}
+			for name, args := range testCase.Plugins {
+				if len(plugins[name]) != len(args) {
+					t.Errorf("expected arguments %v of plugin %s kept, but got %v", args, name, plugins[name])
+				}
+			}
+		})
+	}
+}
@@ -132,6 +132,11 @@ func validateJobCreate(job *v1alpha1.Job, reviewResponse *admissionv1.AdmissionR
 		return "No task specified in job spec"
 	}
 
+	if job.Spec.RunningEstimate != nil && job.Spec.RunningEstimate.Duration <= 0 {
+		reviewResponse.Allowed = false
+		return "runningEstimate must be a positive duration"
+	}
+
 	if _, ok := job.Spec.Plugins[controllerMpi.MPIPluginName]; ok {
 		mp := controllerMpi.NewInstance(job.Spec.Plugins[controllerMpi.MPIPluginName])
 		masterIndex := jobhelpers.GetTaskIndexUnderJob(mp.GetMasterName(), job)
@@ -280,6 +285,7 @@ func validateJobUpdate(old, new *v1alpha1.Job) error {
 	// other fields under spec are not allowed to mutate
 	new.Spec.MinAvailable = old.Spec.MinAvailable
 	new.Spec.PriorityClassName = old.Spec.PriorityClassName
+	new.Spec.Suspend = old.Spec.Suspend
 
 	// K8S also permit mutating spec.schedulingGates
 	// We do not support this for vcjob  (More details in design doc pod-scheduling-readiness.md)
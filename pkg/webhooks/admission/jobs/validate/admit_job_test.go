@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	v1 "k8s.io/api/core/v1"
@@ -396,6 +397,41 @@ func TestValidateJobCreate(t *testing.T) {
 			ret:            "No task specified in job spec",
 			ExpectErr:      true,
 		},
+		// running estimate is not positive
+		{
+			Name:                     "non-positive-running-estimate",
+			PodLevelResourcesEnabled: false,
+			Job: v1alpha1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "non-positive-running-estimate",
+					Namespace: namespace,
+				},
+				Spec: v1alpha1.JobSpec{
+					MinAvailable:    1,
+					Queue:           "default",
+					RunningEstimate: &metav1.Duration{Duration: -time.Minute},
+					Tasks: []v1alpha1.TaskSpec{
+						{
+							Name:     "task-1",
+							Replicas: 1,
+							Template: v1.PodTemplateSpec{
+								Spec: v1.PodSpec{
+									Containers: []v1.Container{
+										{
+											Name:  "fake-name",
+											Image: "busybox:1.24",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			reviewResponse: admissionv1.AdmissionResponse{Allowed: false},
+			ret:            "runningEstimate must be a positive duration",
+			ExpectErr:      true,
+		},
 		// replica set less than zero
 		{
 			Name:                     "replica-lessThanZero",
@@ -2232,6 +2268,7 @@ func TestValidateJobUpdate(t *testing.T) {
 		addTask        bool
 		mutateTaskName bool
 		mutateSpec     bool
+		suspend        bool
 		expectErr      bool
 	}{
 		{
@@ -2290,6 +2327,13 @@ func TestValidateJobUpdate(t *testing.T) {
 			mutateSpec:     true,
 			expectErr:      true,
 		},
+		{
+			name:         "suspend",
+			replicas:     5,
+			minAvailable: 5,
+			suspend:      true,
+			expectErr:    false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2327,6 +2371,9 @@ func TestValidateJobUpdate(t *testing.T) {
 			if tc.mutateSpec {
 				new.Spec.Queue = "mutated-queue"
 			}
+			if tc.suspend {
+				new.Spec.Suspend = &tc.suspend
+			}
 
 			err := validateJobUpdate(old, new)
 			if err != nil && !tc.expectErr {
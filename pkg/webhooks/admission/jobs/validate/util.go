@@ -51,6 +51,7 @@ var policyActionMap = map[busv1alpha1.Action]bool{
 	busv1alpha1.RestartPartitionAction: true,
 	busv1alpha1.TerminateJobAction:     true,
 	busv1alpha1.CompleteJobAction:      true,
+	busv1alpha1.SuspendJobAction:       true,
 	busv1alpha1.ResumeJobAction:        true,
 	busv1alpha1.SyncJobAction:          false,
 	busv1alpha1.EnqueueAction:          false,
@@ -65,16 +66,26 @@ func validatePolicies(policies []batchv1alpha1.LifecyclePolicy, fldPath *field.P
 	exitCodes := map[int32]struct{}{}
 
 	for _, policy := range policies {
-		if (policy.Event != "" || len(policy.Events) != 0) && policy.ExitCode != nil {
+		if (policy.Event != "" || len(policy.Events) != 0) && (policy.ExitCode != nil || policy.ExitCodes != nil) {
 			err = multierror.Append(err, fmt.Errorf("must not specify event and exitCode simultaneously"))
 			break
 		}
 
-		if policy.Event == "" && len(policy.Events) == 0 && policy.ExitCode == nil {
+		if policy.ExitCode != nil && policy.ExitCodes != nil {
+			err = multierror.Append(err, fmt.Errorf("must not specify exitCode and exitCodes simultaneously"))
+			break
+		}
+
+		if policy.Event == "" && len(policy.Events) == 0 && policy.ExitCode == nil && policy.ExitCodes == nil {
 			err = multierror.Append(err, fmt.Errorf("either event and exitCode should be specified"))
 			break
 		}
 
+		if backoffErr := validateBackoff(policy, fldPath.Child("backoff")); backoffErr != nil {
+			err = multierror.Append(err, backoffErr)
+			break
+		}
+
 		if len(policy.Event) != 0 || len(policy.Events) != 0 {
 			bFlag := false
 			policyEventsList := getEventList(policy)
@@ -101,6 +112,11 @@ func validatePolicies(policies []batchv1alpha1.LifecyclePolicy, fldPath *field.P
 			if bFlag {
 				break
 			}
+		} else if policy.ExitCodes != nil {
+			if exitCodesErr := validateExitCodes(policy.ExitCodes, fldPath.Child("exitCodes")); exitCodesErr != nil {
+				err = multierror.Append(err, exitCodesErr)
+				break
+			}
 		} else {
 			if *policy.ExitCode == 0 {
 				err = multierror.Append(err, fmt.Errorf("0 is not a valid error code"))
@@ -122,6 +138,71 @@ func validatePolicies(policies []batchv1alpha1.LifecyclePolicy, fldPath *field.P
 	return err
 }
 
+// validateExitCodes validates the exit codes requirement of a policy.
+func validateExitCodes(requirement *batchv1alpha1.ExitCodeRequirement, fldPath *field.Path) error {
+	if requirement.ContainerName != nil && len(*requirement.ContainerName) == 0 {
+		return field.Invalid(fldPath.Child("containerName"), *requirement.ContainerName, "container name must not be empty")
+	}
+
+	switch requirement.Operator {
+	case "", batchv1alpha1.ExitCodeOpIn, batchv1alpha1.ExitCodeOpNotIn:
+	default:
+		return field.NotSupported(fldPath.Child("operator"), requirement.Operator,
+			[]string{string(batchv1alpha1.ExitCodeOpIn), string(batchv1alpha1.ExitCodeOpNotIn)})
+	}
+
+	if len(requirement.Values) == 0 && len(requirement.Ranges) == 0 {
+		return field.Required(fldPath, "either values or ranges should be specified")
+	}
+
+	matchesZero := false
+	for _, value := range requirement.Values {
+		if value == 0 {
+			matchesZero = true
+		}
+	}
+	for i, r := range requirement.Ranges {
+		if r.Min > r.Max {
+			return field.Invalid(fldPath.Child("ranges").Index(i), r, "min must not be greater than max")
+		}
+		if r.Min <= 0 && r.Max >= 0 {
+			matchesZero = true
+		}
+	}
+	// 0 is not an error code, it is only allowed to be excluded by NotIn.
+	if matchesZero && requirement.Operator != batchv1alpha1.ExitCodeOpNotIn {
+		return fmt.Errorf("0 is not a valid error code")
+	}
+
+	return nil
+}
+
+// validateBackoff validates the backoff of a policy, which is only valid for the restart actions.
+func validateBackoff(policy batchv1alpha1.LifecyclePolicy, fldPath *field.Path) error {
+	backoff := policy.Backoff
+	if backoff == nil {
+		return nil
+	}
+
+	switch policy.Action {
+	case busv1alpha1.RestartJobAction, busv1alpha1.RestartTaskAction, busv1alpha1.RestartPodAction, busv1alpha1.RestartPartitionAction:
+	default:
+		return field.Invalid(fldPath, policy.Action, "backoff is only valid for restart actions")
+	}
+
+	if backoff.InitialDelay != nil && backoff.InitialDelay.Duration <= 0 {
+		return field.Invalid(fldPath.Child("initialDelay"), backoff.InitialDelay.Duration.String(), "must be greater than 0")
+	}
+	if backoff.MaxDelay != nil && backoff.MaxDelay.Duration <= 0 {
+		return field.Invalid(fldPath.Child("maxDelay"), backoff.MaxDelay.Duration.String(), "must be greater than 0")
+	}
+	if backoff.InitialDelay != nil && backoff.MaxDelay != nil && backoff.MaxDelay.Duration < backoff.InitialDelay.Duration {
+		return field.Invalid(fldPath.Child("maxDelay"), backoff.MaxDelay.Duration.String(), "must not be less than initialDelay")
+	}
+
+	return nil
+}
+
 func getEventList(policy batchv1alpha1.LifecyclePolicy) []busv1alpha1.Event {
 	policyEventsList := policy.Events
 	if len(policy.Event) > 0 {
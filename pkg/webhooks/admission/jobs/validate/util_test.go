@@ -17,10 +17,16 @@ limitations under the License.
 package validate
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	busv1alpha1 "volcano.sh/apis/pkg/apis/bus/v1alpha1"
 )
 
 func TestTopoSort(t *testing.T) {
@@ -150,3 +156,163 @@ func TestTopoSort(t *testing.T) {
 		}
 	}
 }
+
+func TestValidatePolicies(t *testing.T) {
+	exitCode := int32(1)
+	containerName := ""
+	duration := func(d time.Duration) *metav1.Duration {
+		return &metav1.Duration{Duration: d}
+	}
+
+	testCases := []struct {
+		name     string
+		policies []v1alpha1.LifecyclePolicy
+		err      string
+	}{
+		{
+			name: "exit codes with values and ranges",
+			policies: []v1alpha1.LifecyclePolicy{
+				{
+					Action: busv1alpha1.RestartJobAction,
+					ExitCodes: &v1alpha1.ExitCodeRequirement{
+						Values: []int32{1, 2},
+						Ranges: []v1alpha1.ExitCodeRange{{Min: 128, Max: 255}},
+					},
+					Backoff: &v1alpha1.RetryBackoff{InitialDelay: duration(time.Second), MaxDelay: duration(time.Minute)},
+				},
+			},
+		},
+		{
+			name: "exit codes not in a range containing 0",
+			policies: []v1alpha1.LifecyclePolicy{
+				{
+					Action: busv1alpha1.AbortJobAction,
+					ExitCodes: &v1alpha1.ExitCodeRequirement{
+						Operator: v1alpha1.ExitCodeOpNotIn,
+						Ranges:   []v1alpha1.ExitCodeRange{{Min: 0, Max: 10}},
+					},
+				},
+			},
+		},
+		{
+			name: "exit codes with event",
+			policies: []v1alpha1.LifecyclePolicy{
+				{
+					Action:    busv1alpha1.RestartJobAction,
+					Event:     busv1alpha1.PodFailedEvent,
+					ExitCodes: &v1alpha1.ExitCodeRequirement{Values: []int32{1}},
+				},
+			},
+			err: "must not specify event and exitCode simultaneously",
+		},
+		{
+			name: "exit codes with exit code",
+			policies: []v1alpha1.LifecyclePolicy{
+				{
+					Action:    busv1alpha1.RestartJobAction,
+					ExitCode:  &exitCode,
+					ExitCodes: &v1alpha1.ExitCodeRequirement{Values: []int32{1}},
+				},
+			},
+			err: "must not specify exitCode and exitCodes simultaneously",
+		},
+		{
+			name: "exit codes without values",
+			policies: []v1alpha1.LifecyclePolicy{
+				{
+					Action:    busv1alpha1.RestartJobAction,
+					ExitCodes: &v1alpha1.ExitCodeRequirement{},
+				},
+			},
+			err: "either values or ranges should be specified",
+		},
+		{
+			name: "exit codes in a range containing 0",
+			policies: []v1alpha1.LifecyclePolicy{
+				{
+					Action:    busv1alpha1.RestartJobAction,
+					ExitCodes: &v1alpha1.ExitCodeRequirement{Ranges: []v1alpha1.ExitCodeRange{{Min: 0, Max: 10}}},
+				},
+			},
+			err: "0 is not a valid error code",
+		},
+		{
+			name: "exit codes with invalid range",
+			policies: []v1alpha1.LifecyclePolicy{
+				{
+					Action:    busv1alpha1.RestartJobAction,
+					ExitCodes: &v1alpha1.ExitCodeRequirement{Ranges: []v1alpha1.ExitCodeRange{{Min: 10, Max: 1}}},
+				},
+			},
+			err: "min must not be greater than max",
+		},
+		{
+			name: "exit codes with invalid operator",
+			policies: []v1alpha1.LifecyclePolicy{
+				{
+					Action:    busv1alpha1.RestartJobAction,
+					ExitCodes: &v1alpha1.ExitCodeRequirement{Operator: "Exists", Values: []int32{1}},
+				},
+			},
+			err: "Unsupported value",
+		},
+		{
+			name: "exit codes with empty container name",
+			policies: []v1alpha1.LifecyclePolicy{
+				{
+					Action:    busv1alpha1.RestartJobAction,
+					ExitCodes: &v1alpha1.ExitCodeRequirement{ContainerName: &containerName, Values: []int32{1}},
+				},
+			},
+			err: "container name must not be empty",
+		},
+		{
+			name: "backoff of non-restart action",
+			policies: []v1alpha1.LifecyclePolicy{
+				{
+					Action:  busv1alpha1.AbortJobAction,
+					Event:   busv1alpha1.PodFailedEvent,
+					Backoff: &v1alpha1.RetryBackoff{},
+				},
+			},
+			err: "backoff is only valid for restart actions",
+		},
+		{
+			name: "backoff with max delay less than initial delay",
+			policies: []v1alpha1.LifecyclePolicy{
+				{
+					Action:  busv1alpha1.RestartPodAction,
+					Event:   busv1alpha1.PodFailedEvent,
+					Backoff: &v1alpha1.RetryBackoff{InitialDelay: duration(time.Minute), MaxDelay: duration(time.Second)},
+				},
+			},
+			err: "must not be less than initialDelay",
+		},
+		{
+			name: "backoff with negative initial delay",
+			policies: []v1alpha1.LifecyclePolicy{
+				{
+					Action:  busv1alpha1.RestartPodAction,
+					Event:   busv1alpha1.PodFailedEvent,
+					Backoff: &v1alpha1.RetryBackoff{InitialDelay: duration(-time.Second)},
+				},
+			},
+			err: "must be greater than 0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validatePolicies(testCase.policies, field.NewPath("spec.policies"))
+			if testCase.err == "" {
+				if err != nil {
+					t.Errorf("expect no error, but got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), testCase.err) {
+				t.Errorf("expect error %q, but got %v", testCase.err, err)
+			}
+		})
+	}
+}
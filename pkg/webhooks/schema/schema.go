@@ -29,6 +29,7 @@ import (
 	corev1 "k8s.io/kubernetes/pkg/apis/core/v1"
 
 	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+	datav1alpha1 "volcano.sh/apis/pkg/apis/datadependency/v1alpha1"
 	flowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
 	schedulingv1beta1 "volcano.sh/apis/pkg/apis/scheduling/v1beta1"
 	hypernodev1alpha1 "volcano.sh/apis/pkg/apis/topology/v1alpha1"
@@ -173,6 +174,27 @@ func DecodeHyperNode(object runtime.RawExtension, resource metav1.GroupVersionRe
 	return &hypernode, nil
 }
 
+// DecodeDataSourceClaim decodes the datasourceclaim using deserializer from the raw object.
+func DecodeDataSourceClaim(object runtime.RawExtension, resource metav1.GroupVersionResource) (*datav1alpha1.DataSourceClaim, error) {
+	claimResource := metav1.GroupVersionResource{
+		Group:    datav1alpha1.SchemeGroupVersion.Group,
+		Version:  datav1alpha1.SchemeGroupVersion.Version,
+		Resource: "datasourceclaims",
+	}
+
+	if resource != claimResource {
+		klog.Errorf("expect resource to be %s", claimResource)
+		return nil, fmt.Errorf("expect resource to be %s", claimResource)
+	}
+
+	claim := datav1alpha1.DataSourceClaim{}
+	if _, _, err := Codecs.UniversalDeserializer().Decode(object.Raw, nil, &claim); err != nil {
+		return nil, err
+	}
+
+	return &claim, nil
+}
+
 // DecodeJobFlow decodes the job using deserializer from the raw object.
 func DecodeJobFlow(object runtime.RawExtension, resource metav1.GroupVersionResource) (*flowv1alpha1.JobFlow, error) {
 	jobFlowResource := metav1.GroupVersionResource{Group: flowv1alpha1.SchemeGroupVersion.Group, Version: flowv1alpha1.SchemeGroupVersion.Version, Resource: "jobflows"}
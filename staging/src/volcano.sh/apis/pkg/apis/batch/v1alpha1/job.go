@@ -123,6 +123,22 @@ type JobSpec struct {
 	// NetworkTopology defines the NetworkTopology config, this field works in conjunction with network topology feature and hyperNode CRD.
 	// +optional
 	NetworkTopology *NetworkTopologySpec `json:"networkTopology,omitempty" protobuf:"bytes,13,opt,name=networkTopology"`
+
+	// Specifies the maximum number of retries caused by infrastructure failures before marking this Job failed,
+	// i.e. the pods evicted, the pods on lost nodes and the containers OOMKilled. Once it is set, these retries are
+	// counted by InfrastructureRetryCount instead of RetryCount, so that they do not consume MaxRetry.
+	// Default to nil (all the retries are counted against MaxRetry).
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxInfrastructureRetry *int32 `json:"maxInfrastructureRetry,omitempty" protobuf:"varint,14,opt,name=maxInfrastructureRetry"`
+
+	// Suspend specifies whether the Job controller should run the pods of the Job. Once it is set to true,
+	// the pods of the Job are deleted and its PodGroup is kept with its creation ordering, so that the Job
+	// resumes from its place in the queue once it is set to false again. The PodGroup of a suspended Job
+	// is not accounted by its queue.
+	// Default to false.
+	// +optional
+	Suspend *bool `json:"suspend,omitempty" protobuf:"varint,15,opt,name=suspend"`
 }
 
 // NetworkTopologyMode represents the networkTopology mode, valid values are "hard" and "soft".
@@ -214,7 +230,7 @@ type LifecyclePolicy struct {
 
 	// The exit code of the pod container, controller will take action
 	// according to this code.
-	// Note: only one of `Event` or `ExitCode` can be specified.
+	// Note: only one of `Event`, `ExitCode` or `ExitCodes` can be specified.
 	// +optional
 	ExitCode *int32 `json:"exitCode,omitempty" protobuf:"bytes,4,opt,name=exitCode"`
 
@@ -222,6 +238,74 @@ type LifecyclePolicy struct {
 	// Default to nil (take action immediately).
 	// +optional
 	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,5,opt,name=timeout"`
+
+	// ExitCodes matches the exit codes of the containers of the failed pod, controller
+	// will take action if any of the containers matches.
+	// Note: only one of `Event`, `ExitCode` or `ExitCodes` can be specified.
+	// +optional
+	ExitCodes *ExitCodeRequirement `json:"exitCodes,omitempty" protobuf:"bytes,6,opt,name=exitCodes"`
+
+	// Backoff delays the restart actions of the policy exponentially with the retries of the Job.
+	// Default to nil (restart immediately).
+	// +optional
+	Backoff *RetryBackoff `json:"backoff,omitempty" protobuf:"bytes,7,opt,name=backoff"`
+}
+
+// ExitCodeOperator is the relationship between the exit code of a container and the values.
+// +kubebuilder:validation:Enum=In;NotIn
+type ExitCodeOperator string
+
+const (
+	// ExitCodeOpIn matches the exit codes in the values.
+	ExitCodeOpIn ExitCodeOperator = "In"
+	// ExitCodeOpNotIn matches the exit codes out of the values.
+	ExitCodeOpNotIn ExitCodeOperator = "NotIn"
+)
+
+// ExitCodeRequirement describes the exit codes of the containers to match. The containers
+// exited with 0 are never matched.
+type ExitCodeRequirement struct {
+	// ContainerName restricts the check of exit codes to the container with this name.
+	// Default to nil (all the containers are checked).
+	// +optional
+	ContainerName *string `json:"containerName,omitempty" protobuf:"bytes,1,opt,name=containerName"`
+
+	// Operator is the relationship between the exit code of a container and the values.
+	// Default to In.
+	// +optional
+	Operator ExitCodeOperator `json:"operator,omitempty" protobuf:"bytes,2,opt,name=operator"`
+
+	// Values is the set of the exit codes.
+	// +optional
+	Values []int32 `json:"values,omitempty" protobuf:"varint,3,rep,name=values"`
+
+	// Ranges is the set of the exit code ranges.
+	// +optional
+	Ranges []ExitCodeRange `json:"ranges,omitempty" protobuf:"bytes,4,rep,name=ranges"`
+}
+
+// ExitCodeRange is a closed range of exit codes.
+type ExitCodeRange struct {
+	// Min is the lowest exit code of the range.
+	Min int32 `json:"min" protobuf:"varint,1,opt,name=min"`
+
+	// Max is the highest exit code of the range.
+	Max int32 `json:"max" protobuf:"varint,2,opt,name=max"`
+}
+
+// RetryBackoff specifies the exponential backoff between the restarts of a Job. The delay of
+// a restart is InitialDelay doubled for each retry of the Job of the same kind, i.e. the
+// infrastructure retries or the others, and capped by MaxDelay.
+type RetryBackoff struct {
+	// InitialDelay is the delay of the first restart.
+	// Default to 10s.
+	// +optional
+	InitialDelay *metav1.Duration `json:"initialDelay,omitempty" protobuf:"bytes,1,opt,name=initialDelay"`
+
+	// MaxDelay is the upper bound of the delay.
+	// Default to 5m.
+	// +optional
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty" protobuf:"bytes,2,opt,name=maxDelay"`
 }
 
 // +kubebuilder:validation:Enum=none;best-effort;restricted;single-numa-node
@@ -326,12 +410,23 @@ const (
 	Terminated JobPhase = "Terminated"
 	// Failed is the phase that the job is restarted failed reached the maximum number of retries.
 	Failed JobPhase = "Failed"
+	// Suspending is the phase that the job is suspended, waiting for releasing pods
+	Suspending JobPhase = "Suspending"
+	// Suspended is the phase that the job is suspended by user or the scheduler, its PodGroup is kept in the queue
+	Suspended JobPhase = "Suspended"
+)
+
+const (
+	// SuspendReasonUserRequested is the suspend reason of the job suspended by its spec or the SuspendJob command.
+	SuspendReasonUserRequested = "UserRequested"
+	// SuspendReasonReclaimed is the suspend reason of the job suspended by the scheduler when its queue is reclaimed.
+	SuspendReasonReclaimed = "Reclaimed"
 )
 
 // JobState contains details for the current state of the job.
 type JobState struct {
 	// The phase of Job.
-	// +kubebuilder:validation:Enum=Pending;Aborting;Aborted;Running;Restarting;Completing;Completed;Terminating;Terminated;Failed
+	// +kubebuilder:validation:Enum=Pending;Aborting;Aborted;Running;Restarting;Completing;Completed;Terminating;Terminated;Failed;Suspending;Suspended
 	// +optional
 	Phase JobPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase"`
 
@@ -410,6 +505,20 @@ type JobStatus struct {
 	// +optional
 	RetryCount int32 `json:"retryCount,omitempty" protobuf:"bytes,10,opt,name=retryCount"`
 
+	// The number of Job retries caused by infrastructure failures, it is only counted
+	// when MaxInfrastructureRetry of the Job is set.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	InfrastructureRetryCount int32 `json:"infrastructureRetryCount,omitempty" protobuf:"bytes,14,opt,name=infrastructureRetryCount"`
+
+	// The time when the Job was suspended, it is cleared once the Job is resumed.
+	// +optional
+	SuspendedAt *metav1.Time `json:"suspendedAt,omitempty" protobuf:"bytes,15,opt,name=suspendedAt"`
+
+	// Unique, one-word, CamelCase reason why the Job was suspended, i.e. UserRequested or Reclaimed.
+	// +optional
+	SuspendReason string `json:"suspendReason,omitempty" protobuf:"bytes,16,opt,name=suspendReason"`
+
 	// The job running duration is the length of time from job running to complete.
 	// +optional
 	RunningDuration *metav1.Duration `json:"runningDuration,omitempty" protobuf:"bytes,11,opt,name=runningDuration"`
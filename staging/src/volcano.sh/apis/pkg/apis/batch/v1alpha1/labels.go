@@ -51,4 +51,10 @@ const (
 	BurstToSiloClusterAnnotation = "volcano.sh/silo-resource"
 	// CronJobScheduledTimestampAnnotation records the intended scheduled timestamp for a job triggered by a CronJob.
 	CronJobScheduledTimestampAnnotation = "volcano.sh/cronjob-scheduled-timestamp"
+	// JobElasticKey job annotation key, the job runs elastically between minAvailable and its replicas if it is "true":
+	// the pods above minAvailable are scheduled on idle resources and reclaimed first under pressure
+	JobElasticKey = "volcano.sh/elastic"
+	// ElasticWorldSizeKey annotation key of the job and its pods, which is the number of the scheduled pods of an
+	// elastic job
+	ElasticWorldSizeKey = "volcano.sh/elastic-world-size"
 )
@@ -180,6 +180,53 @@ func (in *DependsOn) DeepCopy() *DependsOn {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExitCodeRange) DeepCopyInto(out *ExitCodeRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExitCodeRange.
+func (in *ExitCodeRange) DeepCopy() *ExitCodeRange {
+	if in == nil {
+		return nil
+	}
+	out := new(ExitCodeRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExitCodeRequirement) DeepCopyInto(out *ExitCodeRequirement) {
+	*out = *in
+	if in.ContainerName != nil {
+		in, out := &in.ContainerName, &out.ContainerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]ExitCodeRange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExitCodeRequirement.
+func (in *ExitCodeRequirement) DeepCopy() *ExitCodeRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(ExitCodeRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Job) DeepCopyInto(out *Job) {
 	*out = *in
@@ -320,6 +367,16 @@ func (in *JobSpec) DeepCopyInto(out *JobSpec) {
 		*out = new(NetworkTopologySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaxInfrastructureRetry != nil {
+		in, out := &in.MaxInfrastructureRetry, &out.MaxInfrastructureRetry
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -361,6 +418,10 @@ func (in *JobStatus) DeepCopyInto(out *JobStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.SuspendedAt != nil {
+		in, out := &in.SuspendedAt, &out.SuspendedAt
+		*out = (*in).DeepCopy()
+	}
 	if in.RunningDuration != nil {
 		in, out := &in.RunningDuration, &out.RunningDuration
 		*out = new(metav1.Duration)
@@ -429,6 +490,16 @@ func (in *LifecyclePolicy) DeepCopyInto(out *LifecyclePolicy) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.ExitCodes != nil {
+		in, out := &in.ExitCodes, &out.ExitCodes
+		*out = new(ExitCodeRequirement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(RetryBackoff)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -484,6 +555,32 @@ func (in *PartitionPolicySpec) DeepCopy() *PartitionPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBackoff) DeepCopyInto(out *RetryBackoff) {
+	*out = *in
+	if in.InitialDelay != nil {
+		in, out := &in.InitialDelay, &out.InitialDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBackoff.
+func (in *RetryBackoff) DeepCopy() *RetryBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskSpec) DeepCopyInto(out *TaskSpec) {
 	*out = *in
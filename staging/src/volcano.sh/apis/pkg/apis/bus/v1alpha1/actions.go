@@ -49,7 +49,12 @@ const (
 	// CompleteJobAction if this action is set, the unfinished pods will be killed, job completed.
 	CompleteJobAction Action = "CompleteJob"
 
-	// ResumeJobAction is the action to resume an aborted job.
+	// SuspendJobAction if this action is set, the whole job will be suspended:
+	// all Pod of Job will be evicted, and the PodGroup of Job is kept with its
+	// creation ordering, so that the job is resumed from its place in the queue.
+	SuspendJobAction Action = "SuspendJob"
+
+	// ResumeJobAction is the action to resume an aborted or suspended job.
 	ResumeJobAction Action = "ResumeJob"
 
 	// Note: actions below are only used internally, should not be used by users.
@@ -25,6 +25,8 @@ import (
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=datasources,scope=Cluster,shortName=ds
 // +kubebuilder:subresource:status
 
 // DataSource represents a cached query result for data sources in the federated environment.
@@ -98,6 +100,7 @@ type DataSourceStatus struct {
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
 
 // DataSourceList contains a list of DataSource.
 type DataSourceList struct {
@@ -117,6 +120,8 @@ const (
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=datasourceclaims,shortName=dsc
 // +kubebuilder:subresource:status
 
 // DataSourceClaim is a request for a DataSource by a user.
@@ -147,7 +152,7 @@ type WorkloadRef struct {
 	Name string `json:"name" protobuf:"bytes,3,opt,name=name"`
 
 	// Namespace is the namespace of the workload resource.
-	// If empty, defaults to the namespace of the DataSourceClaim.
+	// If set, it must be the namespace of the DataSourceClaim, the workload always lives with its claim.
 	// +optional
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
 }
@@ -196,6 +201,7 @@ type DataSourceClaimStatus struct {
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
 
 // DataSourceClaimList contains a list of DataSourceClaim.
 type DataSourceClaimList struct {
@@ -206,7 +212,22 @@ type DataSourceClaimList struct {
 
 type DSCPhase string
 
+// DataLocalityClusterNamesAnnotationKey is the annotation set on the workload of a bound DataSourceClaim,
+// its value is the comma separated cluster names where the bound DataSource is available.
+// The datalocality plugin of the scheduler places the pods of the workload on the nodes labeled with
+// DataLocalityClusterNameLabelKey as one of the cluster names.
+const DataLocalityClusterNamesAnnotationKey = GroupName + "/cluster-names"
+
+// DataLocalityClusterNameLabelKey is the node label naming the cluster which the node belongs to.
+const DataLocalityClusterNameLabelKey = GroupName + "/cluster-name"
+
 const (
 	DSCPhasePending DSCPhase = "Pending"
 	DSCPhaseBound   DSCPhase = "Bound"
 )
+
+const (
+	// DSCConditionWorkloadObserved means the workload of the claim has been observed. A claim may be created
+	// before its workload, so it is only deleted once its observed workload is gone.
+	DSCConditionWorkloadObserved = "WorkloadObserved"
+)
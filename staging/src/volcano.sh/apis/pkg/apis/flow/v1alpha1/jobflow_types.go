@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"volcano.sh/apis/pkg/apis/batch/v1alpha1"
 )
 
@@ -39,6 +40,54 @@ type Flow struct {
 	DependsOn *DependsOn `json:"dependsOn,omitempty" protobuf:"bytes,2,opt,name=dependsOn"`
 	// +optional
 	Patch *Patch `json:"patch,omitempty" protobuf:"bytes,3,opt,name=patch"`
+	// FailurePolicy defines how the failure of the job of the flow is handled. If it is not set,
+	// the JobFlow fails once the job fails and the jobs of the other flows are left running.
+	// +optional
+	FailurePolicy *FailurePolicy `json:"failurePolicy,omitempty" protobuf:"bytes,4,opt,name=failurePolicy"`
+	// Parameters fans the flow out into one job per parameter set, the jobs run in parallel
+	// and the flows depending on this flow wait for all of them.
+	// +optional
+	Parameters []FlowParameter `json:"parameters,omitempty" protobuf:"bytes,5,rep,name=parameters"`
+	// When gates the flow on the status of the jobs of its targets. The flow waits for its targets
+	// to finish instead of to complete, and it is skipped if the condition is not met.
+	// +optional
+	When *FlowCondition `json:"when,omitempty" protobuf:"bytes,6,opt,name=when"`
+}
+
+// FlowParameter is the parameter set of one of the fan-out jobs of a flow.
+type FlowParameter struct {
+	// Name is appended to the name of the fan-out job, it must be unique in the flow.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +required
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Values are set as environment variables in all the containers of the fan-out job.
+	// +optional
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,2,rep,name=values"`
+}
+
+// FlowCondition gates a flow on the status of the jobs of its targets, all the requirements must be met.
+type FlowCondition struct {
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Requirements []ConditionRequirement `json:"requirements" protobuf:"bytes,1,rep,name=requirements"`
+}
+
+// ConditionRequirement is met if all the jobs of the target flow match it.
+type ConditionRequirement struct {
+	// Target is the flow whose jobs are checked, it must be one of the targets the flow depends on.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Target string `json:"target" protobuf:"bytes,1,opt,name=target"`
+	// Phase is the phase the jobs finished in, e.g. Completed or Failed.
+	// +optional
+	Phase v1alpha1.JobPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase"`
+	// ExitCodes requires a container of each job to have terminated with one of the exit codes.
+	// +optional
+	ExitCodes []int32 `json:"exitCodes,omitempty" protobuf:"varint,3,rep,name=exitCodes"`
+	// Annotations are required to be set on the jobs with the same values.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,4,rep,name=annotations"`
 }
 
 type DependsOn struct {
@@ -48,6 +97,30 @@ type DependsOn struct {
 	Probe *Probe `json:"probe,omitempty" protobuf:"bytes,2,opt,name=probe"`
 }
 
+// FailurePolicy defines how the failure of the job of a flow is handled.
+type FailurePolicy struct {
+	// MaxRetries is the number of times the failed job is recreated before the Action is taken.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries int32 `json:"maxRetries,omitempty" protobuf:"varint,1,opt,name=maxRetries"`
+	// Backoff is the delay before the first retry, it is doubled for each following retry. Defaults to 10s.
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+	// Action is taken once the job fails and the retries are exhausted. Defaults to Abort.
+	// +optional
+	Action FailureAction `json:"action,omitempty" protobuf:"bytes,3,opt,name=action"`
+}
+
+// +kubebuilder:validation:Enum=Skip;Abort
+type FailureAction string
+
+const (
+	// SkipFailureAction treats the flow as optional, the dependent flows proceed as if its job completed.
+	SkipFailureAction FailureAction = "Skip"
+	// AbortFailureAction fails the whole JobFlow and terminates the running jobs of the other flows.
+	AbortFailureAction FailureAction = "Abort"
+)
+
 type Patch struct {
 	// +optional
 	v1alpha1.JobSpec `json:"jobSpec,omitempty" protobuf:"bytes,1,opt,name=jobSpec"`
@@ -115,8 +188,51 @@ type JobFlowStatus struct {
 	Conditions map[string]Condition `json:"conditions,omitempty" protobuf:"bytes,8,rep,name=conditions"`
 	// +optional
 	State State `json:"state,omitempty" protobuf:"bytes,9,opt,name=state"`
+	// ProbeStatuses are the latest results of the dependency probes of the flows.
+	// +optional
+	ProbeStatuses []ProbeStatus `json:"probeStatuses,omitempty" protobuf:"bytes,10,rep,name=probeStatuses"`
+	// SkippedJobs are the failed jobs of the flows with Skip failure action, they don't fail the JobFlow.
+	// +optional
+	SkippedJobs []string `json:"skippedJobs,omitempty" protobuf:"bytes,11,rep,name=skippedJobs"`
+	// SkippedFlows are the flows not deployed as their When conditions are not met.
+	// +optional
+	SkippedFlows []string `json:"skippedFlows,omitempty" protobuf:"bytes,12,rep,name=skippedFlows"`
+}
+
+// ProbeStatus is the latest result of a dependency probe of a flow.
+type ProbeStatus struct {
+	// FlowName is the name of the flow waiting for the probe.
+	// +optional
+	FlowName string `json:"flowName,omitempty" protobuf:"bytes,1,opt,name=flowName"`
+	// Target is the name of the depended flow whose job is probed.
+	// +optional
+	Target string `json:"target,omitempty" protobuf:"bytes,2,opt,name=target"`
+	// Type is the type of the probe.
+	// +optional
+	Type ProbeType `json:"type,omitempty" protobuf:"bytes,3,opt,name=type"`
+	// TaskName is the task of the target job which is probed, empty means all the tasks.
+	// +optional
+	TaskName string `json:"taskName,omitempty" protobuf:"bytes,4,opt,name=taskName"`
+	// Passed indicates whether the probe is passed.
+	// +optional
+	Passed bool `json:"passed,omitempty" protobuf:"varint,5,opt,name=passed"`
+	// Message is the human readable reason of the probe result.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+	// LastTransitionTime is the last time the probe result changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,7,opt,name=lastTransitionTime"`
 }
 
+// +kubebuilder:validation:Enum=HttpGet;TcpSocket;TaskStatus
+type ProbeType string
+
+const (
+	HttpGetProbeType    ProbeType = "HttpGet"
+	TcpSocketProbeType  ProbeType = "TcpSocket"
+	TaskStatusProbeType ProbeType = "TaskStatus"
+)
+
 type JobStatus struct {
 	// +optional
 	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
@@ -131,6 +247,13 @@ type JobStatus struct {
 	RestartCount int32 `json:"restartCount,omitempty" protobuf:"varint,5,opt,name=restartCount"`
 	// +optional
 	RunningHistories []JobRunningHistory `json:"runningHistories,omitempty" protobuf:"bytes,6,rep,name=runningHistories"`
+	// Retries is the number of times the job is recreated by the failure policy of its flow.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Retries int32 `json:"retries,omitempty" protobuf:"varint,7,opt,name=retries"`
+	// RetriedUID is the UID of the failed job last deleted to be retried, the failed job with this UID is not handled again.
+	// +optional
+	RetriedUID types.UID `json:"retriedUID,omitempty" protobuf:"bytes,8,opt,name=retriedUID,casttype=k8s.io/apimachinery/pkg/types.UID"`
 }
 
 type JobRunningHistory struct {
@@ -23,6 +23,39 @@ const AnnotationPrefix = "volcano.sh/"
 // that a job could stay Pending in service level agreement
 const JobWaitingTime = "volcano.sh/sla-waiting-time"
 
+// JobRunningEstimate is the key of the estimated running duration of a job, which is set from
+// the runningEstimate of the Volcano Job and used by backfill to plan reservations, value's format "2h", "30m"
+const JobRunningEstimate = "volcano.sh/running-estimate"
+
+// JobMinRuntime is the key of the minimum running duration of a job, the tasks of the job are protected from
+// eviction by preempt, reclaim and shuffle until they have been running for it, value's format "2h", "30m".
+// It is capped by the evictionMaxMinRuntime argument of the action, and ignored if the action sets no cap.
+const JobMinRuntime = "volcano.sh/min-runtime"
+
+// CheckpointTimeoutAnnotationKey is the annotation key of PodGroup to opt in to graceful eviction: the victims of the
+// job are requested to checkpoint first, and evicted once they acknowledge or the timeout passes, value's format "5m"
+const CheckpointTimeoutAnnotationKey = "volcano.sh/checkpoint-timeout"
+
+// EvictionRequestedAnnotationKey is the annotation key set by the scheduler on the victim pod and its PodGroup to
+// request a checkpoint before eviction, value is the deadline of the checkpoint in RFC3339 format
+const EvictionRequestedAnnotationKey = "volcano.sh/eviction-requested"
+
+// CheckpointCompletedAnnotationKey is the annotation key set by the workload on the victim pod to acknowledge that
+// it has checkpointed, so that it is evicted without waiting for the deadline, value's format "true"
+const CheckpointCompletedAnnotationKey = "volcano.sh/checkpoint-completed"
+
+// SuspendedAnnotationKey is the annotation key set by the job controller on the PodGroup of a suspended job, the
+// PodGroup keeps its creation ordering but is neither enqueued nor accounted by its queue, value's format "true"
+const SuspendedAnnotationKey = "volcano.sh/suspended"
+
+// SuspendOnReclaimAnnotationKey is the annotation key of PodGroup to opt in to suspension by the scheduler: the job
+// is suspended instead of having its tasks evicted one by one when its queue is reclaimed, value's format "true"
+const SuspendOnReclaimAnnotationKey = "volcano.sh/suspend-on-reclaim"
+
+// SuspendRequestedAnnotationKey is the annotation key set by the scheduler on the PodGroup to request the job
+// controller to suspend the job, value is the reason of the request, e.g. "reclaim"
+const SuspendRequestedAnnotationKey = "volcano.sh/suspend-requested"
+
 const KubeHierarchyAnnotationKey = "volcano.sh/hierarchy"
 
 const KubeHierarchyWeightAnnotationKey = "volcano.sh/hierarchy-weights"
@@ -73,5 +106,8 @@ const NumaPolicyKey = "volcano.sh/numa-topology-policy"
 // TopologyDecisionAnnotation is the key of topology decision about pod request resource
 const TopologyDecisionAnnotation = "volcano.sh/topology-decision"
 
+// ReservedForJobAnnotationKey is the annotation key of node to show the job which the node is reserved for
+const ReservedForJobAnnotationKey = "volcano.sh/reserved-for-job"
+
 // PodQosLevel is the key of pod qos level
 const PodQosLevel = "volcano.sh/qos-level"
@@ -98,6 +98,16 @@ type HyperJobStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,4,opt,name=observedGeneration"`
 }
 
+// Condition types of HyperJob, both are terminal.
+const (
+	// HyperJobConditionCompleted means the hyperjob has completed, it is only set when all the volcano jobs of the
+	// hyperjob have finished and at least MinAvailable of them completed successfully.
+	HyperJobConditionCompleted = "Completed"
+	// HyperJobConditionFailed means the hyperjob has failed, it is set as soon as so many volcano jobs have failed
+	// that fewer than MinAvailable of them can complete, even if the other volcano jobs are still running.
+	HyperJobConditionFailed = "Failed"
+)
+
 type ReplicatedJobStatus struct {
 	// Name of the replicated job.
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
@@ -115,6 +125,13 @@ type ReplicatedJobStatus struct {
 	Terminating int32 `json:"terminating,omitempty" protobuf:"varint,7,opt,name=terminating"`
 	// Unknown is the total number of pods under the replicated job in unknown state.
 	Unknown int32 `json:"unknown,omitempty" protobuf:"varint,8,opt,name=unknown"`
+	// SplitReplicas is the replicas of the accelerator task of each volcano job the replicated job is split into
+	// in auto split mode. The split is decided once, and the volcano jobs are always rebuilt from it.
+	// +optional
+	SplitReplicas []int32 `json:"splitReplicas,omitempty" protobuf:"varint,9,rep,name=splitReplicas"`
+	// SplitTier is the hypernode tier each split volcano job is limited in, unset if they are not limited in any tier.
+	// +optional
+	SplitTier *int `json:"splitTier,omitempty" protobuf:"varint,10,opt,name=splitTier"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
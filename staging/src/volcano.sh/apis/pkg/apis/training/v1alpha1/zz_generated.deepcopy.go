@@ -207,6 +207,16 @@ func (in *ReplicatedJobStatus) DeepCopyInto(out *ReplicatedJobStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.SplitReplicas != nil {
+		in, out := &in.SplitReplicas, &out.SplitReplicas
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.SplitTier != nil {
+		in, out := &in.SplitTier, &out.SplitTier
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -0,0 +1,51 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ExitCodeRangeApplyConfiguration represents a declarative configuration of the ExitCodeRange type for use
+// with apply.
+//
+// ExitCodeRange is a closed range of exit codes.
+type ExitCodeRangeApplyConfiguration struct {
+	// Min is the lowest exit code of the range.
+	Min *int32 `json:"min,omitempty"`
+	// Max is the highest exit code of the range.
+	Max *int32 `json:"max,omitempty"`
+}
+
+// ExitCodeRangeApplyConfiguration constructs a declarative configuration of the ExitCodeRange type for use with
+// apply.
+func ExitCodeRange() *ExitCodeRangeApplyConfiguration {
+	return &ExitCodeRangeApplyConfiguration{}
+}
+
+// WithMin sets the Min field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Min field is set to the value of the last call.
+func (b *ExitCodeRangeApplyConfiguration) WithMin(value int32) *ExitCodeRangeApplyConfiguration {
+	b.Min = &value
+	return b
+}
+
+// WithMax sets the Max field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Max field is set to the value of the last call.
+func (b *ExitCodeRangeApplyConfiguration) WithMax(value int32) *ExitCodeRangeApplyConfiguration {
+	b.Max = &value
+	return b
+}
@@ -0,0 +1,85 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+)
+
+// ExitCodeRequirementApplyConfiguration represents a declarative configuration of the ExitCodeRequirement type for use
+// with apply.
+//
+// ExitCodeRequirement describes the exit codes of the containers to match. The containers
+// exited with 0 are never matched.
+type ExitCodeRequirementApplyConfiguration struct {
+	// ContainerName restricts the check of exit codes to the container with this name.
+	// Default to nil (all the containers are checked).
+	ContainerName *string `json:"containerName,omitempty"`
+	// Operator is the relationship between the exit code of a container and the values.
+	// Default to In.
+	Operator *batchv1alpha1.ExitCodeOperator `json:"operator,omitempty"`
+	// Values is the set of the exit codes.
+	Values []int32 `json:"values,omitempty"`
+	// Ranges is the set of the exit code ranges.
+	Ranges []ExitCodeRangeApplyConfiguration `json:"ranges,omitempty"`
+}
+
+// ExitCodeRequirementApplyConfiguration constructs a declarative configuration of the ExitCodeRequirement type for use with
+// apply.
+func ExitCodeRequirement() *ExitCodeRequirementApplyConfiguration {
+	return &ExitCodeRequirementApplyConfiguration{}
+}
+
+// WithContainerName sets the ContainerName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContainerName field is set to the value of the last call.
+func (b *ExitCodeRequirementApplyConfiguration) WithContainerName(value string) *ExitCodeRequirementApplyConfiguration {
+	b.ContainerName = &value
+	return b
+}
+
+// WithOperator sets the Operator field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Operator field is set to the value of the last call.
+func (b *ExitCodeRequirementApplyConfiguration) WithOperator(value batchv1alpha1.ExitCodeOperator) *ExitCodeRequirementApplyConfiguration {
+	b.Operator = &value
+	return b
+}
+
+// WithValues adds the given value to the Values field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Values field.
+func (b *ExitCodeRequirementApplyConfiguration) WithValues(values ...int32) *ExitCodeRequirementApplyConfiguration {
+	for i := range values {
+		b.Values = append(b.Values, values[i])
+	}
+	return b
+}
+
+// WithRanges adds the given value to the Ranges field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Ranges field.
+func (b *ExitCodeRequirementApplyConfiguration) WithRanges(values ...*ExitCodeRangeApplyConfiguration) *ExitCodeRequirementApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRanges")
+		}
+		b.Ranges = append(b.Ranges, *values[i])
+	}
+	return b
+}
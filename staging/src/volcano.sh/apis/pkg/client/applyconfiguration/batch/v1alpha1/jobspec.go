@@ -61,6 +61,17 @@ type JobSpecApplyConfiguration struct {
 	MinSuccess *int32 `json:"minSuccess,omitempty"`
 	// NetworkTopology defines the NetworkTopology config, this field works in conjunction with network topology feature and hyperNode CRD.
 	NetworkTopology *NetworkTopologySpecApplyConfiguration `json:"networkTopology,omitempty"`
+	// Specifies the maximum number of retries caused by infrastructure failures before marking this Job failed,
+	// i.e. the pods evicted, the pods on lost nodes and the containers OOMKilled. Once it is set, these retries are
+	// counted by InfrastructureRetryCount instead of RetryCount, so that they do not consume MaxRetry.
+	// Default to nil (all the retries are counted against MaxRetry).
+	MaxInfrastructureRetry *int32 `json:"maxInfrastructureRetry,omitempty"`
+	// Suspend specifies whether the Job controller should run the pods of the Job. Once it is set to true,
+	// the pods of the Job are deleted and its PodGroup is kept with its creation ordering, so that the Job
+	// resumes from its place in the queue once it is set to false again. The PodGroup of a suspended Job
+	// is not accounted by its queue.
+	// Default to false.
+	Suspend *bool `json:"suspend,omitempty"`
 }
 
 // JobSpecApplyConfiguration constructs a declarative configuration of the JobSpec type for use with
@@ -193,3 +204,19 @@ func (b *JobSpecApplyConfiguration) WithNetworkTopology(value *NetworkTopologySp
 	b.NetworkTopology = value
 	return b
 }
+
+// WithMaxInfrastructureRetry sets the MaxInfrastructureRetry field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxInfrastructureRetry field is set to the value of the last call.
+func (b *JobSpecApplyConfiguration) WithMaxInfrastructureRetry(value int32) *JobSpecApplyConfiguration {
+	b.MaxInfrastructureRetry = &value
+	return b
+}
+
+// WithSuspend sets the Suspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspend field is set to the value of the last call.
+func (b *JobSpecApplyConfiguration) WithSuspend(value bool) *JobSpecApplyConfiguration {
+	b.Suspend = &value
+	return b
+}
@@ -48,6 +48,13 @@ type JobStatusApplyConfiguration struct {
 	Version *int32 `json:"version,omitempty"`
 	// The number of Job retries.
 	RetryCount *int32 `json:"retryCount,omitempty"`
+	// The number of Job retries caused by infrastructure failures, it is only counted
+	// when MaxInfrastructureRetry of the Job is set.
+	InfrastructureRetryCount *int32 `json:"infrastructureRetryCount,omitempty"`
+	// The time when the Job was suspended, it is cleared once the Job is resumed.
+	SuspendedAt *v1.Time `json:"suspendedAt,omitempty"`
+	// Unique, one-word, CamelCase reason why the Job was suspended, i.e. UserRequested or Reclaimed.
+	SuspendReason *string `json:"suspendReason,omitempty"`
 	// The job running duration is the length of time from job running to complete.
 	RunningDuration *v1.Duration `json:"runningDuration,omitempty"`
 	// The resources that controlled by this job, e.g. Service, ConfigMap
@@ -156,6 +163,30 @@ func (b *JobStatusApplyConfiguration) WithRetryCount(value int32) *JobStatusAppl
 	return b
 }
 
+// WithInfrastructureRetryCount sets the InfrastructureRetryCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InfrastructureRetryCount field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithInfrastructureRetryCount(value int32) *JobStatusApplyConfiguration {
+	b.InfrastructureRetryCount = &value
+	return b
+}
+
+// WithSuspendedAt sets the SuspendedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuspendedAt field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithSuspendedAt(value v1.Time) *JobStatusApplyConfiguration {
+	b.SuspendedAt = &value
+	return b
+}
+
+// WithSuspendReason sets the SuspendReason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuspendReason field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithSuspendReason(value string) *JobStatusApplyConfiguration {
+	b.SuspendReason = &value
+	return b
+}
+
 // WithRunningDuration sets the RunningDuration field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RunningDuration field is set to the value of the last call.
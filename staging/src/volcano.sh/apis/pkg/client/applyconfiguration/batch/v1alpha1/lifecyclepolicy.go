@@ -39,11 +39,18 @@ type LifecyclePolicyApplyConfiguration struct {
 	Events []busv1alpha1.Event `json:"events,omitempty"`
 	// The exit code of the pod container, controller will take action
 	// according to this code.
-	// Note: only one of `Event` or `ExitCode` can be specified.
+	// Note: only one of `Event`, `ExitCode` or `ExitCodes` can be specified.
 	ExitCode *int32 `json:"exitCode,omitempty"`
 	// Timeout is the grace period for controller to take actions.
 	// Default to nil (take action immediately).
 	Timeout *v1.Duration `json:"timeout,omitempty"`
+	// ExitCodes matches the exit codes of the containers of the failed pod, controller
+	// will take action if any of the containers matches.
+	// Note: only one of `Event`, `ExitCode` or `ExitCodes` can be specified.
+	ExitCodes *ExitCodeRequirementApplyConfiguration `json:"exitCodes,omitempty"`
+	// Backoff delays the restart actions of the policy exponentially with the retries of the Job.
+	// Default to nil (restart immediately).
+	Backoff *RetryBackoffApplyConfiguration `json:"backoff,omitempty"`
 }
 
 // LifecyclePolicyApplyConfiguration constructs a declarative configuration of the LifecyclePolicy type for use with
@@ -93,3 +100,19 @@ func (b *LifecyclePolicyApplyConfiguration) WithTimeout(value v1.Duration) *Life
 	b.Timeout = &value
 	return b
 }
+
+// WithExitCodes sets the ExitCodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExitCodes field is set to the value of the last call.
+func (b *LifecyclePolicyApplyConfiguration) WithExitCodes(value *ExitCodeRequirementApplyConfiguration) *LifecyclePolicyApplyConfiguration {
+	b.ExitCodes = value
+	return b
+}
+
+// WithBackoff sets the Backoff field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Backoff field is set to the value of the last call.
+func (b *LifecyclePolicyApplyConfiguration) WithBackoff(value *RetryBackoffApplyConfiguration) *LifecyclePolicyApplyConfiguration {
+	b.Backoff = value
+	return b
+}
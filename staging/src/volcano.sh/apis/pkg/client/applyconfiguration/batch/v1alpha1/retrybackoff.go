@@ -0,0 +1,59 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RetryBackoffApplyConfiguration represents a declarative configuration of the RetryBackoff type for use
+// with apply.
+//
+// RetryBackoff specifies the exponential backoff between the restarts of a Job. The delay of
+// a restart is InitialDelay doubled for each retry of the Job of the same kind, i.e. the
+// infrastructure retries or the others, and capped by MaxDelay.
+type RetryBackoffApplyConfiguration struct {
+	// InitialDelay is the delay of the first restart.
+	// Default to 10s.
+	InitialDelay *v1.Duration `json:"initialDelay,omitempty"`
+	// MaxDelay is the upper bound of the delay.
+	// Default to 5m.
+	MaxDelay *v1.Duration `json:"maxDelay,omitempty"`
+}
+
+// RetryBackoffApplyConfiguration constructs a declarative configuration of the RetryBackoff type for use with
+// apply.
+func RetryBackoff() *RetryBackoffApplyConfiguration {
+	return &RetryBackoffApplyConfiguration{}
+}
+
+// WithInitialDelay sets the InitialDelay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InitialDelay field is set to the value of the last call.
+func (b *RetryBackoffApplyConfiguration) WithInitialDelay(value v1.Duration) *RetryBackoffApplyConfiguration {
+	b.InitialDelay = &value
+	return b
+}
+
+// WithMaxDelay sets the MaxDelay field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxDelay field is set to the value of the last call.
+func (b *RetryBackoffApplyConfiguration) WithMaxDelay(value v1.Duration) *RetryBackoffApplyConfiguration {
+	b.MaxDelay = &value
+	return b
+}
@@ -32,7 +32,7 @@ type WorkloadRefApplyConfiguration struct {
 	// Name is the name of the workload resource.
 	Name *string `json:"name,omitempty"`
 	// Namespace is the namespace of the workload resource.
-	// If empty, defaults to the namespace of the DataSourceClaim.
+	// If set, it must be the namespace of the DataSourceClaim, the workload always lives with its claim.
 	Namespace *string `json:"namespace,omitempty"`
 }
 
@@ -0,0 +1,78 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+package v1alpha1
+
+import (
+	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
+)
+
+// ConditionRequirementApplyConfiguration represents a declarative configuration of the ConditionRequirement type for use
+// with apply.
+//
+// ConditionRequirement is met if all the jobs of the target flow match it.
+type ConditionRequirementApplyConfiguration struct {
+	Target      *string                 `json:"target,omitempty"`
+	Phase       *batchv1alpha1.JobPhase `json:"phase,omitempty"`
+	ExitCodes   []int32                 `json:"exitCodes,omitempty"`
+	Annotations map[string]string       `json:"annotations,omitempty"`
+}
+
+// ConditionRequirementApplyConfiguration constructs a declarative configuration of the ConditionRequirement type for use with
+// apply.
+func ConditionRequirement() *ConditionRequirementApplyConfiguration {
+	return &ConditionRequirementApplyConfiguration{}
+}
+
+// WithTarget sets the Target field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Target field is set to the value of the last call.
+func (b *ConditionRequirementApplyConfiguration) WithTarget(value string) *ConditionRequirementApplyConfiguration {
+	b.Target = &value
+	return b
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *ConditionRequirementApplyConfiguration) WithPhase(value batchv1alpha1.JobPhase) *ConditionRequirementApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithExitCodes adds the given value to the ExitCodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExitCodes field.
+func (b *ConditionRequirementApplyConfiguration) WithExitCodes(values ...int32) *ConditionRequirementApplyConfiguration {
+	for i := range values {
+		b.ExitCodes = append(b.ExitCodes, values[i])
+	}
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *ConditionRequirementApplyConfiguration) WithAnnotations(entries map[string]string) *ConditionRequirementApplyConfiguration {
+	if b.Annotations == nil && len(entries) > 0 {
+		b.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Annotations[k] = v
+	}
+	return b
+}
@@ -0,0 +1,63 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	flowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
+)
+
+// FailurePolicyApplyConfiguration represents a declarative configuration of the FailurePolicy type for use
+// with apply.
+//
+// FailurePolicy defines how the failure of the job of a flow is handled.
+type FailurePolicyApplyConfiguration struct {
+	MaxRetries *int32                      `json:"maxRetries,omitempty"`
+	Backoff    *v1.Duration                `json:"backoff,omitempty"`
+	Action     *flowv1alpha1.FailureAction `json:"action,omitempty"`
+}
+
+// FailurePolicyApplyConfiguration constructs a declarative configuration of the FailurePolicy type for use with
+// apply.
+func FailurePolicy() *FailurePolicyApplyConfiguration {
+	return &FailurePolicyApplyConfiguration{}
+}
+
+// WithMaxRetries sets the MaxRetries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRetries field is set to the value of the last call.
+func (b *FailurePolicyApplyConfiguration) WithMaxRetries(value int32) *FailurePolicyApplyConfiguration {
+	b.MaxRetries = &value
+	return b
+}
+
+// WithBackoff sets the Backoff field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Backoff field is set to the value of the last call.
+func (b *FailurePolicyApplyConfiguration) WithBackoff(value v1.Duration) *FailurePolicyApplyConfiguration {
+	b.Backoff = &value
+	return b
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *FailurePolicyApplyConfiguration) WithAction(value flowv1alpha1.FailureAction) *FailurePolicyApplyConfiguration {
+	b.Action = &value
+	return b
+}
@@ -22,9 +22,12 @@ package v1alpha1
 //
 // Flow defines the dependent of jobs
 type FlowApplyConfiguration struct {
-	Name      *string                      `json:"name,omitempty"`
-	DependsOn *DependsOnApplyConfiguration `json:"dependsOn,omitempty"`
-	Patch     *PatchApplyConfiguration     `json:"patch,omitempty"`
+	Name          *string                           `json:"name,omitempty"`
+	DependsOn     *DependsOnApplyConfiguration      `json:"dependsOn,omitempty"`
+	Patch         *PatchApplyConfiguration          `json:"patch,omitempty"`
+	FailurePolicy *FailurePolicyApplyConfiguration  `json:"failurePolicy,omitempty"`
+	Parameters    []FlowParameterApplyConfiguration `json:"parameters,omitempty"`
+	When          *FlowConditionApplyConfiguration  `json:"when,omitempty"`
 }
 
 // FlowApplyConfiguration constructs a declarative configuration of the Flow type for use with
@@ -56,3 +59,32 @@ func (b *FlowApplyConfiguration) WithPatch(value *PatchApplyConfiguration) *Flow
 	b.Patch = value
 	return b
 }
+
+// WithFailurePolicy sets the FailurePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailurePolicy field is set to the value of the last call.
+func (b *FlowApplyConfiguration) WithFailurePolicy(value *FailurePolicyApplyConfiguration) *FlowApplyConfiguration {
+	b.FailurePolicy = value
+	return b
+}
+
+// WithParameters adds the given value to the Parameters field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Parameters field.
+func (b *FlowApplyConfiguration) WithParameters(values ...*FlowParameterApplyConfiguration) *FlowApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithParameters")
+		}
+		b.Parameters = append(b.Parameters, *values[i])
+	}
+	return b
+}
+
+// WithWhen sets the When field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the When field is set to the value of the last call.
+func (b *FlowApplyConfiguration) WithWhen(value *FlowConditionApplyConfiguration) *FlowApplyConfiguration {
+	b.When = value
+	return b
+}
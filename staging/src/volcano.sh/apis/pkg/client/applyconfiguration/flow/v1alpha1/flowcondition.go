@@ -0,0 +1,44 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+package v1alpha1
+
+// FlowConditionApplyConfiguration represents a declarative configuration of the FlowCondition type for use
+// with apply.
+//
+// FlowCondition gates a flow on the status of the jobs of its targets, all the requirements must be met.
+type FlowConditionApplyConfiguration struct {
+	Requirements []ConditionRequirementApplyConfiguration `json:"requirements,omitempty"`
+}
+
+// FlowConditionApplyConfiguration constructs a declarative configuration of the FlowCondition type for use with
+// apply.
+func FlowCondition() *FlowConditionApplyConfiguration {
+	return &FlowConditionApplyConfiguration{}
+}
+
+// WithRequirements adds the given value to the Requirements field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Requirements field.
+func (b *FlowConditionApplyConfiguration) WithRequirements(values ...*ConditionRequirementApplyConfiguration) *FlowConditionApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRequirements")
+		}
+		b.Requirements = append(b.Requirements, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,54 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+package v1alpha1
+
+// FlowParameterApplyConfiguration represents a declarative configuration of the FlowParameter type for use
+// with apply.
+//
+// FlowParameter is the parameter set of one of the fan-out jobs of a flow.
+type FlowParameterApplyConfiguration struct {
+	Name   *string           `json:"name,omitempty"`
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// FlowParameterApplyConfiguration constructs a declarative configuration of the FlowParameter type for use with
+// apply.
+func FlowParameter() *FlowParameterApplyConfiguration {
+	return &FlowParameterApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *FlowParameterApplyConfiguration) WithName(value string) *FlowParameterApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithValues puts the entries into the Values field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Values field,
+// overwriting an existing map entries in Values field with the same key.
+func (b *FlowParameterApplyConfiguration) WithValues(entries map[string]string) *FlowParameterApplyConfiguration {
+	if b.Values == nil && len(entries) > 0 {
+		b.Values = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Values[k] = v
+	}
+	return b
+}
@@ -31,6 +31,9 @@ type JobFlowStatusApplyConfiguration struct {
 	JobStatusList  []JobStatusApplyConfiguration          `json:"jobStatusList,omitempty"`
 	Conditions     map[string]ConditionApplyConfiguration `json:"conditions,omitempty"`
 	State          *StateApplyConfiguration               `json:"state,omitempty"`
+	ProbeStatuses  []ProbeStatusApplyConfiguration        `json:"probeStatuses,omitempty"`
+	SkippedJobs    []string                               `json:"skippedJobs,omitempty"`
+	SkippedFlows   []string                               `json:"skippedFlows,omitempty"`
 }
 
 // JobFlowStatusApplyConfiguration constructs a declarative configuration of the JobFlowStatus type for use with
@@ -133,3 +136,36 @@ func (b *JobFlowStatusApplyConfiguration) WithState(value *StateApplyConfigurati
 	b.State = value
 	return b
 }
+
+// WithProbeStatuses adds the given value to the ProbeStatuses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ProbeStatuses field.
+func (b *JobFlowStatusApplyConfiguration) WithProbeStatuses(values ...*ProbeStatusApplyConfiguration) *JobFlowStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithProbeStatuses")
+		}
+		b.ProbeStatuses = append(b.ProbeStatuses, *values[i])
+	}
+	return b
+}
+
+// WithSkippedJobs adds the given value to the SkippedJobs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SkippedJobs field.
+func (b *JobFlowStatusApplyConfiguration) WithSkippedJobs(values ...string) *JobFlowStatusApplyConfiguration {
+	for i := range values {
+		b.SkippedJobs = append(b.SkippedJobs, values[i])
+	}
+	return b
+}
+
+// WithSkippedFlows adds the given value to the SkippedFlows field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SkippedFlows field.
+func (b *JobFlowStatusApplyConfiguration) WithSkippedFlows(values ...string) *JobFlowStatusApplyConfiguration {
+	for i := range values {
+		b.SkippedFlows = append(b.SkippedFlows, values[i])
+	}
+	return b
+}
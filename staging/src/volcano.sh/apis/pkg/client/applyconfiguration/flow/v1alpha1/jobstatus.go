@@ -19,6 +19,7 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
 	batchv1alpha1 "volcano.sh/apis/pkg/apis/batch/v1alpha1"
 )
 
@@ -31,6 +32,8 @@ type JobStatusApplyConfiguration struct {
 	EndTimestamp     *v1.Time                              `json:"endTimestamp,omitempty"`
 	RestartCount     *int32                                `json:"restartCount,omitempty"`
 	RunningHistories []JobRunningHistoryApplyConfiguration `json:"runningHistories,omitempty"`
+	Retries          *int32                                `json:"retries,omitempty"`
+	RetriedUID       *types.UID                            `json:"retriedUID,omitempty"`
 }
 
 // JobStatusApplyConfiguration constructs a declarative configuration of the JobStatus type for use with
@@ -91,3 +94,19 @@ func (b *JobStatusApplyConfiguration) WithRunningHistories(values ...*JobRunning
 	}
 	return b
 }
+
+// WithRetries sets the Retries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Retries field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithRetries(value int32) *JobStatusApplyConfiguration {
+	b.Retries = &value
+	return b
+}
+
+// WithRetriedUID sets the RetriedUID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetriedUID field is set to the value of the last call.
+func (b *JobStatusApplyConfiguration) WithRetriedUID(value types.UID) *JobStatusApplyConfiguration {
+	b.RetriedUID = &value
+	return b
+}
@@ -0,0 +1,99 @@
+/*
+Copyright The Volcano Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	flowv1alpha1 "volcano.sh/apis/pkg/apis/flow/v1alpha1"
+)
+
+// ProbeStatusApplyConfiguration represents a declarative configuration of the ProbeStatus type for use
+// with apply.
+//
+// ProbeStatus is the latest result of a dependency probe of a flow.
+type ProbeStatusApplyConfiguration struct {
+	FlowName           *string                 `json:"flowName,omitempty"`
+	Target             *string                 `json:"target,omitempty"`
+	Type               *flowv1alpha1.ProbeType `json:"type,omitempty"`
+	TaskName           *string                 `json:"taskName,omitempty"`
+	Passed             *bool                   `json:"passed,omitempty"`
+	Message            *string                 `json:"message,omitempty"`
+	LastTransitionTime *v1.Time                `json:"lastTransitionTime,omitempty"`
+}
+
+// ProbeStatusApplyConfiguration constructs a declarative configuration of the ProbeStatus type for use with
+// apply.
+func ProbeStatus() *ProbeStatusApplyConfiguration {
+	return &ProbeStatusApplyConfiguration{}
+}
+
+// WithFlowName sets the FlowName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FlowName field is set to the value of the last call.
+func (b *ProbeStatusApplyConfiguration) WithFlowName(value string) *ProbeStatusApplyConfiguration {
+	b.FlowName = &value
+	return b
+}
+
+// WithTarget sets the Target field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Target field is set to the value of the last call.
+func (b *ProbeStatusApplyConfiguration) WithTarget(value string) *ProbeStatusApplyConfiguration {
+	b.Target = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ProbeStatusApplyConfiguration) WithType(value flowv1alpha1.ProbeType) *ProbeStatusApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithTaskName sets the TaskName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TaskName field is set to the value of the last call.
+func (b *ProbeStatusApplyConfiguration) WithTaskName(value string) *ProbeStatusApplyConfiguration {
+	b.TaskName = &value
+	return b
+}
+
+// WithPassed sets the Passed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Passed field is set to the value of the last call.
+func (b *ProbeStatusApplyConfiguration) WithPassed(value bool) *ProbeStatusApplyConfiguration {
+	b.Passed = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *ProbeStatusApplyConfiguration) WithMessage(value string) *ProbeStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithLastTransitionTime sets the LastTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTransitionTime field is set to the value of the last call.
+func (b *ProbeStatusApplyConfiguration) WithLastTransitionTime(value v1.Time) *ProbeStatusApplyConfiguration {
+	b.LastTransitionTime = &value
+	return b
+}
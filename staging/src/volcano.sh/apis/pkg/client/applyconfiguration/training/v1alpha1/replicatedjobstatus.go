@@ -40,6 +40,11 @@ type ReplicatedJobStatusApplyConfiguration struct {
 	Terminating *int32 `json:"terminating,omitempty"`
 	// Unknown is the total number of pods under the replicated job in unknown state.
 	Unknown *int32 `json:"unknown,omitempty"`
+	// SplitReplicas is the replicas of the accelerator task of each volcano job the replicated job is split into
+	// in auto split mode. The split is decided once, and the volcano jobs are always rebuilt from it.
+	SplitReplicas []int32 `json:"splitReplicas,omitempty"`
+	// SplitTier is the hypernode tier each split volcano job is limited in, unset if they are not limited in any tier.
+	SplitTier *int `json:"splitTier,omitempty"`
 }
 
 // ReplicatedJobStatusApplyConfiguration constructs a declarative configuration of the ReplicatedJobStatus type for use with
@@ -117,3 +122,21 @@ func (b *ReplicatedJobStatusApplyConfiguration) WithUnknown(value int32) *Replic
 	b.Unknown = &value
 	return b
 }
+
+// WithSplitReplicas adds the given value to the SplitReplicas field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SplitReplicas field.
+func (b *ReplicatedJobStatusApplyConfiguration) WithSplitReplicas(values ...int32) *ReplicatedJobStatusApplyConfiguration {
+	for i := range values {
+		b.SplitReplicas = append(b.SplitReplicas, values[i])
+	}
+	return b
+}
+
+// WithSplitTier sets the SplitTier field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SplitTier field is set to the value of the last call.
+func (b *ReplicatedJobStatusApplyConfiguration) WithSplitTier(value int) *ReplicatedJobStatusApplyConfiguration {
+	b.SplitTier = &value
+	return b
+}
@@ -57,6 +57,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &batchv1alpha1.CronJobStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DependsOn"):
 		return &batchv1alpha1.DependsOnApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ExitCodeRange"):
+		return &batchv1alpha1.ExitCodeRangeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ExitCodeRequirement"):
+		return &batchv1alpha1.ExitCodeRequirementApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Job"):
 		return &batchv1alpha1.JobApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("JobCondition"):
@@ -75,6 +79,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &batchv1alpha1.NetworkTopologySpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PartitionPolicySpec"):
 		return &batchv1alpha1.PartitionPolicySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RetryBackoff"):
+		return &batchv1alpha1.RetryBackoffApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TaskSpec"):
 		return &batchv1alpha1.TaskSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("TaskState"):
@@ -119,10 +125,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		// Group=flow.volcano.sh, Version=v1alpha1
 	case flowv1alpha1.SchemeGroupVersion.WithKind("Condition"):
 		return &applyconfigurationflowv1alpha1.ConditionApplyConfiguration{}
+	case flowv1alpha1.SchemeGroupVersion.WithKind("ConditionRequirement"):
+		return &applyconfigurationflowv1alpha1.ConditionRequirementApplyConfiguration{}
 	case flowv1alpha1.SchemeGroupVersion.WithKind("DependsOn"):
 		return &applyconfigurationflowv1alpha1.DependsOnApplyConfiguration{}
+	case flowv1alpha1.SchemeGroupVersion.WithKind("FailurePolicy"):
+		return &applyconfigurationflowv1alpha1.FailurePolicyApplyConfiguration{}
 	case flowv1alpha1.SchemeGroupVersion.WithKind("Flow"):
 		return &applyconfigurationflowv1alpha1.FlowApplyConfiguration{}
+	case flowv1alpha1.SchemeGroupVersion.WithKind("FlowCondition"):
+		return &applyconfigurationflowv1alpha1.FlowConditionApplyConfiguration{}
+	case flowv1alpha1.SchemeGroupVersion.WithKind("FlowParameter"):
+		return &applyconfigurationflowv1alpha1.FlowParameterApplyConfiguration{}
 	case flowv1alpha1.SchemeGroupVersion.WithKind("HttpGet"):
 		return &applyconfigurationflowv1alpha1.HttpGetApplyConfiguration{}
 	case flowv1alpha1.SchemeGroupVersion.WithKind("JobFlow"):
@@ -143,6 +157,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationflowv1alpha1.PatchApplyConfiguration{}
 	case flowv1alpha1.SchemeGroupVersion.WithKind("Probe"):
 		return &applyconfigurationflowv1alpha1.ProbeApplyConfiguration{}
+	case flowv1alpha1.SchemeGroupVersion.WithKind("ProbeStatus"):
+		return &applyconfigurationflowv1alpha1.ProbeStatusApplyConfiguration{}
 	case flowv1alpha1.SchemeGroupVersion.WithKind("State"):
 		return &applyconfigurationflowv1alpha1.StateApplyConfiguration{}
 	case flowv1alpha1.SchemeGroupVersion.WithKind("TaskStatus"):